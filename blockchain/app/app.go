@@ -1,6 +1,7 @@
 package app
 
 import (
+    "encoding/json"
     "os"
     
     "github.com/cosmos/cosmos-sdk/baseapp"
@@ -11,27 +12,32 @@ import (
     // "github.com/cosmos/cosmos-sdk/x/auth"   // Used in moduleHandler
     authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
     authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+    crisiskeeper "github.com/cosmos/cosmos-sdk/x/crisis/keeper"
+    crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
     "github.com/cosmos/cosmos-sdk/codec"
     "github.com/cosmos/cosmos-sdk/codec/types"
     paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
     storetypes "github.com/cosmos/cosmos-sdk/store/types"
     sdk "github.com/cosmos/cosmos-sdk/types"
     "github.com/cosmos/cosmos-sdk/server/api"
+    "github.com/cosmos/cosmos-sdk/std"
     "github.com/cosmos/cosmos-sdk/server/config"
     servertypes "github.com/cosmos/cosmos-sdk/server/types"
     "github.com/cosmos/cosmos-sdk/client"
     dbm "github.com/cometbft/cometbft-db"
     log "github.com/cometbft/cometbft/libs/log"
+    abci "github.com/cometbft/cometbft/abci/types"
+    tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
     tmtypes "github.com/cometbft/cometbft/types"
     
     // "skaffacity/x/mint"        // TODO: uncomment when mint keeper is fixed
     // mintkeeper "skaffacity/x/mint/keeper"
     // minttypes "skaffacity/x/mint/types"
     
-    // "skaffacity/x/nft"         // Used in moduleHandler
+    "skaffacity/x/nft"
     nftkeeper "skaffacity/x/nft/keeper"
     nfttypes "skaffacity/x/nft/types"
-    // "skaffacity/x/marketplace" // Used in moduleHandler
+    "skaffacity/x/marketplace"
     marketplacekeeper "skaffacity/x/marketplace/keeper"
     marketplacetypes "skaffacity/x/marketplace/types"
     // "skaffacity/x/governance"  // Used in moduleHandler
@@ -40,7 +46,7 @@ import (
     // "skaffacity/x/staking"     // Used in moduleHandler
     stakingkeeper "skaffacity/x/staking/keeper"
     stakingtypes "skaffacity/x/staking/types"
-    // "skaffacity/x/web"         // Used in moduleHandler
+    "skaffacity/x/web"
     webkeeper "skaffacity/x/web/keeper"
     webtypes "skaffacity/x/web/types"
 )
@@ -60,6 +66,15 @@ const (
 
 var (
     DefaultNodeHome = os.ExpandEnv("$HOME/skaffacity")
+
+    // maccPerms lists the module accounts and their permissions. The fee
+    // collector receives transaction and marketplace protocol fees, which
+    // the web module splits; the governance module account holds proposal
+    // deposits.
+    maccPerms = map[string][]string{
+        authtypes.FeeCollectorName: nil,
+        govtypes.ModuleName:        nil,
+    }
 )
 
 // SetConfig sets the global SDK configuration for address prefixes
@@ -82,6 +97,7 @@ type App struct {
     // Keepers
     AccountKeeper authkeeper.AccountKeeper
     BankKeeper    bankkeeper.Keeper
+    CrisisKeeper  crisiskeeper.Keeper
     // MintKeeper    mintkeeper.Keeper  // Disabled temporarily
     NFTKeeper     nftkeeper.Keeper
     MarketKeeper  marketplacekeeper.Keeper
@@ -125,6 +141,7 @@ func NewApp(
     keys := sdk.NewKVStoreKeys(
         authtypes.StoreKey,
         banktypes.StoreKey,
+        crisistypes.StoreKey,
         // minttypes.StoreKey, // Disabled temporarily
         nfttypes.StoreKey,
         marketplacetypes.StoreKey,
@@ -153,7 +170,7 @@ func NewApp(
         cdc,
         keys[authtypes.StoreKey],
         authtypes.ProtoBaseAccount,
-        nil, // maccPerms - module account permissions
+        maccPerms,
         sdk.Bech32MainPrefix,
         authtypes.NewModuleAddress("gov").String(),
     )
@@ -167,6 +184,17 @@ func NewApp(
         authtypes.NewModuleAddress("gov").String(),
     )
     
+    // Initialize crisis keeper, which asserts the module invariants every
+    // invCheckPeriod blocks
+    app.CrisisKeeper = *crisiskeeper.NewKeeper(
+        cdc,
+        keys[crisistypes.StoreKey],
+        invCheckPeriod,
+        app.BankKeeper,
+        authtypes.FeeCollectorName,
+        authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+    )
+    
     // Initialize mint keeper - using a basic implementation for now
     // app.MintKeeper = *mintkeeper.NewKeeper(
     //     cdc,
@@ -180,6 +208,7 @@ func NewApp(
         cdc,
         keys[nfttypes.StoreKey],
         app.BankKeeper, // Add bank keeper dependency
+        authtypes.NewModuleAddress(govtypes.ModuleName).String(),
     )
     
     app.StakingKeeper = *stakingkeeper.NewKeeper(
@@ -193,11 +222,13 @@ func NewApp(
         keys[marketplacetypes.StoreKey],
         app.BankKeeper,
         &app.NFTKeeper,
+        authtypes.NewModuleAddress(govtypes.ModuleName).String(),
     )
     
     app.GovKeeper = *governancekeeper.NewKeeper(
         cdc,
         keys[govtypes.StoreKey],
+        app.BankKeeper, // holds proposal deposits
         &app.StakingKeeper,
     )
     
@@ -208,11 +239,39 @@ func NewApp(
         paramtypes.Subspace{}, // Use zero value instead of nil
         app.BankKeeper,
         app.AccountKeeper,
+        authtypes.NewModuleAddress(govtypes.ModuleName).String(),
     )
     
+    // Passed governance proposals execute these messages with the governance
+    // module account as signer; anything else cannot be carried by a proposal
+    govRouter := govtypes.NewRouter()
+    govRouter.
+        AddRoute(&webtypes.MsgSetDeveloperAddress{}, web.NewHandler(app.WebKeeper)).
+        AddRoute(&webtypes.MsgEnableFeeDistribution{}, web.NewHandler(app.WebKeeper)).
+        AddRoute(&marketplacetypes.MsgUpdateParams{}, marketplace.NewHandler(app.MarketKeeper)).
+        AddRoute(&nfttypes.MsgAddBadgeIssuer{}, nft.NewHandler(app.NFTKeeper)).
+        AddRoute(&nfttypes.MsgRemoveBadgeIssuer{}, nft.NewHandler(app.NFTKeeper))
+    app.GovKeeper.SetRouter(govRouter)
+    
     // Use module handler to load all modules with proper initialization
     app.mm = app.moduleHandler.LoadAllModules(app, cdc, keys, memKeys)
     
+    // Route transactions and queries to the module services. The Msg service
+    // router only accepts Msg types the interface registry resolves, so the
+    // modules register their interfaces first.
+    std.RegisterInterfaces(interfaceRegistry)
+    app.moduleHandler.RegisterInterfaces(interfaceRegistry)
+    bApp.SetInterfaceRegistry(interfaceRegistry)
+    app.mm.RegisterServices(module.NewConfigurator(cdc, bApp.MsgServiceRouter(), bApp.GRPCQueryRouter()))
+    app.mm.RegisterInvariants(&app.CrisisKeeper)
+    
+    // Initialize every module from the genesis file
+    app.SetInitChainer(app.InitChainer)
+
+    // Run the per-block logic of every module, such as expiring rentals
+    app.SetBeginBlocker(app.BeginBlocker)
+    app.SetEndBlocker(app.EndBlocker)
+    
     // Mount stores
     app.MountKVStores(keys)
     app.MountMemoryStores(memKeys)
@@ -231,6 +290,27 @@ func NewApp(
     return app
 }
 
+// InitChainer initializes every module from the genesis state, in the order
+// set by the module handler. The staking module returns the genesis
+// validator set.
+func (app *App) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
+    var genesisState GenesisState
+    if err := json.Unmarshal(req.AppStateBytes, &genesisState); err != nil {
+        panic(err)
+    }
+    return app.mm.InitGenesis(ctx, app.cdc, genesisState)
+}
+
+// BeginBlocker runs the BeginBlock logic of every module
+func (app *App) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+    return app.mm.BeginBlock(ctx, req)
+}
+
+// EndBlocker runs the EndBlock logic of every module
+func (app *App) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+    return app.mm.EndBlock(ctx, req)
+}
+
 // RegisterAPIRoutes registers all application module routes with the provided API server.
 func (app *App) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIConfig) {
     // For now, we'll implement a minimal version
@@ -255,15 +335,28 @@ func (app *App) RegisterTxService(clientCtx client.Context) {
     // In a full implementation, you would register tx services here
 }
 
-// ExportAppStateAndValidators exports the state of the application for a genesis file.
+// ExportAppStateAndValidators exports the state of every module, and the
+// validator set, at the last committed height for a genesis file. No module
+// keeps height-dependent state, so forZeroHeight and jailAllowedAddrs have
+// nothing to reset.
 func (app *App) ExportAppStateAndValidators(forZeroHeight bool, jailAllowedAddrs []string) (servertypes.ExportedApp, error) {
-    // For now, return a basic implementation
-    // In a full implementation, you would export the actual state
+    ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+
+    appState, err := json.MarshalIndent(app.mm.ExportGenesis(ctx, app.cdc), "", "  ")
+    if err != nil {
+        return servertypes.ExportedApp{}, err
+    }
+
+    var validators []tmtypes.GenesisValidator
+    for _, validator := range app.StakingKeeper.GetAllValidators(ctx) {
+        validators = append(validators, validator.TendermintValidator())
+    }
+
     return servertypes.ExportedApp{
-        AppState:    []byte("{}"),
-        Validators:  []tmtypes.GenesisValidator{},
-        Height:      0,
-        ConsensusParams: nil,
+        AppState:        appState,
+        Validators:      validators,
+        Height:          app.LastBlockHeight() + 1,
+        ConsensusParams: app.GetConsensusParams(ctx),
     }, nil
 }
 
@@ -0,0 +1,100 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/stretchr/testify/require"
+
+	govtypes "skaffacity/x/governance/types"
+	marketplacetypes "skaffacity/x/marketplace/types"
+	nfttypes "skaffacity/x/nft/types"
+	stakingtypes "skaffacity/x/staking/types"
+	webtypes "skaffacity/x/web/types"
+)
+
+// defaultGenesis returns the default genesis state of every module with a
+// single validator
+func defaultGenesis(t *testing.T) GenesisState {
+	t.Helper()
+
+	app := NewApp(log.NewNopLogger(), dbm.NewMemDB(), nil, false, nil, "", 0, nil, nil)
+	genesis := GenesisState{}
+	for name, mod := range app.mm.Modules {
+		if basic, ok := mod.(module.HasGenesisBasics); ok {
+			genesis[name] = basic.DefaultGenesis(app.cdc)
+		}
+	}
+
+	staking := stakingtypes.GenesisState{Validators: []stakingtypes.GenesisValidator{{
+		Name:   "validator",
+		PubKey: ed25519.GenPrivKey().PubKey().Bytes(),
+		Power:  10,
+	}}}
+	bz, err := json.Marshal(staking)
+	require.NoError(t, err)
+	genesis[stakingtypes.ModuleName] = bz
+
+	return genesis
+}
+
+// initApp starts a new app from genesis and commits its first block
+func initApp(t *testing.T, genesis GenesisState) *App {
+	t.Helper()
+
+	app := NewApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, nil, "", 0, nil, nil)
+	appState, err := json.Marshal(genesis)
+	require.NoError(t, err)
+
+	res := app.InitChain(abci.RequestInitChain{AppStateBytes: appState})
+	require.Len(t, res.Validators, 1)
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	app.Commit()
+	return app
+}
+
+func TestExportReturnsTheGenesisState(t *testing.T) {
+	genesis := defaultGenesis(t)
+
+	exported, err := initApp(t, genesis).ExportAppStateAndValidators(false, nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), exported.Height)
+	require.Len(t, exported.Validators, 1)
+	require.Equal(t, int64(10), exported.Validators[0].Power)
+
+	var exportedGenesis GenesisState
+	require.NoError(t, json.Unmarshal(exported.AppState, &exportedGenesis))
+	for _, name := range []string{
+		nfttypes.ModuleName,
+		marketplacetypes.ModuleName,
+		govtypes.ModuleName,
+		stakingtypes.ModuleName,
+		webtypes.ModuleName,
+	} {
+		require.JSONEq(t, string(genesis[name]), string(exportedGenesis[name]), name)
+	}
+
+	var web webtypes.GenesisState
+	require.NoError(t, json.Unmarshal(exportedGenesis[webtypes.ModuleName], &web))
+	require.Equal(t, webtypes.DefaultWebConfig(), web.WebConfig)
+}
+
+func TestExportRestartsTheChain(t *testing.T) {
+	exported, err := initApp(t, defaultGenesis(t)).ExportAppStateAndValidators(false, nil)
+	require.NoError(t, err)
+
+	var genesis GenesisState
+	require.NoError(t, json.Unmarshal(exported.AppState, &genesis))
+	restarted, err := initApp(t, genesis).ExportAppStateAndValidators(false, nil)
+	require.NoError(t, err)
+	require.JSONEq(t, string(exported.AppState), string(restarted.AppState))
+	require.Equal(t, exported.Validators, restarted.Validators)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
 	"github.com/cosmos/cosmos-sdk/x/mint"
 	
 	// "skaffacity/x/governance"  // Commented out until AppModuleBasic implemented
@@ -25,6 +26,7 @@ var ModuleBasics = module.NewBasicManager(
 	auth.AppModuleBasic{},
 	authvesting.AppModuleBasic{},
 	bank.AppModuleBasic{},
+	crisis.AppModuleBasic{},
 	mint.AppModuleBasic{},
 	// nft.AppModuleBasic{},      // TODO: implement AppModuleBasic
 	// marketplace.AppModuleBasic{}, // TODO: implement AppModuleBasic  
@@ -0,0 +1,120 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	marketplacetypes "skaffacity/x/marketplace/types"
+	nftkeeper "skaffacity/x/nft/keeper"
+	nfttypes "skaffacity/x/nft/types"
+	webtypes "skaffacity/x/web/types"
+)
+
+// testAddr returns a distinct account address for each n
+func testAddr(n byte) sdk.AccAddress {
+	return sdk.AccAddress(append(make([]byte, 19), n))
+}
+
+// setGenesis replaces the genesis state of a module
+func setGenesis(t *testing.T, genesis GenesisState, moduleName string, state interface{}) {
+	t.Helper()
+
+	bz, err := json.Marshal(state)
+	require.NoError(t, err)
+	genesis[moduleName] = bz
+}
+
+// beginBlock begins the block at height and returns its events with a
+// context for delivering state changes in it
+func beginBlock(app *App, height int64) (sdk.Context, []abci.Event) {
+	header := tmproto.Header{Height: height}
+	res := app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	return app.NewContext(false, header), res.Events
+}
+
+// endBlock ends and commits the block at height
+func endBlock(app *App, height int64) {
+	app.EndBlock(abci.RequestEndBlock{Height: height})
+	app.Commit()
+}
+
+// findEvent returns the attributes of the first event of the given type
+func findEvent(t *testing.T, events []abci.Event, eventType string) map[string]string {
+	t.Helper()
+
+	for _, event := range events {
+		if event.Type != eventType {
+			continue
+		}
+		attributes := map[string]string{}
+		for _, attribute := range event.Attributes {
+			attributes[attribute.Key] = attribute.Value
+		}
+		return attributes
+	}
+	require.FailNow(t, "event not emitted", eventType)
+	return nil
+}
+
+func TestProtocolFeeIsSplitOnce(t *testing.T) {
+	seller, buyer, developer := testAddr(1), testAddr(2), testAddr(3)
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	genesis := defaultGenesis(t)
+	setGenesis(t, genesis, banktypes.ModuleName, banktypes.GenesisState{
+		Params:   banktypes.DefaultParams(),
+		Balances: []banktypes.Balance{{Address: buyer.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin("skaf", 10_000))}},
+		Supply:   sdk.NewCoins(sdk.NewInt64Coin("skaf", 10_000)),
+	})
+	params := marketplacetypes.DefaultParams()
+	params.ProtocolFeeBasisPoints = 500
+	marketplace := marketplacetypes.DefaultGenesisState()
+	marketplace.Params = params
+	setGenesis(t, genesis, marketplacetypes.ModuleName, marketplace)
+	web := webtypes.DefaultGenesisState()
+	web.WebConfig.FeeDistribution.DeveloperAddress = developer.String()
+	setGenesis(t, genesis, webtypes.ModuleName, web)
+
+	app := initApp(t, genesis)
+
+	// a sale for 10000 collects a protocol fee of 500
+	ctx, _ := beginBlock(app, 2)
+	nftServer := nftkeeper.NewMsgServerImpl(app.NFTKeeper)
+	_, err := nftServer.CreateClass(sdk.WrapSDKContext(ctx), nfttypes.NewMsgCreateClass(seller.String(), "swords", "Swords", "", seller.String(), 0, true, nfttypes.RoyaltyInfo{}, nfttypes.MetadataMutability{}))
+	require.NoError(t, err)
+	_, err = nftServer.MintNFT(sdk.WrapSDKContext(ctx), nfttypes.NewMsgMintNFT(seller.String(), "swords", "1", nfttypes.TypeItem, seller.String(), nfttypes.Metadata{Name: "Sword"}))
+	require.NoError(t, err)
+	listing, err := app.MarketKeeper.CreateListing(ctx, seller.String(), nfttypes.NFTID("swords", "1"), sdk.NewInt64Coin("skaf", 10_000), 0)
+	require.NoError(t, err)
+	_, err = app.MarketKeeper.BuyItem(ctx, buyer.String(), listing.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(500), app.BankKeeper.GetBalance(ctx, feeCollector, "skaf").Amount.Int64())
+	endBlock(app, 2)
+
+	// the next block gives the developer 10% of it and leaves the
+	// validators' 90% in the fee collector
+	ctx, events := beginBlock(app, 3)
+	require.Equal(t, int64(50), app.BankKeeper.GetBalance(ctx, developer, "skaf").Amount.Int64())
+	require.Equal(t, int64(450), app.BankKeeper.GetBalance(ctx, feeCollector, "skaf").Amount.Int64())
+	require.Equal(t, map[string]string{
+		"total_fees":           "500skaf",
+		"developer_fee":        "50skaf",
+		"validator_fee":        "450skaf",
+		"developer_percentage": "1000",
+		"validator_percentage": "9000",
+	}, findEvent(t, events, "fee_distribution"))
+	endBlock(app, 3)
+
+	// the validators' share is not split again
+	ctx, _ = beginBlock(app, 4)
+	require.Equal(t, int64(50), app.BankKeeper.GetBalance(ctx, developer, "skaf").Amount.Int64())
+	require.Equal(t, int64(450), app.BankKeeper.GetBalance(ctx, feeCollector, "skaf").Amount.Int64())
+	endBlock(app, 4)
+}
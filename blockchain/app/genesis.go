@@ -88,13 +88,22 @@ func NewDefaultGenesisState() GenesisState {
     
     mintGenesisJSON, _ := json.Marshal(mintGenesis)
     
+    // NFT genesis state: no classes, NFTs or badge issuers at launch
+    nftGenesisJSON, _ := json.Marshal(nfttypes.DefaultGenesisState())
+    
+    // Marketplace genesis state: default params and no open listings, auctions or offers
+    marketplaceGenesisJSON, _ := json.Marshal(marketplacetypes.DefaultGenesisState())
+    
+    // Governance genesis state: default deposit and voting params and no proposals
+    governanceGenesisJSON, _ := json.Marshal(govtypes.DefaultGenesisState())
+    
     return GenesisState{
         banktypes.ModuleName:        bankGenesisJSON,
         minttypes.ModuleName:        mintGenesisJSON,
         "auth":                      []byte(`{"params":{"max_memo_characters":"256","tx_sig_limit":"7","tx_size_cost_per_byte":"10","sig_verify_cost_ed25519":"590","sig_verify_cost_secp256k1":"1000"},"accounts":[]}`),
-        nfttypes.ModuleName:         []byte(`{}`),
-        marketplacetypes.ModuleName: []byte(`{}`),
-        govtypes.ModuleName:         []byte(`{}`),
+        nfttypes.ModuleName:         nftGenesisJSON,
+        marketplacetypes.ModuleName: marketplaceGenesisJSON,
+        govtypes.ModuleName:         governanceGenesisJSON,
         stakingtypes.ModuleName:     []byte(`{}`),
     }
 }
@@ -5,11 +5,14 @@ import (
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 
 	// "skaffacity/x/mint"      // Disabled temporarily due to type conflicts
@@ -117,6 +120,9 @@ func (mh *ModuleHandler) LoadAllModules(app *App, cdc codec.Codec, keys map[stri
 		govtypes.ModuleName,
 		stakingtypes.ModuleName,
 		webtypes.ModuleName,
+		// crisis comes last so that it checks the invariants once every
+		// other module has initialized or ended the block
+		crisistypes.ModuleName,
 	}
 
 	// Initialize and register all modules with their keepers
@@ -135,14 +141,27 @@ func (mh *ModuleHandler) LoadAllModules(app *App, cdc codec.Codec, keys map[stri
 	// Create module manager
 	mm := module.NewManager(modules...)
 	
-	// Set genesis order
+	// Set genesis and block order
 	mm.SetOrderInitGenesis(loadOrder...)
+	mm.SetOrderExportGenesis(loadOrder...)
+	mm.SetOrderBeginBlockers(loadOrder...)
+	mm.SetOrderEndBlockers(loadOrder...)
 	
 	mh.printLoadingSummary()
 	
 	return mm
 }
 
+// RegisterInterfaces registers the interface implementations, such as the
+// Msg types, of every loaded module
+func (mh *ModuleHandler) RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	for _, name := range mh.loaded {
+		if basic, ok := mh.modules[name].Module.(module.AppModuleBasic); ok {
+			basic.RegisterInterfaces(registry)
+		}
+	}
+}
+
 // initializeModules creates and registers all modules with their keepers
 func (mh *ModuleHandler) initializeModules(app *App, cdc codec.Codec, keys map[string]*storetypes.KVStoreKey, memKeys map[string]*storetypes.MemoryStoreKey) {
 	
@@ -166,6 +185,16 @@ func (mh *ModuleHandler) initializeModules(app *App, cdc codec.Codec, keys map[s
 		bankModule,
 	)
 
+	// Crisis Module
+	crisisModule := crisis.NewAppModule(&app.CrisisKeeper, false, nil)
+	mh.RegisterModule(
+		crisistypes.ModuleName,
+		"v0.47.0",
+		"Invariant checks",
+		&app.CrisisKeeper,
+		crisisModule,
+	)
+
 	// Mint Module - Disabled temporarily due to type conflicts
 	// mintModule := mint.NewAppModule(cdc, app.MintKeeper, app.AccountKeeper)
 	// mh.RegisterModule(
@@ -0,0 +1,26 @@
+package governance
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"skaffacity/x/governance/keeper"
+	"skaffacity/x/governance/types"
+)
+
+// EndBlocker fails the proposals whose deposit period has ended without
+// reaching the minimum deposit and tallies the proposals whose voting period
+// has ended by the end of the block.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	for _, proposal := range k.EndDepositPeriods(ctx) {
+		ctx.EventManager().EmitEvent(types.NewProposalFinishedEvent(proposal))
+	}
+
+	for _, proposal := range k.EndVotingPeriods(ctx) {
+		ctx.EventManager().EmitEvent(types.NewProposalFinishedEvent(proposal))
+	}
+}
@@ -0,0 +1,178 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"skaffacity/x/governance/types"
+)
+
+const FlagMessages = "messages"
+
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Governance transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdSubmitProposal(),
+		GetCmdDeposit(),
+		GetCmdVote(),
+	)
+
+	return cmd
+}
+
+func GetCmdSubmitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-proposal [title] [description] [initial-deposit]",
+		Short: "Submit a new governance proposal",
+		Long: `Submit a proposal with an initial deposit, for example 1000000000skaf. Once
+the deposits on the proposal reach the minimum deposit its voting period starts;
+a proposal that does not reach it within the deposit period fails. Deposits are
+refunded when the proposal is final.
+
+With --messages, the proposal executes messages as the governance module
+account when it passes. The file holds a JSON array of messages, each with
+its type url and its JSON encoding, for example:
+
+[{"type_url": "/skaffacity.web.MsgEnableFeeDistribution",
+  "value": {"creator": "<governance module address>", "enabled": true}}]`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			var messages []types.ProposalMessage
+			messagesFile, err := cmd.Flags().GetString(FlagMessages)
+			if err != nil {
+				return err
+			}
+			if messagesFile != "" {
+				if messages, err = parseProposalMessages(messagesFile); err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgSubmitProposal(clientCtx.GetFromAddress().String(), args[0], args[1], deposit, messages)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMessages, "", "JSON file of messages to execute when the proposal passes")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseProposalMessages reads the messages of a proposal from a JSON file
+func parseProposalMessages(path string) ([]types.ProposalMessage, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var messages []types.ProposalMessage
+	if err := json.Unmarshal(bz, &messages); err != nil {
+		return nil, fmt.Errorf("%s must hold a JSON array of messages: %w", path, err)
+	}
+	return messages, nil
+}
+
+func GetCmdDeposit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit [proposal-id] [amount]",
+		Short: "Deposit coins on a proposal in its deposit period",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := parseProposalID(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeposit(clientCtx.GetFromAddress().String(), proposalID, amount)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote [proposal-id] [option]",
+		Short: "Vote yes, no or abstain on a proposal in its voting period",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := parseProposalID(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgVote(clientCtx.GetFromAddress().String(), proposalID, args[1])
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseProposalID parses a proposal ID argument
+func parseProposalID(arg string) (uint64, error) {
+	id, err := strconv.ParseUint(arg, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid proposal id %q: %w", arg, err)
+	}
+	return id, nil
+}
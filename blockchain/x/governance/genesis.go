@@ -0,0 +1,45 @@
+package governance
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"skaffacity/x/governance/keeper"
+	"skaffacity/x/governance/types"
+)
+
+// InitGenesis loads params, proposals, deposits and votes from genesis,
+// rebuilding the deposit and voting queues, and
+// restores the proposal ID counter. The deposits are not moved: the bank
+// genesis must already credit them to the governance module account.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	k.SetDepositParams(ctx, genState.DepositParams)
+	k.SetVotingParams(ctx, genState.VotingParams)
+
+	for _, proposal := range genState.Proposals {
+		k.ImportProposal(ctx, proposal)
+	}
+
+	for _, deposit := range genState.Deposits {
+		k.SetDeposit(ctx, deposit)
+	}
+
+	for _, vote := range genState.Votes {
+		k.SetVote(ctx, vote)
+	}
+
+	k.SetNextProposalID(ctx, genState.NextProposalID)
+}
+
+// ExportGenesis returns the governance module's exported genesis
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	genesis := types.DefaultGenesisState()
+
+	genesis.DepositParams = k.GetDepositParams(ctx)
+	genesis.VotingParams = k.GetVotingParams(ctx)
+	genesis.Proposals = append(genesis.Proposals, k.GetAllProposals(ctx)...)
+	genesis.NextProposalID = k.GetNextProposalID(ctx)
+	genesis.Deposits = append(genesis.Deposits, k.GetAllDeposits(ctx)...)
+	genesis.Votes = append(genesis.Votes, k.GetAllVotes(ctx)...)
+
+	return genesis
+}
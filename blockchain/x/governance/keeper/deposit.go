@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"skaffacity/x/governance/types"
+)
+
+// AddDeposit moves amount from depositor into the module account towards
+// the minimum deposit of a proposal in its deposit period. votingStarted is
+// true when the deposit brings the proposal to its minimum deposit and
+// starts its voting period.
+func (k Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositor string, amount sdk.Coins) (votingStarted bool, err error) {
+	proposal, found := k.GetProposal(ctx, proposalID)
+	if !found {
+		return false, sdkerrors.Wrapf(types.ErrProposalNotFound, "proposal %d", proposalID)
+	}
+	if proposal.Status != types.StatusSubmitted || !ctx.BlockTime().Before(proposal.DepositEndTime) {
+		return false, sdkerrors.Wrapf(types.ErrDepositPeriodEnded, "proposal %d", proposalID)
+	}
+
+	if err := amount.Validate(); err != nil || amount.IsZero() {
+		return false, sdkerrors.Wrapf(types.ErrInvalidDeposit, "invalid deposit %s", amount)
+	}
+
+	depositorAddr, err := sdk.AccAddressFromBech32(depositor)
+	if err != nil {
+		return false, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid depositor address (%s)", err)
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, depositorAddr, types.ModuleAddress, amount); err != nil {
+		return false, err
+	}
+
+	deposit, found := k.GetDeposit(ctx, proposalID, depositor)
+	if !found {
+		deposit = types.Deposit{ProposalID: proposalID, Depositor: depositor}
+	}
+	deposit.Amount = deposit.Amount.Add(amount...)
+	k.SetDeposit(ctx, deposit)
+
+	proposal.TotalDeposit = proposal.TotalDeposit.Add(amount...)
+	if proposal.TotalDeposit.IsAllGTE(k.GetDepositParams(ctx).MinDeposit) {
+		k.startVoting(ctx, &proposal)
+		return true, nil
+	}
+
+	k.SetProposal(ctx, proposal)
+	return false, nil
+}
+
+// GetDeposit returns a depositor's deposit on a proposal
+func (k Keeper) GetDeposit(ctx sdk.Context, proposalID uint64, depositor string) (types.Deposit, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetDepositKey(proposalID, depositor))
+	if bz == nil {
+		return types.Deposit{}, false
+	}
+
+	var deposit types.Deposit
+	k.cdc.MustUnmarshal(bz, &deposit)
+	return deposit, true
+}
+
+// SetDeposit stores a deposit
+func (k Keeper) SetDeposit(ctx sdk.Context, deposit types.Deposit) {
+	ctx.KVStore(k.storeKey).Set(types.GetDepositKey(deposit.ProposalID, deposit.Depositor), k.cdc.MustMarshal(&deposit))
+}
+
+// GetDeposits returns the deposits on a proposal
+func (k Keeper) GetDeposits(ctx sdk.Context, proposalID uint64) []types.Deposit {
+	return k.getDeposits(ctx, types.GetDepositPrefix(proposalID))
+}
+
+// GetAllDeposits returns the deposits on every proposal that is not final
+func (k Keeper) GetAllDeposits(ctx sdk.Context) []types.Deposit {
+	return k.getDeposits(ctx, types.DepositKey)
+}
+
+func (k Keeper) getDeposits(ctx sdk.Context, keyPrefix []byte) []types.Deposit {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var deposits []types.Deposit
+	for ; iterator.Valid(); iterator.Next() {
+		var deposit types.Deposit
+		k.cdc.MustUnmarshal(iterator.Value(), &deposit)
+		deposits = append(deposits, deposit)
+	}
+	return deposits
+}
+
+// refundDeposits returns the deposits on a proposal to their depositors and
+// deletes them
+func (k Keeper) refundDeposits(ctx sdk.Context, proposalID uint64) error {
+	store := ctx.KVStore(k.storeKey)
+	for _, deposit := range k.GetDeposits(ctx, proposalID) {
+		depositor, err := sdk.AccAddressFromBech32(deposit.Depositor)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid depositor address (%s)", err)
+		}
+		if err := k.bankKeeper.SendCoins(ctx, types.ModuleAddress, depositor, deposit.Amount); err != nil {
+			return err
+		}
+		store.Delete(types.GetDepositKey(proposalID, deposit.Depositor))
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"skaffacity/x/governance/types"
+)
+
+// proposalMsg is a decoded proposal message with the handler that executes it
+type proposalMsg struct {
+	msg     sdk.Msg
+	handler sdk.Handler
+}
+
+// unpackMessages decodes the messages of a proposal and checks that each can
+// be executed: it has a route, is valid and is signed by the governance
+// module account alone
+func (k Keeper) unpackMessages(messages []types.ProposalMessage) ([]proposalMsg, error) {
+	if err := types.ValidateProposalMessages(messages); err != nil {
+		return nil, err
+	}
+	if len(messages) > 0 && k.router == nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidProposalMsg, "proposals cannot carry messages on this chain")
+	}
+
+	msgs := make([]proposalMsg, 0, len(messages))
+	for i, message := range messages {
+		msg, handler, err := k.router.Unpack(message)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "message %d", i)
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidProposalMsg, "message %d (%s): %s", i, message.TypeURL, err)
+		}
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(types.ModuleAddress) {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidProposalMsg, "message %d (%s) must be signed by the governance module account %s", i, message.TypeURL, types.ModuleAddress)
+		}
+		msgs = append(msgs, proposalMsg{msg: msg, handler: handler})
+	}
+	return msgs, nil
+}
+
+// executeProposal executes the messages of a passed proposal in order. Their
+// state changes and events are only kept if every message succeeds.
+func (k Keeper) executeProposal(ctx sdk.Context, proposal types.Proposal) (err error) {
+	msgs, err := k.unpackMessages(proposal.Messages)
+	if err != nil {
+		return err
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	defer func() {
+		// a panicking handler fails the proposal rather than the block
+		if r := recover(); r != nil {
+			err = fmt.Errorf("message panicked: %v", r)
+		}
+	}()
+
+	for i, m := range msgs {
+		if _, err := m.handler(cacheCtx, m.msg); err != nil {
+			return sdkerrors.Wrapf(err, "message %d (%s)", i, sdk.MsgTypeURL(m.msg))
+		}
+	}
+	write()
+	return nil
+}
@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"skaffacity/x/governance/types"
+	nfttypes "skaffacity/x/nft/types"
+)
+
+// proposalMessages encodes msgs for a proposal
+func proposalMessages(t *testing.T, msgs ...sdk.Msg) []types.ProposalMessage {
+	t.Helper()
+
+	messages, err := types.NewProposalMessages(msgs...)
+	require.NoError(t, err)
+	return messages
+}
+
+// pass votes yes on a proposal with enough stake to reach quorum and ends
+// its voting period
+func (f *fixture) pass(t *testing.T, proposalID uint64) types.Proposal {
+	t.Helper()
+
+	require.NoError(t, f.keeper.Vote(f.ctx, proposalID, testAddr(1), string(types.VoteYes)))
+	return f.endVoting(t, proposalID)
+}
+
+func TestPassedProposalExecutesItsMessages(t *testing.T) {
+	f := setupKeeper(t)
+	f.stake(testAddr(1), 10_000_000, genesisTime.Add(-time.Hour))
+
+	authority := types.ModuleAddress.String()
+	proposal := f.startVoting(t, proposalMessages(t,
+		nfttypes.NewMsgAddBadgeIssuer(authority, testAddr(7), "City Hall"),
+		nfttypes.NewMsgAddBadgeIssuer(authority, testAddr(8), "Harbour Office"),
+	))
+
+	proposal = f.pass(t, proposal.ID)
+	require.Equal(t, types.StatusPassed, proposal.Status)
+	require.Empty(t, proposal.ExecutionError)
+	require.True(t, f.nft.IsBadgeIssuer(f.ctx, testAddr(7)))
+	require.True(t, f.nft.IsBadgeIssuer(f.ctx, testAddr(8)))
+}
+
+func TestFailedMessageRevertsTheWholeProposal(t *testing.T) {
+	f := setupKeeper(t)
+	f.stake(testAddr(1), 10_000_000, genesisTime.Add(-time.Hour))
+
+	// the second message fails as testAddr(8) is no badge issuer, which
+	// must undo the first
+	authority := types.ModuleAddress.String()
+	proposal := f.startVoting(t, proposalMessages(t,
+		nfttypes.NewMsgAddBadgeIssuer(authority, testAddr(7), "City Hall"),
+		nfttypes.NewMsgRemoveBadgeIssuer(authority, testAddr(8)),
+	))
+
+	proposal = f.pass(t, proposal.ID)
+	require.Equal(t, types.StatusFailed, proposal.Status)
+	require.Contains(t, proposal.ExecutionError, "message 1")
+	require.False(t, f.nft.IsBadgeIssuer(f.ctx, testAddr(7)))
+
+	// the deposit is refunded all the same
+	require.Equal(t, f.keeper.GetDepositParams(f.ctx).MinDeposit, f.bank[testAddr(100)])
+}
+
+func TestRejectedProposalDoesNotExecute(t *testing.T) {
+	f := setupKeeper(t)
+	f.stake(testAddr(1), 10_000_000, genesisTime.Add(-time.Hour))
+
+	proposal := f.startVoting(t, proposalMessages(t,
+		nfttypes.NewMsgAddBadgeIssuer(types.ModuleAddress.String(), testAddr(7), "City Hall"),
+	))
+	require.NoError(t, f.keeper.Vote(f.ctx, proposal.ID, testAddr(1), string(types.VoteNo)))
+
+	proposal = f.endVoting(t, proposal.ID)
+	require.Equal(t, types.StatusRejected, proposal.Status)
+	require.False(t, f.nft.IsBadgeIssuer(f.ctx, testAddr(7)))
+}
+
+func TestSubmitProposalChecksItsMessages(t *testing.T) {
+	f := setupKeeper(t)
+	proposer := testAddr(100)
+	minDeposit := f.keeper.GetDepositParams(f.ctx).MinDeposit
+	f.bank[proposer] = minDeposit
+
+	testCases := []struct {
+		name     string
+		messages []types.ProposalMessage
+	}{
+		{
+			"not signed by the governance module account",
+			proposalMessages(t, nfttypes.NewMsgAddBadgeIssuer(testAddr(1), testAddr(7), "City Hall")),
+		},
+		{
+			"no route",
+			proposalMessages(t, nfttypes.NewMsgMintNFT(types.ModuleAddress.String(), "swords", "1", nfttypes.TypeItem, testAddr(7), nfttypes.Metadata{Name: "Sword"})),
+		},
+		{
+			"misspelt field",
+			[]types.ProposalMessage{{
+				TypeURL: sdk.MsgTypeURL(&nfttypes.MsgAddBadgeIssuer{}),
+				Value:   []byte(`{"authority":"` + types.ModuleAddress.String() + `","isuer":"` + testAddr(7) + `"}`),
+			}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := f.keeper.SubmitProposal(f.ctx, proposer, "title", "description", minDeposit, tc.messages)
+			require.ErrorIs(t, err, types.ErrInvalidProposalMsg)
+		})
+	}
+	require.Equal(t, minDeposit, f.bank[proposer])
+}
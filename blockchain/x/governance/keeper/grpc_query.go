@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"skaffacity/x/governance/types"
+)
+
+type queryServer struct {
+	Keeper
+}
+
+func NewQueryServer(k Keeper) types.QueryServer {
+	return &queryServer{Keeper: k}
+}
+
+var _ types.QueryServer = queryServer{}
+
+// Proposal returns a single proposal
+func (k queryServer) Proposal(c context.Context, req *types.QueryProposalRequest) (*types.QueryProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	proposal, found := k.GetProposal(ctx, req.ProposalId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "proposal %d not found", req.ProposalId)
+	}
+
+	return &types.QueryProposalResponse{Proposal: proposal}, nil
+}
+
+// Proposals returns a page of the proposals, optionally only those with a status
+func (k queryServer) Proposals(c context.Context, req *types.QueryProposalsRequest) (*types.QueryProposalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ProposalKey)
+
+	proposals := []types.Proposal{}
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var proposal types.Proposal
+		if err := k.cdc.Unmarshal(value, &proposal); err != nil {
+			return false, err
+		}
+		if req.Status != "" && proposal.Status != req.Status {
+			return false, nil
+		}
+		if accumulate {
+			proposals = append(proposals, proposal)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryProposalsResponse{Proposals: proposals, Pagination: pageRes}, nil
+}
+
+// Vote returns the vote of an account on a proposal
+func (k queryServer) Vote(c context.Context, req *types.QueryVoteRequest) (*types.QueryVoteResponse, error) {
+	if req == nil || req.Voter == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	vote, found := k.GetVote(ctx, req.ProposalId, req.Voter)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "%s has not voted on proposal %d", req.Voter, req.ProposalId)
+	}
+
+	return &types.QueryVoteResponse{Vote: vote}, nil
+}
+
+// Votes returns a page of the votes on a proposal
+func (k queryServer) Votes(c context.Context, req *types.QueryVotesRequest) (*types.QueryVotesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetVotePrefix(req.ProposalId))
+
+	votes := []types.Vote{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var vote types.Vote
+		if err := k.cdc.Unmarshal(value, &vote); err != nil {
+			return err
+		}
+		votes = append(votes, vote)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVotesResponse{Votes: votes, Pagination: pageRes}, nil
+}
+
+// Deposits returns a page of the deposits on a proposal that is not final yet
+func (k queryServer) Deposits(c context.Context, req *types.QueryDepositsRequest) (*types.QueryDepositsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetDepositPrefix(req.ProposalId))
+
+	deposits := []types.Deposit{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var deposit types.Deposit
+		if err := k.cdc.Unmarshal(value, &deposit); err != nil {
+			return err
+		}
+		deposits = append(deposits, deposit)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDepositsResponse{Deposits: deposits, Pagination: pageRes}, nil
+}
+
+// Tally returns the tally of a proposal. While it is being voted on the
+// tally holds the votes cast so far.
+func (k queryServer) Tally(c context.Context, req *types.QueryTallyRequest) (*types.QueryTallyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	proposal, found := k.GetProposal(ctx, req.ProposalId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "proposal %d not found", req.ProposalId)
+	}
+
+	if proposal.Status == types.StatusVotingPeriod {
+		yes, no, abstain, _ := k.Keeper.Tally(ctx, proposal.ID)
+		return &types.QueryTallyResponse{Yes: yes, No: no, Abstain: abstain}, nil
+	}
+
+	return &types.QueryTallyResponse{Yes: proposal.YesVotes, No: proposal.NoVotes, Abstain: proposal.AbstainVotes}, nil
+}
+
+// Params returns the governance deposit and voting params
+func (k queryServer) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{
+		DepositParams: k.GetDepositParams(ctx),
+		VotingParams:  k.GetVotingParams(ctx),
+	}, nil
+}
@@ -2,42 +2,61 @@ package keeper
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
-	sdk "github.com/cosmos/cosmos-sdk/types"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"skaffacity/x/governance/types"
 )
 
 type Keeper struct {
 	storeKey      storetypes.StoreKey
 	cdc           codec.BinaryCodec
+	bankKeeper    types.BankKeeper
 	stakingKeeper types.StakingKeeper
+	// router executes the messages of passed proposals; without one,
+	// proposals cannot carry messages
+	router *types.Router
 }
 
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
+	bankKeeper types.BankKeeper,
 	stakingKeeper types.StakingKeeper,
 ) *Keeper {
 	return &Keeper{
 		storeKey:      storeKey,
 		cdc:           cdc,
+		bankKeeper:    bankKeeper,
 		stakingKeeper: stakingKeeper,
 	}
 }
 
-// Basic stub methods
-func (k Keeper) CreateProposal(ctx sdk.Context, title, description, proposer string) (uint64, error) {
-	return 1, nil
+// SetRouter sets the router that executes the messages of passed proposals
+// and seals it. It panics when a router is already set.
+func (k *Keeper) SetRouter(router *types.Router) {
+	if k.router != nil {
+		panic("governance router already set")
+	}
+	router.Seal()
+	k.router = router
 }
 
-func (k Keeper) Vote(ctx sdk.Context, proposalID uint64, voter, voteOption string) error {
-	return nil
+// Router returns the router that executes the messages of passed proposals
+func (k Keeper) Router() *types.Router {
+	return k.router
 }
 
-func (k Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (types.Proposal, bool) {
-	return types.Proposal{}, false
+// GetNextProposalID returns the ID the next proposal will get
+func (k Keeper) GetNextProposalID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextProposalIDKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
 }
 
-func (k Keeper) IsProposalActive(ctx sdk.Context, proposalID uint64) bool {
-	return false
+// SetNextProposalID sets the ID the next proposal will get
+func (k Keeper) SetNextProposalID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextProposalIDKey, sdk.Uint64ToBigEndian(id))
 }
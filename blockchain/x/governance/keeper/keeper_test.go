@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"skaffacity/x/governance/keeper"
+	"skaffacity/x/governance/types"
+	"skaffacity/x/nft"
+	nftkeeper "skaffacity/x/nft/keeper"
+	nfttypes "skaffacity/x/nft/types"
+	stakingkeeper "skaffacity/x/staking/keeper"
+	stakingtypes "skaffacity/x/staking/types"
+)
+
+// genesisTime is the block time every test starts at
+var genesisTime = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// testAddr returns a distinct account address for each n
+func testAddr(n byte) string {
+	return sdk.AccAddress(append(make([]byte, 19), n)).String()
+}
+
+// fakeBank is an in-memory BankKeeper
+type fakeBank map[string]sdk.Coins
+
+func (b fakeBank) SendCoins(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	balance := b[fromAddr.String()]
+	if !balance.IsAllGTE(amt) {
+		return fmt.Errorf("%s has %s, cannot send %s", fromAddr, balance, amt)
+	}
+	b[fromAddr.String()] = balance.Sub(amt...)
+	b[toAddr.String()] = b[toAddr.String()].Add(amt...)
+	return nil
+}
+
+type fixture struct {
+	ctx     sdk.Context
+	keeper  *keeper.Keeper
+	staking *stakingkeeper.Keeper
+	nft     *nftkeeper.Keeper
+	bank    fakeBank
+}
+
+// setupKeeper returns a governance keeper backed by the real staking keeper
+// and a fake bank, at genesisTime. Proposals can execute the badge issuer
+// messages of the real nft keeper, whose authority is the governance module
+// account.
+func setupKeeper(t *testing.T) fixture {
+	t.Helper()
+
+	govKey := storetypes.NewKVStoreKey(types.StoreKey)
+	stakingKey := storetypes.NewKVStoreKey(stakingtypes.StoreKey)
+	nftKey := storetypes.NewKVStoreKey(nfttypes.StoreKey)
+
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(govKey, storetypes.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(stakingKey, storetypes.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(nftKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(cms, tmproto.Header{Time: genesisTime}, false, log.NewNopLogger())
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	bank := fakeBank{}
+	staking := stakingkeeper.NewKeeper(cdc, stakingKey, nil)
+	nftKeeper := nftkeeper.NewKeeper(cdc, nftKey, nil, types.ModuleAddress.String())
+
+	k := keeper.NewKeeper(cdc, govKey, bank, staking)
+	k.SetRouter(types.NewRouter().
+		AddRoute(&nfttypes.MsgAddBadgeIssuer{}, nft.NewHandler(*nftKeeper)).
+		AddRoute(&nfttypes.MsgRemoveBadgeIssuer{}, nft.NewHandler(*nftKeeper)))
+
+	return fixture{
+		ctx:     ctx,
+		keeper:  k,
+		staking: staking,
+		nft:     nftKeeper,
+		bank:    bank,
+	}
+}
+
+// stake sets the delegation of addr to amount in a block at the given time
+func (f fixture) stake(addr string, amount int64, at time.Time) {
+	f.staking.SetDelegation(f.ctx.WithBlockTime(at), &stakingtypes.Delegation{
+		DelegatorAddress: addr,
+		Amount:           sdk.NewInt(amount),
+	})
+}
+
+// startVoting submits a proposal with the minimum deposit, which puts it
+// straight into its voting period
+func (f fixture) startVoting(t *testing.T, messages []types.ProposalMessage) types.Proposal {
+	t.Helper()
+
+	proposer := testAddr(100)
+	minDeposit := f.keeper.GetDepositParams(f.ctx).MinDeposit
+	f.bank[proposer] = f.bank[proposer].Add(minDeposit...)
+
+	proposal, err := f.keeper.SubmitProposal(f.ctx, proposer, "title", "description", minDeposit, messages)
+	require.NoError(t, err)
+	require.Equal(t, types.StatusVotingPeriod, proposal.Status)
+	return proposal
+}
+
+// endVoting moves the block time to the end of the proposal's voting period,
+// when votes are no longer accepted, and tallies it
+func (f *fixture) endVoting(t *testing.T, proposalID uint64) types.Proposal {
+	t.Helper()
+
+	proposal, found := f.keeper.GetProposal(f.ctx, proposalID)
+	require.True(t, found)
+	f.ctx = f.ctx.WithBlockTime(proposal.VotingEndTime)
+	f.keeper.EndVotingPeriods(f.ctx)
+
+	proposal, found = f.keeper.GetProposal(f.ctx, proposalID)
+	require.True(t, found)
+	return proposal
+}
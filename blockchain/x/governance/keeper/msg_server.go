@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"skaffacity/x/governance/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (k msgServer) SubmitProposal(goCtx context.Context, msg *types.MsgSubmitProposal) (*types.MsgSubmitProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	proposal, err := k.Keeper.SubmitProposal(ctx, msg.Proposer, msg.Title, msg.Description, msg.InitialDeposit, msg.Messages)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSubmitProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(proposal.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyProposer, proposal.Proposer),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.InitialDeposit.String()),
+		),
+	)
+	if proposal.Status == types.StatusVotingPeriod {
+		ctx.EventManager().EmitEvent(types.NewVotingStartedEvent(proposal))
+	}
+
+	return &types.MsgSubmitProposalResponse{ProposalID: proposal.ID}, nil
+}
+
+func (k msgServer) Deposit(goCtx context.Context, msg *types.MsgDeposit) (*types.MsgDepositResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	votingStarted, err := k.Keeper.AddDeposit(ctx, msg.ProposalID, msg.Depositor, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProposalDeposit,
+			sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(msg.ProposalID, 10)),
+			sdk.NewAttribute(types.AttributeKeyDepositor, msg.Depositor),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+	)
+	if votingStarted {
+		proposal, _ := k.GetProposal(ctx, msg.ProposalID)
+		ctx.EventManager().EmitEvent(types.NewVotingStartedEvent(proposal))
+	}
+
+	return &types.MsgDepositResponse{VotingStarted: votingStarted}, nil
+}
+
+func (k msgServer) Vote(goCtx context.Context, msg *types.MsgVote) (*types.MsgVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.Vote(ctx, msg.ProposalID, msg.Voter, msg.Option); err != nil {
+		return nil, err
+	}
+	vote, _ := k.GetVote(ctx, msg.ProposalID, msg.Voter)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProposalVote,
+			sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(msg.ProposalID, 10)),
+			sdk.NewAttribute(types.AttributeKeyVoter, msg.Voter),
+			sdk.NewAttribute(types.AttributeKeyOption, msg.Option),
+			sdk.NewAttribute(types.AttributeKeyWeight, vote.Weight.String()),
+		),
+	)
+
+	return &types.MsgVoteResponse{}, nil
+}
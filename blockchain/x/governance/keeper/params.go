@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"skaffacity/x/governance/types"
+)
+
+// GetDepositParams returns the deposit params, or the defaults if none are set
+func (k Keeper) GetDepositParams(ctx sdk.Context) types.DepositParams {
+	bz := ctx.KVStore(k.storeKey).Get(types.DepositParamsKey)
+	if bz == nil {
+		return types.DefaultDepositParams()
+	}
+
+	var params types.DepositParams
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetDepositParams stores the deposit params
+func (k Keeper) SetDepositParams(ctx sdk.Context, params types.DepositParams) {
+	ctx.KVStore(k.storeKey).Set(types.DepositParamsKey, k.cdc.MustMarshal(&params))
+}
+
+// GetVotingParams returns the voting params, or the defaults if none are set
+func (k Keeper) GetVotingParams(ctx sdk.Context) types.VotingParams {
+	bz := ctx.KVStore(k.storeKey).Get(types.VotingParamsKey)
+	if bz == nil {
+		return types.DefaultVotingParams()
+	}
+
+	var params types.VotingParams
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetVotingParams stores the voting params
+func (k Keeper) SetVotingParams(ctx sdk.Context, params types.VotingParams) {
+	ctx.KVStore(k.storeKey).Set(types.VotingParamsKey, k.cdc.MustMarshal(&params))
+}
@@ -0,0 +1,233 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"skaffacity/x/governance/types"
+)
+
+// SubmitProposal creates a proposal in its deposit period and makes the
+// proposer's initial deposit, which may already start the voting period.
+// The messages the proposal executes when it passes are checked up front.
+func (k Keeper) SubmitProposal(ctx sdk.Context, proposer, title, description string, initialDeposit sdk.Coins, messages []types.ProposalMessage) (types.Proposal, error) {
+	if err := types.ValidateProposalContent(title, description); err != nil {
+		return types.Proposal{}, err
+	}
+	if _, err := k.unpackMessages(messages); err != nil {
+		return types.Proposal{}, err
+	}
+
+	id := k.GetNextProposalID(ctx)
+	k.SetNextProposalID(ctx, id+1)
+
+	proposal := types.Proposal{
+		ID:             id,
+		Title:          title,
+		Description:    description,
+		Proposer:       proposer,
+		Status:         types.StatusSubmitted,
+		SubmitTime:     ctx.BlockTime(),
+		YesVotes:       sdk.ZeroDec(),
+		NoVotes:        sdk.ZeroDec(),
+		AbstainVotes:   sdk.ZeroDec(),
+		TotalDeposit:   sdk.NewCoins(),
+		DepositEndTime: ctx.BlockTime().Add(k.GetDepositParams(ctx).MaxDepositPeriod),
+		TotalStake:     sdk.ZeroInt(),
+		Messages:       messages,
+	}
+	k.setProposal(ctx, proposal)
+
+	if !initialDeposit.IsZero() {
+		if _, err := k.AddDeposit(ctx, proposal.ID, proposer, initialDeposit); err != nil {
+			return types.Proposal{}, err
+		}
+	}
+
+	proposal, _ = k.GetProposal(ctx, proposal.ID)
+	return proposal, nil
+}
+
+// GetProposal returns a proposal by ID
+func (k Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (types.Proposal, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetProposalKey(proposalID))
+	if bz == nil {
+		return types.Proposal{}, false
+	}
+
+	var proposal types.Proposal
+	k.cdc.MustUnmarshal(bz, &proposal)
+	return proposal, true
+}
+
+// SetProposal stores a proposal
+func (k Keeper) SetProposal(ctx sdk.Context, proposal types.Proposal) {
+	ctx.KVStore(k.storeKey).Set(types.GetProposalKey(proposal.ID), k.cdc.MustMarshal(&proposal))
+}
+
+// ImportProposal stores a proposal from genesis with its deposit or voting
+// queue entry
+func (k Keeper) ImportProposal(ctx sdk.Context, proposal types.Proposal) {
+	k.setProposal(ctx, proposal)
+}
+
+// GetAllProposals returns every proposal, final or not
+func (k Keeper) GetAllProposals(ctx sdk.Context) []types.Proposal {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ProposalKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var proposals []types.Proposal
+	for ; iterator.Valid(); iterator.Next() {
+		var proposal types.Proposal
+		k.cdc.MustUnmarshal(iterator.Value(), &proposal)
+		proposals = append(proposals, proposal)
+	}
+	return proposals
+}
+
+// IsProposalActive reports whether a proposal can be voted on
+func (k Keeper) IsProposalActive(ctx sdk.Context, proposalID uint64) bool {
+	proposal, found := k.GetProposal(ctx, proposalID)
+	return found && proposal.Status == types.StatusVotingPeriod && ctx.BlockTime().Before(proposal.VotingEndTime)
+}
+
+// EndDepositPeriods fails the proposals whose deposit period has ended
+// without reaching the minimum deposit and refunds their deposits
+func (k Keeper) EndDepositPeriods(ctx sdk.Context) []types.Proposal {
+	var failed []types.Proposal
+	for _, proposal := range k.dueProposals(ctx, types.DepositQueueKey, types.GetDepositQueuePrefix(ctx.BlockTime())) {
+		if proposal.Status != types.StatusSubmitted {
+			continue
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.finishProposal(cacheCtx, &proposal, types.StatusFailed); err != nil {
+			ctx.Logger().Error("failed to end deposit period", "proposal_id", proposal.ID, "err", err)
+			continue
+		}
+		write()
+		failed = append(failed, proposal)
+	}
+	return failed
+}
+
+// EndVotingPeriods tallies the proposals whose voting period has ended,
+// executes the messages of those that passed, moves them to their final
+// status and refunds their deposits. A passed proposal whose messages fail
+// is failed with its execution error, and none of its messages take effect.
+func (k Keeper) EndVotingPeriods(ctx sdk.Context) []types.Proposal {
+	var finished []types.Proposal
+	for _, proposal := range k.dueProposals(ctx, types.VotingQueueKey, types.GetVotingQueuePrefix(ctx.BlockTime())) {
+		if proposal.Status != types.StatusVotingPeriod {
+			continue
+		}
+
+		var voted sdk.Int
+		proposal.YesVotes, proposal.NoVotes, proposal.AbstainVotes, voted = k.Tally(ctx, proposal.ID)
+		status := types.TallyStatus(proposal.YesVotes, proposal.NoVotes, voted, proposal.TotalStake, k.GetVotingParams(ctx).QuorumThreshold)
+
+		// finish each proposal on its own so a failed refund leaves the
+		// others untouched
+		cacheCtx, write := ctx.CacheContext()
+		if status == types.StatusPassed {
+			if err := k.executeProposal(cacheCtx, proposal); err != nil {
+				status = types.StatusFailed
+				proposal.ExecutionError = err.Error()
+			}
+		}
+		if err := k.finishProposal(cacheCtx, &proposal, status); err != nil {
+			ctx.Logger().Error("failed to end voting period", "proposal_id", proposal.ID, "err", err)
+			continue
+		}
+		write()
+		finished = append(finished, proposal)
+	}
+	return finished
+}
+
+// dueProposals returns the proposals queued under queueKey up to and
+// including the time of the queue prefix end
+func (k Keeper) dueProposals(ctx sdk.Context, queueKey, end []byte) []types.Proposal {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(queueKey, sdk.PrefixEndBytes(end))
+	defer iterator.Close()
+
+	var ids []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		ids = append(ids, sdk.BigEndianToUint64(iterator.Value()))
+	}
+
+	proposals := make([]types.Proposal, 0, len(ids))
+	for _, id := range ids {
+		if proposal, found := k.GetProposal(ctx, id); found {
+			proposals = append(proposals, proposal)
+		}
+	}
+	return proposals
+}
+
+// startVoting moves a proposal that reached its minimum deposit from the
+// deposit queue into its voting period and records the total stake its
+// quorum is measured against
+func (k Keeper) startVoting(ctx sdk.Context, proposal *types.Proposal) {
+	k.removeFromQueue(ctx, *proposal)
+	proposal.Status = types.StatusVotingPeriod
+	proposal.VotingStartTime = ctx.BlockTime()
+	proposal.VotingEndTime = ctx.BlockTime().Add(k.GetVotingParams(ctx).VotingPeriod)
+	proposal.TotalStake = k.stakingKeeper.GetTotalStake(ctx)
+	k.setProposal(ctx, *proposal)
+}
+
+// finishProposal gives a proposal its final status, takes it off its queue,
+// and refunds its deposits
+func (k Keeper) finishProposal(ctx sdk.Context, proposal *types.Proposal, status string) error {
+	if err := k.refundDeposits(ctx, proposal.ID); err != nil {
+		return err
+	}
+	k.removeFromQueue(ctx, *proposal)
+	proposal.Status = status
+	k.SetProposal(ctx, *proposal)
+	return nil
+}
+
+// setProposal stores a proposal and, while it is in its deposit or voting
+// period, its queue entry
+func (k Keeper) setProposal(ctx sdk.Context, proposal types.Proposal) {
+	k.SetProposal(ctx, proposal)
+
+	store := ctx.KVStore(k.storeKey)
+	switch proposal.Status {
+	case types.StatusSubmitted:
+		store.Set(types.GetDepositQueueKey(proposal.DepositEndTime, proposal.ID), sdk.Uint64ToBigEndian(proposal.ID))
+	case types.StatusVotingPeriod:
+		store.Set(types.GetVotingQueueKey(proposal.VotingEndTime, proposal.ID), sdk.Uint64ToBigEndian(proposal.ID))
+	}
+}
+
+// removeFromQueue deletes the queue entry of a proposal's current period
+func (k Keeper) removeFromQueue(ctx sdk.Context, proposal types.Proposal) {
+	store := ctx.KVStore(k.storeKey)
+	switch proposal.Status {
+	case types.StatusSubmitted:
+		store.Delete(types.GetDepositQueueKey(proposal.DepositEndTime, proposal.ID))
+	case types.StatusVotingPeriod:
+		store.Delete(types.GetVotingQueueKey(proposal.VotingEndTime, proposal.ID))
+	}
+}
+
+// activeProposal returns a proposal that is in its voting period
+func (k Keeper) activeProposal(ctx sdk.Context, proposalID uint64) (types.Proposal, error) {
+	proposal, found := k.GetProposal(ctx, proposalID)
+	if !found {
+		return types.Proposal{}, sdkerrors.Wrapf(types.ErrProposalNotFound, "proposal %d", proposalID)
+	}
+	if proposal.Status != types.StatusVotingPeriod {
+		return types.Proposal{}, sdkerrors.Wrapf(types.ErrNotVotingPeriod, "proposal %d is %s", proposalID, proposal.Status)
+	}
+	if !ctx.BlockTime().Before(proposal.VotingEndTime) {
+		return types.Proposal{}, sdkerrors.Wrapf(types.ErrVotingPeriodEnded, "proposal %d", proposalID)
+	}
+	return proposal, nil
+}
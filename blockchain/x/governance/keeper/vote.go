@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"skaffacity/x/governance/types"
+)
+
+// Vote records voter's vote on a proposal in its voting period. Votes are
+// final: an account votes once per proposal, with the voting power its stake
+// gives it. Accounts with less than the minimum stake to vote, or whose
+// stake was added or increased after the voting period started, cannot vote.
+func (k Keeper) Vote(ctx sdk.Context, proposalID uint64, voter, voteOption string) error {
+	proposal, err := k.activeProposal(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+
+	if err := types.ValidateVoteOption(voteOption); err != nil {
+		return err
+	}
+
+	if _, found := k.GetVote(ctx, proposalID, voter); found {
+		return sdkerrors.Wrapf(types.ErrAlreadyVoted, "%s on proposal %d", voter, proposalID)
+	}
+
+	stake, power, err := k.votingPower(ctx, proposal, voter)
+	if err != nil {
+		return err
+	}
+
+	k.SetVote(ctx, types.Vote{
+		ProposalID: proposalID,
+		Voter:      voter,
+		Option:     voteOption,
+		Weight:     power,
+		Timestamp:  ctx.BlockTime(),
+		Stake:      stake,
+	})
+	return nil
+}
+
+// GetVote returns a voter's vote on a proposal
+func (k Keeper) GetVote(ctx sdk.Context, proposalID uint64, voter string) (types.Vote, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetVoteKey(proposalID, voter))
+	if bz == nil {
+		return types.Vote{}, false
+	}
+
+	var vote types.Vote
+	k.cdc.MustUnmarshal(bz, &vote)
+	return vote, true
+}
+
+// SetVote stores a vote
+func (k Keeper) SetVote(ctx sdk.Context, vote types.Vote) {
+	ctx.KVStore(k.storeKey).Set(types.GetVoteKey(vote.ProposalID, vote.Voter), k.cdc.MustMarshal(&vote))
+}
+
+// GetVotes returns the votes on a proposal
+func (k Keeper) GetVotes(ctx sdk.Context, proposalID uint64) []types.Vote {
+	return k.getVotes(ctx, types.GetVotePrefix(proposalID))
+}
+
+// GetAllVotes returns the votes on every proposal
+func (k Keeper) GetAllVotes(ctx sdk.Context) []types.Vote {
+	return k.getVotes(ctx, types.VoteKey)
+}
+
+func (k Keeper) getVotes(ctx sdk.Context, keyPrefix []byte) []types.Vote {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var votes []types.Vote
+	for ; iterator.Valid(); iterator.Next() {
+		var vote types.Vote
+		k.cdc.MustUnmarshal(iterator.Value(), &vote)
+		votes = append(votes, vote)
+	}
+	return votes
+}
+
+// Tally sums the weights of the votes on a proposal by option, and the stake
+// of every voter
+func (k Keeper) Tally(ctx sdk.Context, proposalID uint64) (yes, no, abstain sdk.Dec, voted sdk.Int) {
+	yes, no, abstain, voted = sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroInt()
+	for _, vote := range k.GetVotes(ctx, proposalID) {
+		voted = voted.Add(vote.Stake)
+		switch types.VoteOption(vote.Option) {
+		case types.VoteYes:
+			yes = yes.Add(vote.Weight)
+		case types.VoteNo:
+			no = no.Add(vote.Weight)
+		case types.VoteAbstain:
+			abstain = abstain.Add(vote.Weight)
+		}
+	}
+	return yes, no, abstain, voted
+}
@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"skaffacity/x/governance/types"
+)
+
+func TestVoteWeightsStakeByTier(t *testing.T) {
+	f := setupKeeper(t)
+	before := genesisTime.Add(-time.Hour)
+
+	// by stake alone no would win, 104 to 100; the tier weights of the
+	// default staking params turn it around
+	alice, bob, carol := testAddr(1), testAddr(2), testAddr(3)
+	f.stake(alice, 100_000_000_000, before) // councillor, 1.25
+	f.stake(bob, 99_000_000_000, before)    // resident, 1.1
+	f.stake(carol, 5_000_000_000, before)   // citizen, 1
+
+	proposal := f.startVoting(t, nil)
+	require.NoError(t, f.keeper.Vote(f.ctx, proposal.ID, alice, string(types.VoteYes)))
+	require.NoError(t, f.keeper.Vote(f.ctx, proposal.ID, bob, string(types.VoteNo)))
+	require.NoError(t, f.keeper.Vote(f.ctx, proposal.ID, carol, string(types.VoteNo)))
+
+	yes, no, abstain, voted := f.keeper.Tally(f.ctx, proposal.ID)
+	require.Equal(t, sdk.NewDec(125_000_000_000), yes)
+	require.Equal(t, sdk.NewDec(113_900_000_000), no)
+	require.True(t, abstain.IsZero())
+	require.Equal(t, sdk.NewInt(204_000_000_000), voted)
+
+	proposal = f.endVoting(t, proposal.ID)
+	require.Equal(t, types.StatusPassed, proposal.Status)
+}
+
+func TestVoteRequiresStakeFromBeforeVotingStarted(t *testing.T) {
+	f := setupKeeper(t)
+
+	early, late, small := testAddr(1), testAddr(2), testAddr(3)
+	f.stake(early, 10_000_000, genesisTime.Add(-time.Hour))
+	f.stake(small, 999_999, genesisTime.Add(-time.Hour))
+
+	proposal := f.startVoting(t, nil)
+	// stake added in the block the vote started in is already too late
+	f.stake(late, 1_000_000_000_000, genesisTime)
+
+	require.NoError(t, f.keeper.Vote(f.ctx, proposal.ID, early, string(types.VoteYes)))
+	require.ErrorIs(t, f.keeper.Vote(f.ctx, proposal.ID, late, string(types.VoteNo)), types.ErrInsufficientStake)
+	require.ErrorIs(t, f.keeper.Vote(f.ctx, proposal.ID, small, string(types.VoteNo)), types.ErrInsufficientStake)
+	require.ErrorIs(t, f.keeper.Vote(f.ctx, proposal.ID, testAddr(4), string(types.VoteNo)), types.ErrInsufficientStake)
+	require.ErrorIs(t, f.keeper.Vote(f.ctx, proposal.ID, early, string(types.VoteNo)), types.ErrAlreadyVoted)
+
+	_, no, _, voted := f.keeper.Tally(f.ctx, proposal.ID)
+	require.True(t, no.IsZero())
+	require.Equal(t, sdk.NewInt(10_000_000), voted)
+}
+
+func TestQuorumIsMeasuredAgainstStakeWhenVotingStarted(t *testing.T) {
+	before := genesisTime.Add(-time.Hour)
+
+	testCases := []struct {
+		name        string
+		idleStake   int64
+		expectation string
+	}{
+		// 300 of 890 staked voted, just over the default 33.4% quorum
+		{"quorum reached", 590_000_000_000, types.StatusPassed},
+		// 300 of 900 staked voted, just under it
+		{"quorum missed", 600_000_000_000, types.StatusFailed},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := setupKeeper(t)
+			voter := testAddr(1)
+			f.stake(voter, 300_000_000_000, before)
+			f.stake(testAddr(2), tc.idleStake, before)
+
+			proposal := f.startVoting(t, nil)
+			require.Equal(t, sdk.NewInt(300_000_000_000+tc.idleStake), proposal.TotalStake)
+
+			// stake added once voting started neither votes nor dilutes
+			// the quorum
+			f.stake(testAddr(3), 10_000_000_000_000, genesisTime.Add(time.Minute))
+
+			require.NoError(t, f.keeper.Vote(f.ctx, proposal.ID, voter, string(types.VoteYes)))
+			proposal = f.endVoting(t, proposal.ID)
+			require.Equal(t, tc.expectation, proposal.Status)
+		})
+	}
+}
+
+func TestStakeToppedUpDuringAVoteNoLongerCounts(t *testing.T) {
+	f := setupKeeper(t)
+	before := genesisTime.Add(-time.Hour)
+
+	grower, shrinker := testAddr(1), testAddr(2)
+	f.stake(grower, 10_000_000, before)
+	f.stake(shrinker, 20_000_000, before)
+
+	proposal := f.startVoting(t, nil)
+	f.stake(grower, 20_000_000, genesisTime.Add(time.Minute))
+	f.stake(shrinker, 15_000_000, genesisTime.Add(time.Minute))
+
+	require.ErrorIs(t, f.keeper.Vote(f.ctx, proposal.ID, grower, string(types.VoteYes)), types.ErrInsufficientStake)
+	require.NoError(t, f.keeper.Vote(f.ctx, proposal.ID, shrinker, string(types.VoteYes)))
+
+	_, _, _, voted := f.keeper.Tally(f.ctx, proposal.ID)
+	require.Equal(t, sdk.NewInt(15_000_000), voted)
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"skaffacity/x/governance/types"
+)
+
+// votingPower returns voter's stake and voting power on a proposal in its
+// voting period: their stake times the vote weight of their staking tier.
+// Only stake in place before the voting period started counts, so stake
+// added to swing a vote counts for nothing, and the stake must reach the
+// minimum stake to vote. Looking up the voter alone keeps the cost of a vote
+// independent of the number of stakers.
+func (k Keeper) votingPower(ctx sdk.Context, proposal types.Proposal, voter string) (sdk.Int, sdk.Dec, error) {
+	minStake := k.GetVotingParams(ctx).MinStakeToVote
+
+	delegation, err := k.stakingKeeper.GetDelegation(ctx, voter)
+	if err != nil || delegation.Amount.IsNil() || !delegation.Amount.IsPositive() || delegation.Amount.LT(minStake) {
+		return sdk.Int{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrInsufficientStake, "%s has less than %s staked", voter, minStake)
+	}
+	if !delegation.StartTime.Before(proposal.VotingStartTime) {
+		return sdk.Int{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrInsufficientStake, "%s staked after voting on proposal %d started", voter, proposal.ID)
+	}
+
+	power := sdk.NewDecFromInt(delegation.Amount).Mul(k.stakingKeeper.GetVoteWeight(ctx, delegation.Amount))
+	return delegation.Amount, power, nil
+}
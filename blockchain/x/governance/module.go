@@ -1,7 +1,9 @@
 package governance
 
 import (
+    "context"
     "encoding/json"
+    "fmt"
 
     "github.com/cosmos/cosmos-sdk/codec"
     codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -12,6 +14,7 @@ import (
     "github.com/grpc-ecosystem/grpc-gateway/runtime"
     abci "github.com/cometbft/cometbft/abci/types"
     
+    "skaffacity/x/governance/client/cli"
     "skaffacity/x/governance/keeper"
     govtypes "skaffacity/x/governance/types"
 )
@@ -26,36 +29,66 @@ func NewAppModule(k keeper.Keeper) AppModule {
 
 func (am AppModule) Name() string { return govtypes.ModuleName }
 
-func (am AppModule) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {}
+func (am AppModule) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+    govtypes.RegisterCodec(cdc)
+}
 
-func (am AppModule) RegisterInterfaces(registry codectypes.InterfaceRegistry) {}
+func (am AppModule) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+    govtypes.RegisterInterfaces(registry)
+}
 
+// DefaultGenesis returns the governance module's default genesis state,
+// JSON-encoded like the other skaffacity modules
 func (am AppModule) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
-    return []byte(`{}`) // Simple empty JSON for now
+    bz, err := json.Marshal(govtypes.DefaultGenesisState())
+    if err != nil {
+        panic(err)
+    }
+    return bz
 }
 
 func (am AppModule) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncodingConfig, bz json.RawMessage) error {
-    return nil
+    var genState govtypes.GenesisState
+    if err := json.Unmarshal(bz, &genState); err != nil {
+        return fmt.Errorf("failed to unmarshal %s genesis state: %w", govtypes.ModuleName, err)
+    }
+    return genState.Validate()
 }
 
-func (am AppModule) RegisterServices(cfg module.Configurator) {}
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+    govtypes.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
+    govtypes.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServer(am.keeper))
+}
 
-func (am AppModule) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {}
+func (am AppModule) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+    govtypes.RegisterQueryHandlerClient(context.Background(), mux, govtypes.NewQueryClient(clientCtx))
+}
 
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
+    var genState govtypes.GenesisState
+    if err := json.Unmarshal(data, &genState); err != nil {
+        panic(fmt.Errorf("failed to unmarshal %s genesis state: %w", govtypes.ModuleName, err))
+    }
+
+    InitGenesis(ctx, am.keeper, genState)
     return []abci.ValidatorUpdate{}
 }
 
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
-    return []byte(`{}`) // Simple empty JSON for now
+    bz, err := json.Marshal(ExportGenesis(ctx, am.keeper))
+    if err != nil {
+        panic(err)
+    }
+    return bz
 }
 
 func (am AppModule) ConsensusVersion() uint64 { return 1 }
 
 func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {}
 func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
+    EndBlocker(ctx, am.keeper)
     return []abci.ValidatorUpdate{}
 }
 
-func (am AppModule) GetTxCmd() *cobra.Command { return nil }
-func (am AppModule) GetQueryCmd() *cobra.Command { return nil }
+func (am AppModule) GetTxCmd() *cobra.Command { return cli.GetTxCmd() }
+func (am AppModule) GetQueryCmd() *cobra.Command { return cli.GetQueryCmd() }
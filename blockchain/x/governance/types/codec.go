@@ -0,0 +1,32 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgSubmitProposal{}, "governance/SubmitProposal", nil)
+	cdc.RegisterConcrete(&MsgDeposit{}, "governance/Deposit", nil)
+	cdc.RegisterConcrete(&MsgVote{}, "governance/Vote", nil)
+}
+
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSubmitProposal{},
+		&MsgDeposit{},
+		&MsgVote{},
+	)
+}
+
+var (
+	Amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
+)
+
+func init() {
+	RegisterCodec(Amino)
+	RegisterInterfaces(ModuleCdc.InterfaceRegistry())
+	Amino.Seal()
+}
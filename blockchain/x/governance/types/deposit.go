@@ -0,0 +1,45 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Deposit is what a depositor has put towards the minimum deposit of a
+// proposal. Deposits are held by the module account and refunded once the
+// proposal is final.
+type Deposit struct {
+	ProposalID uint64    `json:"proposal_id"`
+	Depositor  string    `json:"depositor"`
+	Amount     sdk.Coins `json:"amount"`
+}
+
+// ProtoMessage implements the proto.Message interface for Deposit.
+func (d *Deposit) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for Deposit.
+func (d *Deposit) Reset() { *d = Deposit{} }
+
+// String implements the fmt.Stringer interface for Deposit.
+func (d *Deposit) String() string {
+	return fmt.Sprintf("Deposit{ProposalID: %d, Depositor: %s, Amount: %s}", d.ProposalID, d.Depositor, d.Amount)
+}
+
+// Marshal implements codec.ProtoMarshaler for Deposit.
+func (d *Deposit) Marshal() ([]byte, error) { return json.Marshal(d) }
+
+// MarshalTo implements codec.ProtoMarshaler for Deposit.
+func (d *Deposit) MarshalTo(data []byte) (int, error) { return jsonMarshalTo(d, data) }
+
+// MarshalToSizedBuffer implements codec.ProtoMarshaler for Deposit.
+func (d *Deposit) MarshalToSizedBuffer(data []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(d, data)
+}
+
+// Unmarshal implements codec.ProtoMarshaler for Deposit.
+func (d *Deposit) Unmarshal(data []byte) error { return json.Unmarshal(data, d) }
+
+// Size implements codec.ProtoMarshaler for Deposit.
+func (d *Deposit) Size() int { return jsonSize(d) }
@@ -11,4 +11,9 @@ var (
 	ErrInsufficientStake    = sdkerrors.Register(ModuleName, 4, "insufficient stake")
 	ErrAlreadyVoted         = sdkerrors.Register(ModuleName, 5, "already voted")
 	ErrInvalidVoteOption    = sdkerrors.Register(ModuleName, 6, "invalid vote option")
+	ErrInvalidParams        = sdkerrors.Register(ModuleName, 7, "invalid governance params")
+	ErrInvalidDeposit       = sdkerrors.Register(ModuleName, 8, "invalid deposit")
+	ErrDepositPeriodEnded   = sdkerrors.Register(ModuleName, 9, "deposit period has ended")
+	ErrNotVotingPeriod      = sdkerrors.Register(ModuleName, 10, "proposal is not in its voting period")
+	ErrInvalidProposalMsg   = sdkerrors.Register(ModuleName, 11, "invalid proposal message")
 )
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// governance module event types
+const (
+	// EventTypeSubmitProposal defines the event type for a new proposal
+	EventTypeSubmitProposal = "submit_proposal"
+	// EventTypeProposalDeposit defines the event type for a deposit on a proposal
+	EventTypeProposalDeposit = "proposal_deposit"
+	// EventTypeVotingStarted defines the event type for a proposal reaching
+	// its minimum deposit and entering its voting period
+	EventTypeVotingStarted = "voting_started"
+	// EventTypeProposalVote defines the event type for a vote on a proposal
+	EventTypeProposalVote = "proposal_vote"
+	// EventTypeProposalFinished defines the event type for a proposal
+	// reaching its final status, at the end of its deposit or voting period
+	EventTypeProposalFinished = "proposal_finished"
+
+	// AttributeKeyProposalID defines the event attribute for the proposal id
+	AttributeKeyProposalID = "proposal_id"
+	// AttributeKeyProposer defines the event attribute for the proposer
+	AttributeKeyProposer = "proposer"
+	// AttributeKeyDepositor defines the event attribute for the depositor
+	AttributeKeyDepositor = "depositor"
+	// AttributeKeyAmount defines the event attribute for the deposit amount
+	AttributeKeyAmount = "amount"
+	// AttributeKeyVoter defines the event attribute for the voter
+	AttributeKeyVoter = "voter"
+	// AttributeKeyOption defines the event attribute for the vote option
+	AttributeKeyOption = "option"
+	// AttributeKeyStatus defines the event attribute for the proposal status
+	AttributeKeyStatus = "status"
+	// AttributeKeyVotingEndTime defines the event attribute for the end of
+	// the voting period
+	AttributeKeyVotingEndTime = "voting_end_time"
+	// AttributeKeyWeight defines the event attribute for the weight of a vote
+	AttributeKeyWeight = "weight"
+	// AttributeKeyTotalStake defines the event attribute for the stake a
+	// proposal's quorum is measured against
+	AttributeKeyTotalStake = "total_stake"
+	// AttributeKeyExecutionError defines the event attribute for why the
+	// messages of a passed proposal failed to execute
+	AttributeKeyExecutionError = "execution_error"
+)
+
+// NewVotingStartedEvent returns the event of a proposal entering its voting
+// period
+func NewVotingStartedEvent(proposal Proposal) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeVotingStarted,
+		sdk.NewAttribute(AttributeKeyProposalID, strconv.FormatUint(proposal.ID, 10)),
+		sdk.NewAttribute(AttributeKeyVotingEndTime, proposal.VotingEndTime.Format(time.RFC3339)),
+		sdk.NewAttribute(AttributeKeyTotalStake, proposal.TotalStake.String()),
+	)
+}
+
+// NewProposalFinishedEvent returns the event of a proposal reaching its
+// final status, with its tally and, if its messages failed, why
+func NewProposalFinishedEvent(proposal Proposal) sdk.Event {
+	event := sdk.NewEvent(
+		EventTypeProposalFinished,
+		sdk.NewAttribute(AttributeKeyProposalID, strconv.FormatUint(proposal.ID, 10)),
+		sdk.NewAttribute(AttributeKeyStatus, proposal.Status),
+		sdk.NewAttribute(string(VoteYes), proposal.YesVotes.String()),
+		sdk.NewAttribute(string(VoteNo), proposal.NoVotes.String()),
+		sdk.NewAttribute(string(VoteAbstain), proposal.AbstainVotes.String()),
+	)
+	if proposal.ExecutionError != "" {
+		event = event.AppendAttributes(sdk.NewAttribute(AttributeKeyExecutionError, proposal.ExecutionError))
+	}
+	return event
+}
@@ -2,9 +2,21 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	stakingtypes "skaffacity/x/staking/types"
 )
 
-// StakingKeeper defines the expected staking keeper interface
+// StakingKeeper defines the expected staking keeper interface, used to
+// weight votes by stake and status tier
 type StakingKeeper interface {
 	GetStakedAmount(ctx sdk.Context, address string) sdk.Int
+	GetVoteWeight(ctx sdk.Context, amount sdk.Int) sdk.Dec
+	GetDelegation(ctx sdk.Context, delegatorAddr string) (*stakingtypes.Delegation, error)
+	GetTotalStake(ctx sdk.Context) sdk.Int
+}
+
+// BankKeeper defines the expected bank keeper interface, used to hold
+// proposal deposits in the module account
+type BankKeeper interface {
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 }
@@ -1,14 +1,127 @@
 package types
 
-// GenesisState defines the governance module's genesis state
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GenesisState defines the governance module's genesis state. Deposits are
+// only held for proposals that are not final yet; the governance module
+// account must hold them in the bank genesis.
 type GenesisState struct {
-	// Empty for now, basic structure
+	DepositParams  DepositParams `json:"deposit_params"`
+	VotingParams   VotingParams  `json:"voting_params"`
+	Proposals      []Proposal    `json:"proposals"`
+	NextProposalID uint64        `json:"next_proposal_id"`
+	Deposits       []Deposit     `json:"deposits"`
+	Votes          []Vote        `json:"votes"`
 }
 
+// DefaultGenesisState returns the governance module's default genesis state
 func DefaultGenesisState() *GenesisState {
-	return &GenesisState{}
+	return &GenesisState{
+		DepositParams:  DefaultDepositParams(),
+		VotingParams:   DefaultVotingParams(),
+		Proposals:      []Proposal{},
+		NextProposalID: 1,
+		Deposits:       []Deposit{},
+		Votes:          []Vote{},
+	}
 }
 
+// Validate performs basic genesis state validation
 func (gs GenesisState) Validate() error {
+	if err := gs.DepositParams.Validate(); err != nil {
+		return err
+	}
+	if err := gs.VotingParams.Validate(); err != nil {
+		return err
+	}
+
+	proposals := make(map[uint64]Proposal, len(gs.Proposals))
+	for _, proposal := range gs.Proposals {
+		if proposal.ID == 0 || proposal.ID >= gs.NextProposalID {
+			return fmt.Errorf("proposal id %d must be between 1 and the next proposal id %d", proposal.ID, gs.NextProposalID)
+		}
+		if _, ok := proposals[proposal.ID]; ok {
+			return fmt.Errorf("duplicate proposal %d", proposal.ID)
+		}
+		switch proposal.Status {
+		case StatusSubmitted, StatusVotingPeriod, StatusPassed, StatusRejected, StatusFailed:
+		default:
+			return sdkerrors.Wrapf(ErrInvalidProposal, "proposal %d has unknown status %q", proposal.ID, proposal.Status)
+		}
+		if err := ValidateProposalContent(proposal.Title, proposal.Description); err != nil {
+			return sdkerrors.Wrapf(err, "proposal %d", proposal.ID)
+		}
+		if err := ValidateProposalMessages(proposal.Messages); err != nil {
+			return sdkerrors.Wrapf(err, "proposal %d", proposal.ID)
+		}
+		if proposal.ExecutionError != "" && proposal.Status != StatusFailed {
+			return sdkerrors.Wrapf(ErrInvalidProposal, "proposal %d is %s but has an execution error", proposal.ID, proposal.Status)
+		}
+		proposals[proposal.ID] = proposal
+	}
+
+	deposited := make(map[uint64]sdk.Coins)
+	seenDeposits := make(map[string]bool, len(gs.Deposits))
+	for _, deposit := range gs.Deposits {
+		proposal, ok := proposals[deposit.ProposalID]
+		if !ok {
+			return sdkerrors.Wrapf(ErrProposalNotFound, "deposit of %s on proposal %d", deposit.Depositor, deposit.ProposalID)
+		}
+		if proposal.IsFinal() {
+			return sdkerrors.Wrapf(ErrInvalidDeposit, "deposit of %s on final proposal %d", deposit.Depositor, deposit.ProposalID)
+		}
+		key := string(GetDepositKey(deposit.ProposalID, deposit.Depositor))
+		if seenDeposits[key] {
+			return fmt.Errorf("duplicate deposit of %s on proposal %d", deposit.Depositor, deposit.ProposalID)
+		}
+		seenDeposits[key] = true
+		if err := deposit.Amount.Validate(); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidDeposit, "deposit of %s on proposal %d (%s)", deposit.Depositor, deposit.ProposalID, err)
+		}
+		deposited[deposit.ProposalID] = deposited[deposit.ProposalID].Add(deposit.Amount...)
+	}
+	for _, proposal := range gs.Proposals {
+		if proposal.IsFinal() {
+			continue
+		}
+		// Coins.IsEqual panics on differing denoms, so compare both ways
+		total := deposited[proposal.ID]
+		if !total.IsAllGTE(proposal.TotalDeposit) || !proposal.TotalDeposit.IsAllGTE(total) {
+			return sdkerrors.Wrapf(ErrInvalidDeposit, "proposal %d totals %s, its deposits %s", proposal.ID, proposal.TotalDeposit, deposited[proposal.ID])
+		}
+	}
+
+	for _, proposal := range gs.Proposals {
+		if proposal.Status != StatusVotingPeriod {
+			continue
+		}
+		if proposal.TotalStake.IsNil() || proposal.TotalStake.IsNegative() {
+			return fmt.Errorf("proposal %d must record the total stake its voting period started with", proposal.ID)
+		}
+	}
+
+	seenVotes := make(map[string]bool, len(gs.Votes))
+	for _, vote := range gs.Votes {
+		if _, ok := proposals[vote.ProposalID]; !ok {
+			return sdkerrors.Wrapf(ErrProposalNotFound, "vote of %s on proposal %d", vote.Voter, vote.ProposalID)
+		}
+		key := string(GetVoteKey(vote.ProposalID, vote.Voter))
+		if seenVotes[key] {
+			return sdkerrors.Wrapf(ErrAlreadyVoted, "%s on proposal %d", vote.Voter, vote.ProposalID)
+		}
+		seenVotes[key] = true
+		if err := ValidateVoteOption(vote.Option); err != nil {
+			return sdkerrors.Wrapf(err, "vote of %s on proposal %d", vote.Voter, vote.ProposalID)
+		}
+		if vote.Stake.IsNil() || !vote.Stake.IsPositive() || vote.Weight.IsNil() || !vote.Weight.IsPositive() {
+			return sdkerrors.Wrapf(ErrInsufficientStake, "vote of %s on proposal %d must have a positive stake and weight", vote.Voter, vote.ProposalID)
+		}
+	}
+
 	return nil
 }
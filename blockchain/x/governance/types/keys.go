@@ -1,8 +1,18 @@
 package types
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
 const (
 	// StoreKey defines the primary module store key
 	StoreKey = ModuleName
+
+	RouterKey    = ModuleName
+	QuerierRoute = ModuleName
 )
 
 // Keys for governance store
@@ -10,4 +20,64 @@ var (
 	ProposalKey       = []byte{0x01}
 	NextProposalIDKey = []byte{0x02}
 	VoteKey           = []byte{0x03}
+	DepositKey        = []byte{0x04}
+	// DepositQueueKey orders the proposals in their deposit period by the
+	// end of that period
+	DepositQueueKey = []byte{0x05}
+	// VotingQueueKey orders the proposals in their voting period by the end
+	// of that period
+	VotingQueueKey   = []byte{0x06}
+	DepositParamsKey = []byte{0x07}
+	VotingParamsKey  = []byte{0x08}
 )
+
+// ModuleAddress is the governance module account. It holds proposal
+// deposits until they are refunded.
+var ModuleAddress = authtypes.NewModuleAddress(ModuleName)
+
+// GetProposalKey returns the store key of a proposal
+func GetProposalKey(id uint64) []byte {
+	return append(ProposalKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetVotePrefix returns the prefix of the votes on a proposal
+func GetVotePrefix(proposalID uint64) []byte {
+	return append(VoteKey, sdk.Uint64ToBigEndian(proposalID)...)
+}
+
+// GetVoteKey returns the store key of a voter's vote on a proposal
+func GetVoteKey(proposalID uint64, voter string) []byte {
+	return append(GetVotePrefix(proposalID), []byte(voter)...)
+}
+
+// GetDepositPrefix returns the prefix of the deposits on a proposal
+func GetDepositPrefix(proposalID uint64) []byte {
+	return append(DepositKey, sdk.Uint64ToBigEndian(proposalID)...)
+}
+
+// GetDepositKey returns the store key of a depositor's deposit on a proposal
+func GetDepositKey(proposalID uint64, depositor string) []byte {
+	return append(GetDepositPrefix(proposalID), []byte(depositor)...)
+}
+
+// GetDepositQueuePrefix returns the queue prefix of proposals whose deposit
+// period ends at endTime
+func GetDepositQueuePrefix(endTime time.Time) []byte {
+	return append(DepositQueueKey, sdk.FormatTimeBytes(endTime)...)
+}
+
+// GetDepositQueueKey returns the deposit queue key of a proposal
+func GetDepositQueueKey(endTime time.Time, id uint64) []byte {
+	return append(GetDepositQueuePrefix(endTime), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetVotingQueuePrefix returns the queue prefix of proposals whose voting
+// period ends at endTime
+func GetVotingQueuePrefix(endTime time.Time) []byte {
+	return append(VotingQueueKey, sdk.FormatTimeBytes(endTime)...)
+}
+
+// GetVotingQueueKey returns the voting queue key of a proposal
+func GetVotingQueueKey(endTime time.Time, id uint64) []byte {
+	return append(GetVotingQueuePrefix(endTime), sdk.Uint64ToBigEndian(id)...)
+}
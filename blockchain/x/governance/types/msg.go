@@ -0,0 +1,200 @@
+package types
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgSubmitProposal = "submit_proposal"
+	TypeMsgDeposit        = "deposit"
+	TypeMsgVote           = "vote"
+)
+
+var (
+	_ sdk.Msg = &MsgSubmitProposal{}
+	_ sdk.Msg = &MsgDeposit{}
+	_ sdk.Msg = &MsgVote{}
+)
+
+// MsgSubmitProposal submits a proposal with an initial deposit. The proposal
+// is voted on once its deposits reach the minimum deposit.
+// Messages, if any, are executed when the proposal passes.
+type MsgSubmitProposal struct {
+	Proposer       string            `json:"proposer"`
+	Title          string            `json:"title"`
+	Description    string            `json:"description"`
+	InitialDeposit sdk.Coins         `json:"initial_deposit"`
+	Messages       []ProposalMessage `json:"messages,omitempty"`
+}
+
+// NewMsgSubmitProposal creates a new MsgSubmitProposal
+func NewMsgSubmitProposal(proposer, title, description string, initialDeposit sdk.Coins, messages []ProposalMessage) *MsgSubmitProposal {
+	return &MsgSubmitProposal{
+		Proposer:       proposer,
+		Title:          title,
+		Description:    description,
+		InitialDeposit: initialDeposit,
+		Messages:       messages,
+	}
+}
+
+// Route returns the route of MsgSubmitProposal
+func (msg *MsgSubmitProposal) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of MsgSubmitProposal
+func (msg *MsgSubmitProposal) Type() string {
+	return TypeMsgSubmitProposal
+}
+
+// GetSigners returns the signers of MsgSubmitProposal
+func (msg *MsgSubmitProposal) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Proposer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// GetSignBytes returns the sign bytes of MsgSubmitProposal
+func (msg *MsgSubmitProposal) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validates the basic fields of MsgSubmitProposal
+func (msg *MsgSubmitProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Proposer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid proposer address (%s)", err)
+	}
+
+	if err := msg.InitialDeposit.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidDeposit, err.Error())
+	}
+
+	if err := ValidateProposalMessages(msg.Messages); err != nil {
+		return err
+	}
+
+	return ValidateProposalContent(msg.Title, msg.Description)
+}
+
+// MsgDeposit adds to the deposit of a proposal in its deposit period
+type MsgDeposit struct {
+	Depositor  string    `json:"depositor"`
+	ProposalID uint64    `json:"proposal_id"`
+	Amount     sdk.Coins `json:"amount"`
+}
+
+// NewMsgDeposit creates a new MsgDeposit
+func NewMsgDeposit(depositor string, proposalID uint64, amount sdk.Coins) *MsgDeposit {
+	return &MsgDeposit{
+		Depositor:  depositor,
+		ProposalID: proposalID,
+		Amount:     amount,
+	}
+}
+
+// Route returns the route of MsgDeposit
+func (msg *MsgDeposit) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of MsgDeposit
+func (msg *MsgDeposit) Type() string {
+	return TypeMsgDeposit
+}
+
+// GetSigners returns the signers of MsgDeposit
+func (msg *MsgDeposit) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// GetSignBytes returns the sign bytes of MsgDeposit
+func (msg *MsgDeposit) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validates the basic fields of MsgDeposit
+func (msg *MsgDeposit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Depositor); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid depositor address (%s)", err)
+	}
+
+	if err := msg.Amount.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidDeposit, err.Error())
+	}
+	if msg.Amount.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidDeposit, "deposit cannot be empty")
+	}
+
+	return nil
+}
+
+// MsgVote casts a vote on a proposal in its voting period
+type MsgVote struct {
+	Voter      string `json:"voter"`
+	ProposalID uint64 `json:"proposal_id"`
+	Option     string `json:"option"`
+}
+
+// NewMsgVote creates a new MsgVote
+func NewMsgVote(voter string, proposalID uint64, option string) *MsgVote {
+	return &MsgVote{
+		Voter:      voter,
+		ProposalID: proposalID,
+		Option:     option,
+	}
+}
+
+// Route returns the route of MsgVote
+func (msg *MsgVote) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of MsgVote
+func (msg *MsgVote) Type() string {
+	return TypeMsgVote
+}
+
+// GetSigners returns the signers of MsgVote
+func (msg *MsgVote) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Voter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// GetSignBytes returns the sign bytes of MsgVote
+func (msg *MsgVote) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validates the basic fields of MsgVote
+func (msg *MsgVote) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Voter); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid voter address (%s)", err)
+	}
+
+	return ValidateVoteOption(msg.Option)
+}
@@ -1,3 +1,171 @@
 package types
 
-type MsgServer interface{}
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+)
+
+// MsgServer defines the governance module's message service
+type MsgServer interface {
+	SubmitProposal(context.Context, *MsgSubmitProposal) (*MsgSubmitProposalResponse, error)
+	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
+	Vote(context.Context, *MsgVote) (*MsgVoteResponse, error)
+}
+
+// MsgSubmitProposalResponse is the response for MsgSubmitProposal
+type MsgSubmitProposalResponse struct {
+	ProposalID uint64 `json:"proposal_id"`
+}
+
+// MsgDepositResponse is the response for MsgDeposit. VotingStarted is set
+// when the deposit brought the proposal to its minimum deposit.
+type MsgDepositResponse struct {
+	VotingStarted bool `json:"voting_started"`
+}
+
+// MsgVoteResponse is the response for MsgVote
+type MsgVoteResponse struct{}
+
+// ProtoMessage implements the proto.Message interface for MsgSubmitProposal.
+func (msg *MsgSubmitProposal) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgSubmitProposal.
+func (msg *MsgSubmitProposal) Reset() { *msg = MsgSubmitProposal{} }
+
+// String implements the proto.Message interface for MsgSubmitProposal.
+func (msg *MsgSubmitProposal) String() string {
+	return fmt.Sprintf("MsgSubmitProposal{Proposer: %s, Title: %s, InitialDeposit: %s, Messages: %d}", msg.Proposer, msg.Title, msg.InitialDeposit, len(msg.Messages))
+}
+
+// XXX_MessageName returns the fully qualified message name used as type URL.
+func (msg *MsgSubmitProposal) XXX_MessageName() string {
+	return "skaffacity.governance.v1.MsgSubmitProposal"
+}
+
+// ProtoMessage implements the proto.Message interface for MsgSubmitProposalResponse.
+func (m *MsgSubmitProposalResponse) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgSubmitProposalResponse.
+func (m *MsgSubmitProposalResponse) Reset() { *m = MsgSubmitProposalResponse{} }
+
+// String implements the proto.Message interface for MsgSubmitProposalResponse.
+func (m *MsgSubmitProposalResponse) String() string {
+	return fmt.Sprintf("MsgSubmitProposalResponse{ProposalID: %d}", m.ProposalID)
+}
+
+// ProtoMessage implements the proto.Message interface for MsgDeposit.
+func (msg *MsgDeposit) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgDeposit.
+func (msg *MsgDeposit) Reset() { *msg = MsgDeposit{} }
+
+// String implements the proto.Message interface for MsgDeposit.
+func (msg *MsgDeposit) String() string {
+	return fmt.Sprintf("MsgDeposit{Depositor: %s, ProposalID: %d, Amount: %s}", msg.Depositor, msg.ProposalID, msg.Amount)
+}
+
+// XXX_MessageName returns the fully qualified message name used as type URL.
+func (msg *MsgDeposit) XXX_MessageName() string { return "skaffacity.governance.v1.MsgDeposit" }
+
+// ProtoMessage implements the proto.Message interface for MsgDepositResponse.
+func (m *MsgDepositResponse) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgDepositResponse.
+func (m *MsgDepositResponse) Reset() { *m = MsgDepositResponse{} }
+
+// String implements the proto.Message interface for MsgDepositResponse.
+func (m *MsgDepositResponse) String() string {
+	return fmt.Sprintf("MsgDepositResponse{VotingStarted: %t}", m.VotingStarted)
+}
+
+// ProtoMessage implements the proto.Message interface for MsgVote.
+func (msg *MsgVote) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgVote.
+func (msg *MsgVote) Reset() { *msg = MsgVote{} }
+
+// String implements the proto.Message interface for MsgVote.
+func (msg *MsgVote) String() string {
+	return fmt.Sprintf("MsgVote{Voter: %s, ProposalID: %d, Option: %s}", msg.Voter, msg.ProposalID, msg.Option)
+}
+
+// XXX_MessageName returns the fully qualified message name used as type URL.
+func (msg *MsgVote) XXX_MessageName() string { return "skaffacity.governance.v1.MsgVote" }
+
+// ProtoMessage implements the proto.Message interface for MsgVoteResponse.
+func (m *MsgVoteResponse) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgVoteResponse.
+func (m *MsgVoteResponse) Reset() { *m = MsgVoteResponse{} }
+
+// String implements the proto.Message interface for MsgVoteResponse.
+func (m *MsgVoteResponse) String() string { return "MsgVoteResponse{}" }
+
+const msgServiceName = "skaffacity.governance.v1.Msg"
+
+// RegisterMsgServer registers the message service implementation with the
+// server. The app's MsgServiceRouter delivers the module's transactions
+// through it.
+func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SubmitProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + msgServiceName + "/SubmitProposal"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitProposal(ctx, req.(*MsgSubmitProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Deposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + msgServiceName + "/Deposit"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Deposit(ctx, req.(*MsgDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Vote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + msgServiceName + "/Vote"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Vote(ctx, req.(*MsgVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// _Msg_serviceDesc is written by hand like the module's other types; there is
+// no tx.proto to generate it from.
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: msgServiceName,
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "SubmitProposal", Handler: _Msg_SubmitProposal_Handler},
+		{MethodName: "Deposit", Handler: _Msg_Deposit_Handler},
+		{MethodName: "Vote", Handler: _Msg_Vote_Handler},
+	},
+	Streams: []grpc.StreamDesc{},
+}
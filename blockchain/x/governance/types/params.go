@@ -0,0 +1,130 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// DefaultDepositDenom is the denom of the default minimum deposit
+	DefaultDepositDenom = "skaf"
+	// DefaultMaxDepositPeriod is how long a proposal may wait for its
+	// minimum deposit by default
+	DefaultMaxDepositPeriod = 2 * 24 * time.Hour
+)
+
+// DefaultMinDepositAmount is 1,000 SKAF at 6 decimals
+var DefaultMinDepositAmount = sdk.NewInt(1_000_000_000)
+
+var (
+	// DefaultQuorumThreshold is the share of the total stake that
+	// must vote for a proposal to be decided
+	DefaultQuorumThreshold = sdk.NewDecWithPrec(334, 3)
+	// DefaultMinStakeToVote is 1 SKAF at 6 decimals
+	DefaultMinStakeToVote = sdk.NewInt(1_000_000)
+)
+
+// DefaultDepositParams returns the default deposit params
+func DefaultDepositParams() DepositParams {
+	return DepositParams{
+		MinDeposit:       sdk.NewCoins(sdk.NewCoin(DefaultDepositDenom, DefaultMinDepositAmount)),
+		MaxDepositPeriod: DefaultMaxDepositPeriod,
+	}
+}
+
+// DefaultVotingParams returns the default voting params
+func DefaultVotingParams() VotingParams {
+	return VotingParams{
+		VotingPeriod:    DefaultVotingPeriod,
+		QuorumThreshold: DefaultQuorumThreshold,
+		MinStakeToVote:  DefaultMinStakeToVote,
+	}
+}
+
+// Validate checks the deposit params
+func (p DepositParams) Validate() error {
+	if err := p.MinDeposit.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidParams, "invalid minimum deposit (%s)", err)
+	}
+	if p.MinDeposit.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidParams, "minimum deposit cannot be zero")
+	}
+	if p.MaxDepositPeriod <= 0 {
+		return sdkerrors.Wrap(ErrInvalidParams, "max deposit period must be positive")
+	}
+	return nil
+}
+
+// Validate checks the voting params
+func (p VotingParams) Validate() error {
+	if p.VotingPeriod <= 0 {
+		return sdkerrors.Wrap(ErrInvalidParams, "voting period must be positive")
+	}
+	if p.QuorumThreshold.IsNil() || p.QuorumThreshold.IsNegative() || p.QuorumThreshold.GT(sdk.OneDec()) {
+		return sdkerrors.Wrap(ErrInvalidParams, "quorum threshold must be between 0 and 1")
+	}
+	if p.MinStakeToVote.IsNil() || p.MinStakeToVote.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidParams, "minimum stake to vote cannot be negative")
+	}
+	return nil
+}
+
+// ProtoMessage implements the proto.Message interface for DepositParams.
+func (p *DepositParams) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for DepositParams.
+func (p *DepositParams) Reset() { *p = DepositParams{} }
+
+// String implements the fmt.Stringer interface for DepositParams.
+func (p *DepositParams) String() string {
+	return fmt.Sprintf("DepositParams{MinDeposit: %s, MaxDepositPeriod: %s}", p.MinDeposit, p.MaxDepositPeriod)
+}
+
+// Marshal implements codec.ProtoMarshaler for DepositParams.
+func (p *DepositParams) Marshal() ([]byte, error) { return json.Marshal(p) }
+
+// MarshalTo implements codec.ProtoMarshaler for DepositParams.
+func (p *DepositParams) MarshalTo(data []byte) (int, error) { return jsonMarshalTo(p, data) }
+
+// MarshalToSizedBuffer implements codec.ProtoMarshaler for DepositParams.
+func (p *DepositParams) MarshalToSizedBuffer(data []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(p, data)
+}
+
+// Unmarshal implements codec.ProtoMarshaler for DepositParams.
+func (p *DepositParams) Unmarshal(data []byte) error { return json.Unmarshal(data, p) }
+
+// Size implements codec.ProtoMarshaler for DepositParams.
+func (p *DepositParams) Size() int { return jsonSize(p) }
+
+// ProtoMessage implements the proto.Message interface for VotingParams.
+func (p *VotingParams) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for VotingParams.
+func (p *VotingParams) Reset() { *p = VotingParams{} }
+
+// String implements the fmt.Stringer interface for VotingParams.
+func (p *VotingParams) String() string {
+	return fmt.Sprintf("VotingParams{VotingPeriod: %s, QuorumThreshold: %s, MinStakeToVote: %s}", p.VotingPeriod, p.QuorumThreshold, p.MinStakeToVote)
+}
+
+// Marshal implements codec.ProtoMarshaler for VotingParams.
+func (p *VotingParams) Marshal() ([]byte, error) { return json.Marshal(p) }
+
+// MarshalTo implements codec.ProtoMarshaler for VotingParams.
+func (p *VotingParams) MarshalTo(data []byte) (int, error) { return jsonMarshalTo(p, data) }
+
+// MarshalToSizedBuffer implements codec.ProtoMarshaler for VotingParams.
+func (p *VotingParams) MarshalToSizedBuffer(data []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(p, data)
+}
+
+// Unmarshal implements codec.ProtoMarshaler for VotingParams.
+func (p *VotingParams) Unmarshal(data []byte) error { return json.Unmarshal(data, p) }
+
+// Size implements codec.ProtoMarshaler for VotingParams.
+func (p *VotingParams) Size() int { return jsonSize(p) }
@@ -1,11 +1,17 @@
 package types
 
 import (
+	"encoding/json"
+	"fmt"
 	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-// Proposal represents a governance proposal
+// Proposal represents a governance proposal. It waits in its deposit period
+// until its deposits reach the minimum deposit, then is voted on for the
+// voting period and tallied when that ends.
 type Proposal struct {
 	ID             uint64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Title          string    `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
@@ -17,6 +23,19 @@ type Proposal struct {
 	YesVotes       sdk.Dec   `protobuf:"bytes,8,opt,name=yes_votes,json=yesVotes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"yes_votes"`
 	NoVotes        sdk.Dec   `protobuf:"bytes,9,opt,name=no_votes,json=noVotes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"no_votes"`
 	AbstainVotes   sdk.Dec   `protobuf:"bytes,10,opt,name=abstain_votes,json=abstainVotes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"abstain_votes"`
+	TotalDeposit   sdk.Coins `protobuf:"bytes,11,rep,name=total_deposit,json=totalDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_deposit"`
+	DepositEndTime time.Time `protobuf:"bytes,12,opt,name=deposit_end_time,json=depositEndTime,proto3,stdtime" json:"deposit_end_time"`
+	// VotingStartTime is zero until the proposal enters its voting period
+	VotingStartTime time.Time `protobuf:"bytes,13,opt,name=voting_start_time,json=votingStartTime,proto3,stdtime" json:"voting_start_time"`
+	// TotalStake is the SKAF staked when the voting period started; quorum
+	// is the share of it that voted
+	TotalStake sdk.Int `protobuf:"bytes,14,opt,name=total_stake,json=totalStake,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_stake"`
+	// Messages are executed, with the governance module account as signer,
+	// when the proposal passes
+	Messages []ProposalMessage `protobuf:"bytes,15,rep,name=messages,proto3" json:"messages,omitempty"`
+	// ExecutionError is why the messages of a proposal that passed its vote
+	// failed to execute
+	ExecutionError string `protobuf:"bytes,16,opt,name=execution_error,json=executionError,proto3" json:"execution_error,omitempty"`
 }
 
 // Vote represents a vote on a proposal
@@ -26,25 +45,105 @@ type Vote struct {
 	Option     string    `protobuf:"bytes,3,opt,name=option,proto3" json:"option,omitempty"`
 	Weight     sdk.Dec   `protobuf:"bytes,4,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
 	Timestamp  time.Time `protobuf:"bytes,5,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	// Stake is the voter's stake when voting; Weight is that stake times
+	// the vote weight of their staking tier
+	Stake sdk.Int `protobuf:"bytes,6,opt,name=stake,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"stake"`
 }
 
 // Proposal status constants
 const (
+	// StatusSubmitted is the deposit period of a proposal
 	StatusSubmitted    = "submitted"
 	StatusVotingPeriod = "voting_period"
 	StatusPassed       = "passed"
 	StatusRejected     = "rejected"
-	StatusFailed       = "failed"
+	// StatusFailed marks a proposal that did not reach the minimum deposit
+	// within its deposit period, whose votes did not reach quorum, or whose
+	// messages failed to execute after it passed its vote (see
+	// Proposal.ExecutionError)
+	StatusFailed = "failed"
 )
 
 // Default voting period
 var DefaultVotingPeriod = time.Hour * 24 * 7 // 7 days
 
+// Limits on proposal text
+const (
+	MaxTitleLength       = 140
+	MaxDescriptionLength = 10000
+)
+
+// ValidateProposalContent checks the title and description of a proposal
+func ValidateProposalContent(title, description string) error {
+	if len(title) == 0 {
+		return sdkerrors.Wrap(ErrInvalidProposal, "title cannot be empty")
+	}
+	if len(title) > MaxTitleLength {
+		return sdkerrors.Wrapf(ErrInvalidProposal, "title cannot be longer than %d characters", MaxTitleLength)
+	}
+	if len(description) == 0 {
+		return sdkerrors.Wrap(ErrInvalidProposal, "description cannot be empty")
+	}
+	if len(description) > MaxDescriptionLength {
+		return sdkerrors.Wrapf(ErrInvalidProposal, "description cannot be longer than %d characters", MaxDescriptionLength)
+	}
+	return nil
+}
+
+// IsFinal reports whether a proposal has left its deposit and voting
+// periods for good
+func (p Proposal) IsFinal() bool {
+	return p.Status == StatusPassed || p.Status == StatusRejected || p.Status == StatusFailed
+}
+
+// TallyStatus returns the final status of a proposal with the given tally:
+// failed when the stake that voted is less than quorum of totalStake, passed
+// when the weight of yes votes outweighs no and rejected otherwise. The stake
+// of abstain votes only counts towards quorum.
+func TallyStatus(yes, no sdk.Dec, votedStake, totalStake sdk.Int, quorum sdk.Dec) string {
+	if !votedStake.IsPositive() || !totalStake.IsPositive() || sdk.NewDecFromInt(votedStake).QuoInt(totalStake).LT(quorum) {
+		return StatusFailed
+	}
+	if yes.GT(no) {
+		return StatusPassed
+	}
+	return StatusRejected
+}
+
+// ValidateVoteOption checks that option is one of the vote options
+func ValidateVoteOption(option string) error {
+	switch VoteOption(option) {
+	case VoteYes, VoteNo, VoteAbstain:
+		return nil
+	default:
+		return sdkerrors.Wrapf(ErrInvalidVoteOption, "%q is not one of %s, %s or %s", option, VoteYes, VoteNo, VoteAbstain)
+	}
+}
+
 // ProtoMessage implements the proto.Message interface for Proposal.
 func (p *Proposal) ProtoMessage() {}
 
 // Reset implements the proto.Message interface for Proposal.
-func (p *Proposal) Reset() {}
+func (p *Proposal) Reset() { *p = Proposal{} }
 
 // String implements the fmt.Stringer interface for Proposal.
-func (p *Proposal) String() string { return "Proposal stub" }
\ No newline at end of file
+func (p *Proposal) String() string {
+	return fmt.Sprintf("Proposal{ID: %d, Title: %s, Proposer: %s, Status: %s, TotalDeposit: %s, VotingEndTime: %s, Messages: %d}", p.ID, p.Title, p.Proposer, p.Status, p.TotalDeposit, p.VotingEndTime, len(p.Messages))
+}
+
+// Marshal implements codec.ProtoMarshaler for Proposal.
+func (p *Proposal) Marshal() ([]byte, error) { return json.Marshal(p) }
+
+// MarshalTo implements codec.ProtoMarshaler for Proposal.
+func (p *Proposal) MarshalTo(data []byte) (int, error) { return jsonMarshalTo(p, data) }
+
+// MarshalToSizedBuffer implements codec.ProtoMarshaler for Proposal.
+func (p *Proposal) MarshalToSizedBuffer(data []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(p, data)
+}
+
+// Unmarshal implements codec.ProtoMarshaler for Proposal.
+func (p *Proposal) Unmarshal(data []byte) error { return json.Unmarshal(data, p) }
+
+// Size implements codec.ProtoMarshaler for Proposal.
+func (p *Proposal) Size() int { return jsonSize(p) }
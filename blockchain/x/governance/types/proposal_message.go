@@ -0,0 +1,57 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxProposalMessages limits the messages a single proposal can carry
+const MaxProposalMessages = 16
+
+// ProposalMessage is a message a proposal executes when it passes. Value is
+// the JSON encoding of the message named by TypeURL, the same encoding the
+// module types use for their store, so proposals remain readable in queries
+// and genesis.
+type ProposalMessage struct {
+	TypeURL string          `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url"`
+	Value   json.RawMessage `protobuf:"bytes,2,opt,name=value,proto3" json:"value"`
+}
+
+// NewProposalMessages encodes msgs for a proposal to execute
+func NewProposalMessages(msgs ...sdk.Msg) ([]ProposalMessage, error) {
+	messages := make([]ProposalMessage, 0, len(msgs))
+	for _, msg := range msgs {
+		bz, err := json.Marshal(msg)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(ErrInvalidProposalMsg, "%s: %s", sdk.MsgTypeURL(msg), err)
+		}
+		messages = append(messages, ProposalMessage{TypeURL: sdk.MsgTypeURL(msg), Value: bz})
+	}
+	return messages, nil
+}
+
+// ValidateProposalMessages checks that messages are few enough and each
+// names a message type. Whether the type can be executed by a proposal is
+// checked against the keeper's router when the proposal is submitted.
+func ValidateProposalMessages(messages []ProposalMessage) error {
+	if len(messages) > MaxProposalMessages {
+		return sdkerrors.Wrapf(ErrInvalidProposalMsg, "a proposal can carry at most %d messages", MaxProposalMessages)
+	}
+	for i, message := range messages {
+		if len(message.TypeURL) == 0 {
+			return sdkerrors.Wrapf(ErrInvalidProposalMsg, "message %d has no type url", i)
+		}
+		if !json.Valid(message.Value) {
+			return sdkerrors.Wrapf(ErrInvalidProposalMsg, "message %d (%s) is not valid JSON", i, message.TypeURL)
+		}
+	}
+	return nil
+}
+
+// String implements the fmt.Stringer interface for ProposalMessage.
+func (m ProposalMessage) String() string {
+	return fmt.Sprintf("%s%s", m.TypeURL, m.Value)
+}
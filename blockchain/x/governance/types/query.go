@@ -0,0 +1,267 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// QueryProposalRequest is the request type for the Query/Proposal RPC method
+type QueryProposalRequest struct {
+	ProposalId uint64 `json:"proposal_id"`
+}
+
+// QueryProposalResponse is the response type for the Query/Proposal RPC method
+type QueryProposalResponse struct {
+	Proposal Proposal `json:"proposal"`
+}
+
+// QueryProposalsRequest is the request type for the Query/Proposals RPC method
+type QueryProposalsRequest struct {
+	// Status, when set, keeps only the proposals with that status
+	Status     string             `json:"status,omitempty"`
+	Pagination *query.PageRequest `json:"pagination,omitempty"`
+}
+
+// QueryProposalsResponse is the response type for the Query/Proposals RPC method
+type QueryProposalsResponse struct {
+	Proposals  []Proposal          `json:"proposals"`
+	Pagination *query.PageResponse `json:"pagination,omitempty"`
+}
+
+// QueryVoteRequest is the request type for the Query/Vote RPC method
+type QueryVoteRequest struct {
+	ProposalId uint64 `json:"proposal_id"`
+	Voter      string `json:"voter"`
+}
+
+// QueryVoteResponse is the response type for the Query/Vote RPC method
+type QueryVoteResponse struct {
+	Vote Vote `json:"vote"`
+}
+
+// QueryVotesRequest is the request type for the Query/Votes RPC method
+type QueryVotesRequest struct {
+	ProposalId uint64             `json:"proposal_id"`
+	Pagination *query.PageRequest `json:"pagination,omitempty"`
+}
+
+// QueryVotesResponse is the response type for the Query/Votes RPC method
+type QueryVotesResponse struct {
+	Votes      []Vote              `json:"votes"`
+	Pagination *query.PageResponse `json:"pagination,omitempty"`
+}
+
+// QueryDepositsRequest is the request type for the Query/Deposits RPC method
+type QueryDepositsRequest struct {
+	ProposalId uint64             `json:"proposal_id"`
+	Pagination *query.PageRequest `json:"pagination,omitempty"`
+}
+
+// QueryDepositsResponse is the response type for the Query/Deposits RPC method
+type QueryDepositsResponse struct {
+	Deposits   []Deposit           `json:"deposits"`
+	Pagination *query.PageResponse `json:"pagination,omitempty"`
+}
+
+// QueryTallyRequest is the request type for the Query/Tally RPC method
+type QueryTallyRequest struct {
+	ProposalId uint64 `json:"proposal_id"`
+}
+
+// QueryTallyResponse is the response type for the Query/Tally RPC method.
+// During the voting period it holds the votes cast so far.
+type QueryTallyResponse struct {
+	Yes     sdk.Dec `json:"yes"`
+	No      sdk.Dec `json:"no"`
+	Abstain sdk.Dec `json:"abstain"`
+}
+
+// QueryParamsRequest is the request type for the Query/Params RPC method
+type QueryParamsRequest struct{}
+
+// QueryParamsResponse is the response type for the Query/Params RPC method
+type QueryParamsResponse struct {
+	DepositParams DepositParams `json:"deposit_params"`
+	VotingParams  VotingParams  `json:"voting_params"`
+}
+
+func (m *QueryProposalRequest) ProtoMessage() {}
+func (m *QueryProposalRequest) Reset()        { *m = QueryProposalRequest{} }
+func (m *QueryProposalRequest) String() string {
+	return fmt.Sprintf("QueryProposalRequest{%d}", m.ProposalId)
+}
+func (m *QueryProposalRequest) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryProposalRequest) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryProposalRequest) Size() int                        { return jsonSize(m) }
+func (m *QueryProposalRequest) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryProposalRequest) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryProposalResponse) ProtoMessage() {}
+func (m *QueryProposalResponse) Reset()        { *m = QueryProposalResponse{} }
+func (m *QueryProposalResponse) String() string {
+	return "QueryProposalResponse{" + m.Proposal.String() + "}"
+}
+func (m *QueryProposalResponse) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryProposalResponse) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryProposalResponse) Size() int                        { return jsonSize(m) }
+func (m *QueryProposalResponse) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryProposalResponse) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryProposalsRequest) ProtoMessage() {}
+func (m *QueryProposalsRequest) Reset()        { *m = QueryProposalsRequest{} }
+func (m *QueryProposalsRequest) String() string {
+	return fmt.Sprintf("QueryProposalsRequest{Status: %s}", m.Status)
+}
+func (m *QueryProposalsRequest) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryProposalsRequest) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryProposalsRequest) Size() int                        { return jsonSize(m) }
+func (m *QueryProposalsRequest) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryProposalsRequest) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryProposalsResponse) ProtoMessage() {}
+func (m *QueryProposalsResponse) Reset()        { *m = QueryProposalsResponse{} }
+func (m *QueryProposalsResponse) String() string {
+	return fmt.Sprintf("QueryProposalsResponse{%d proposals}", len(m.Proposals))
+}
+func (m *QueryProposalsResponse) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryProposalsResponse) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryProposalsResponse) Size() int                        { return jsonSize(m) }
+func (m *QueryProposalsResponse) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryProposalsResponse) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryVoteRequest) ProtoMessage() {}
+func (m *QueryVoteRequest) Reset()        { *m = QueryVoteRequest{} }
+func (m *QueryVoteRequest) String() string {
+	return fmt.Sprintf("QueryVoteRequest{%d, %s}", m.ProposalId, m.Voter)
+}
+func (m *QueryVoteRequest) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryVoteRequest) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryVoteRequest) Size() int                        { return jsonSize(m) }
+func (m *QueryVoteRequest) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryVoteRequest) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryVoteResponse) ProtoMessage()                    {}
+func (m *QueryVoteResponse) Reset()                           { *m = QueryVoteResponse{} }
+func (m *QueryVoteResponse) String() string                   { return "QueryVoteResponse{" + m.Vote.String() + "}" }
+func (m *QueryVoteResponse) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryVoteResponse) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryVoteResponse) Size() int                        { return jsonSize(m) }
+func (m *QueryVoteResponse) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryVoteResponse) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryVotesRequest) ProtoMessage() {}
+func (m *QueryVotesRequest) Reset()        { *m = QueryVotesRequest{} }
+func (m *QueryVotesRequest) String() string {
+	return fmt.Sprintf("QueryVotesRequest{%d}", m.ProposalId)
+}
+func (m *QueryVotesRequest) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryVotesRequest) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryVotesRequest) Size() int                        { return jsonSize(m) }
+func (m *QueryVotesRequest) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryVotesRequest) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryVotesResponse) ProtoMessage() {}
+func (m *QueryVotesResponse) Reset()        { *m = QueryVotesResponse{} }
+func (m *QueryVotesResponse) String() string {
+	return fmt.Sprintf("QueryVotesResponse{%d votes}", len(m.Votes))
+}
+func (m *QueryVotesResponse) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryVotesResponse) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryVotesResponse) Size() int                        { return jsonSize(m) }
+func (m *QueryVotesResponse) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryVotesResponse) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryDepositsRequest) ProtoMessage() {}
+func (m *QueryDepositsRequest) Reset()        { *m = QueryDepositsRequest{} }
+func (m *QueryDepositsRequest) String() string {
+	return fmt.Sprintf("QueryDepositsRequest{%d}", m.ProposalId)
+}
+func (m *QueryDepositsRequest) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryDepositsRequest) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryDepositsRequest) Size() int                        { return jsonSize(m) }
+func (m *QueryDepositsRequest) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryDepositsRequest) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryDepositsResponse) ProtoMessage() {}
+func (m *QueryDepositsResponse) Reset()        { *m = QueryDepositsResponse{} }
+func (m *QueryDepositsResponse) String() string {
+	return fmt.Sprintf("QueryDepositsResponse{%d deposits}", len(m.Deposits))
+}
+func (m *QueryDepositsResponse) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryDepositsResponse) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryDepositsResponse) Size() int                        { return jsonSize(m) }
+func (m *QueryDepositsResponse) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryDepositsResponse) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryTallyRequest) ProtoMessage() {}
+func (m *QueryTallyRequest) Reset()        { *m = QueryTallyRequest{} }
+func (m *QueryTallyRequest) String() string {
+	return fmt.Sprintf("QueryTallyRequest{%d}", m.ProposalId)
+}
+func (m *QueryTallyRequest) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryTallyRequest) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryTallyRequest) Size() int                        { return jsonSize(m) }
+func (m *QueryTallyRequest) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryTallyRequest) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryTallyResponse) ProtoMessage() {}
+func (m *QueryTallyResponse) Reset()        { *m = QueryTallyResponse{} }
+func (m *QueryTallyResponse) String() string {
+	return fmt.Sprintf("QueryTallyResponse{Yes: %s, No: %s, Abstain: %s}", m.Yes, m.No, m.Abstain)
+}
+func (m *QueryTallyResponse) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryTallyResponse) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryTallyResponse) Size() int                        { return jsonSize(m) }
+func (m *QueryTallyResponse) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryTallyResponse) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryParamsRequest) ProtoMessage()                    {}
+func (m *QueryParamsRequest) Reset()                           { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string                   { return "QueryParamsRequest{}" }
+func (m *QueryParamsRequest) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryParamsRequest) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryParamsRequest) Size() int                        { return jsonSize(m) }
+func (m *QueryParamsRequest) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryParamsRequest) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryParamsResponse) ProtoMessage() {}
+func (m *QueryParamsResponse) Reset()        { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string {
+	return "QueryParamsResponse{" + m.DepositParams.String() + ", " + m.VotingParams.String() + "}"
+}
+func (m *QueryParamsResponse) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryParamsResponse) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryParamsResponse) Size() int                        { return jsonSize(m) }
+func (m *QueryParamsResponse) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryParamsResponse) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
@@ -0,0 +1,317 @@
+package types
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	nfttypes "skaffacity/x/nft/types"
+)
+
+// QueryServer defines the gRPC querier service, see skaffacity/governance/v1/query.proto.
+type QueryServer interface {
+	// Proposal returns a single proposal.
+	Proposal(context.Context, *QueryProposalRequest) (*QueryProposalResponse, error)
+	// Proposals returns a page of the proposals, optionally only those with a status.
+	Proposals(context.Context, *QueryProposalsRequest) (*QueryProposalsResponse, error)
+	// Vote returns the vote of an account on a proposal.
+	Vote(context.Context, *QueryVoteRequest) (*QueryVoteResponse, error)
+	// Votes returns a page of the votes on a proposal.
+	Votes(context.Context, *QueryVotesRequest) (*QueryVotesResponse, error)
+	// Deposits returns a page of the deposits on a proposal that is not final yet.
+	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
+	// Tally returns the tally of a proposal, so far if it is still being voted on.
+	Tally(context.Context, *QueryTallyRequest) (*QueryTallyResponse, error)
+	// Params returns the governance deposit and voting params.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+}
+
+// QueryClient defines the gRPC querier client.
+type QueryClient interface {
+	Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error)
+	Proposals(ctx context.Context, in *QueryProposalsRequest, opts ...grpc.CallOption) (*QueryProposalsResponse, error)
+	Vote(ctx context.Context, in *QueryVoteRequest, opts ...grpc.CallOption) (*QueryVoteResponse, error)
+	Votes(ctx context.Context, in *QueryVotesRequest, opts ...grpc.CallOption) (*QueryVotesResponse, error)
+	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
+	Tally(ctx context.Context, in *QueryTallyRequest, opts ...grpc.CallOption) (*QueryTallyResponse, error)
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+}
+
+const queryServiceName = "skaffacity.governance.v1.Query"
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+// NewQueryClient creates a new QueryClient.
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error) {
+	out := new(QueryProposalResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/Proposal", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Proposals(ctx context.Context, in *QueryProposalsRequest, opts ...grpc.CallOption) (*QueryProposalsResponse, error) {
+	out := new(QueryProposalsResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/Proposals", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Vote(ctx context.Context, in *QueryVoteRequest, opts ...grpc.CallOption) (*QueryVoteResponse, error) {
+	out := new(QueryVoteResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/Vote", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Votes(ctx context.Context, in *QueryVotesRequest, opts ...grpc.CallOption) (*QueryVotesResponse, error) {
+	out := new(QueryVotesResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/Votes", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error) {
+	out := new(QueryDepositsResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/Deposits", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Tally(ctx context.Context, in *QueryTallyRequest, opts ...grpc.CallOption) (*QueryTallyResponse, error) {
+	out := new(QueryTallyResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/Tally", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/Params", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RegisterQueryServer registers a service implementation with the server.
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Proposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Proposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + queryServiceName + "/Proposal"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Proposal(ctx, req.(*QueryProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Proposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Proposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + queryServiceName + "/Proposals"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Proposals(ctx, req.(*QueryProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Vote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + queryServiceName + "/Vote"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Vote(ctx, req.(*QueryVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Votes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Votes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + queryServiceName + "/Votes"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Votes(ctx, req.(*QueryVotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Deposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Deposits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + queryServiceName + "/Deposits"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Deposits(ctx, req.(*QueryDepositsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Tally_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTallyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Tally(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + queryServiceName + "/Tally"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Tally(ctx, req.(*QueryTallyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + queryServiceName + "/Params"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: queryServiceName,
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "Proposal", Handler: _Query_Proposal_Handler},
+		{MethodName: "Proposals", Handler: _Query_Proposals_Handler},
+		{MethodName: "Vote", Handler: _Query_Vote_Handler},
+		{MethodName: "Votes", Handler: _Query_Votes_Handler},
+		{MethodName: "Deposits", Handler: _Query_Deposits_Handler},
+		{MethodName: "Tally", Handler: _Query_Tally_Handler},
+		{MethodName: "Params", Handler: _Query_Params_Handler},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "skaffacity/governance/v1/query.proto",
+}
+
+var (
+	pattern_Query_Proposal_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"skaffacity", "governance", "v1", "proposals", "proposal_id"}, "", runtime.AssumeColonVerbOpt(false)))
+
+	pattern_Query_Proposals_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"skaffacity", "governance", "v1", "proposals"}, "", runtime.AssumeColonVerbOpt(false)))
+
+	pattern_Query_Vote_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5, 1, 0, 4, 1, 5, 6}, []string{"skaffacity", "governance", "v1", "proposals", "proposal_id", "votes", "voter"}, "", runtime.AssumeColonVerbOpt(false)))
+
+	pattern_Query_Votes_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"skaffacity", "governance", "v1", "proposals", "proposal_id", "votes"}, "", runtime.AssumeColonVerbOpt(false)))
+
+	pattern_Query_Deposits_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"skaffacity", "governance", "v1", "proposals", "proposal_id", "deposits"}, "", runtime.AssumeColonVerbOpt(false)))
+
+	pattern_Query_Tally_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"skaffacity", "governance", "v1", "proposals", "proposal_id", "tally"}, "", runtime.AssumeColonVerbOpt(false)))
+
+	pattern_Query_Params_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"skaffacity", "governance", "v1", "params"}, "", runtime.AssumeColonVerbOpt(false)))
+)
+
+// RegisterQueryHandlerClient registers the query REST routes on mux.
+func RegisterQueryHandlerClient(ctx context.Context, mux *runtime.ServeMux, client QueryClient) error {
+	nfttypes.HandleGateway(mux, pattern_Query_Proposal_0, func(ctx context.Context, req *http.Request, pathParams map[string]string) (interface{}, error) {
+		proposalID, err := strconv.ParseUint(pathParams["proposal_id"], 10, 64)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid proposal_id: %s", err)
+		}
+		return client.Proposal(ctx, &QueryProposalRequest{ProposalId: proposalID})
+	})
+
+	nfttypes.HandleGateway(mux, pattern_Query_Proposals_0, func(ctx context.Context, req *http.Request, pathParams map[string]string) (interface{}, error) {
+		pageReq, err := nfttypes.PageRequestFromQuery(req.URL.Query())
+		if err != nil {
+			return nil, err
+		}
+		return client.Proposals(ctx, &QueryProposalsRequest{Status: req.URL.Query().Get("status"), Pagination: pageReq})
+	})
+
+	nfttypes.HandleGateway(mux, pattern_Query_Vote_0, func(ctx context.Context, req *http.Request, pathParams map[string]string) (interface{}, error) {
+		proposalID, err := strconv.ParseUint(pathParams["proposal_id"], 10, 64)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid proposal_id: %s", err)
+		}
+		return client.Vote(ctx, &QueryVoteRequest{ProposalId: proposalID, Voter: pathParams["voter"]})
+	})
+
+	nfttypes.HandleGateway(mux, pattern_Query_Votes_0, func(ctx context.Context, req *http.Request, pathParams map[string]string) (interface{}, error) {
+		proposalID, err := strconv.ParseUint(pathParams["proposal_id"], 10, 64)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid proposal_id: %s", err)
+		}
+		pageReq, err := nfttypes.PageRequestFromQuery(req.URL.Query())
+		if err != nil {
+			return nil, err
+		}
+		return client.Votes(ctx, &QueryVotesRequest{ProposalId: proposalID, Pagination: pageReq})
+	})
+
+	nfttypes.HandleGateway(mux, pattern_Query_Deposits_0, func(ctx context.Context, req *http.Request, pathParams map[string]string) (interface{}, error) {
+		proposalID, err := strconv.ParseUint(pathParams["proposal_id"], 10, 64)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid proposal_id: %s", err)
+		}
+		pageReq, err := nfttypes.PageRequestFromQuery(req.URL.Query())
+		if err != nil {
+			return nil, err
+		}
+		return client.Deposits(ctx, &QueryDepositsRequest{ProposalId: proposalID, Pagination: pageReq})
+	})
+
+	nfttypes.HandleGateway(mux, pattern_Query_Tally_0, func(ctx context.Context, req *http.Request, pathParams map[string]string) (interface{}, error) {
+		proposalID, err := strconv.ParseUint(pathParams["proposal_id"], 10, 64)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid proposal_id: %s", err)
+		}
+		return client.Tally(ctx, &QueryTallyRequest{ProposalId: proposalID})
+	})
+
+	nfttypes.HandleGateway(mux, pattern_Query_Params_0, func(ctx context.Context, req *http.Request, pathParams map[string]string) (interface{}, error) {
+		return client.Params(ctx, &QueryParamsRequest{})
+	})
+
+	return nil
+}
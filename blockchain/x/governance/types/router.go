@@ -0,0 +1,78 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Router routes the messages of passed proposals to the handlers of the
+// modules that own them. Only message types with a route can be carried by
+// a proposal, so the router is also the list of what governance may change.
+type Router struct {
+	routes map[string]route
+	sealed bool
+}
+
+type route struct {
+	msgType reflect.Type
+	handler sdk.Handler
+}
+
+// NewRouter creates an empty Router
+func NewRouter() *Router {
+	return &Router{routes: make(map[string]route)}
+}
+
+// AddRoute routes messages of msg's type to handler. It panics when the
+// router is sealed or the type already has a route.
+func (r *Router) AddRoute(msg sdk.Msg, handler sdk.Handler) *Router {
+	if r.sealed {
+		panic("router sealed; cannot add route")
+	}
+
+	typeURL := sdk.MsgTypeURL(msg)
+	if _, ok := r.routes[typeURL]; ok {
+		panic(fmt.Sprintf("route %s has already been added", typeURL))
+	}
+
+	msgType := reflect.TypeOf(msg)
+	if msgType.Kind() != reflect.Ptr {
+		panic(fmt.Sprintf("route %s must be added with a pointer to its message", typeURL))
+	}
+
+	r.routes[typeURL] = route{msgType: msgType.Elem(), handler: handler}
+	return r
+}
+
+// Seal prevents routes from being added
+func (r *Router) Seal() {
+	r.sealed = true
+}
+
+// HasRoute reports whether messages of typeURL can be executed
+func (r *Router) HasRoute(typeURL string) bool {
+	_, ok := r.routes[typeURL]
+	return ok
+}
+
+// Unpack decodes a proposal message and returns it with its handler
+func (r *Router) Unpack(message ProposalMessage) (sdk.Msg, sdk.Handler, error) {
+	route, ok := r.routes[message.TypeURL]
+	if !ok {
+		return nil, nil, sdkerrors.Wrapf(ErrInvalidProposalMsg, "%s cannot be executed by a proposal", message.TypeURL)
+	}
+
+	msg := reflect.New(route.msgType).Interface().(sdk.Msg)
+	decoder := json.NewDecoder(bytes.NewReader(message.Value))
+	// a misspelt field would otherwise be dropped and voted on unnoticed
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(msg); err != nil {
+		return nil, nil, sdkerrors.Wrapf(ErrInvalidProposalMsg, "%s: %s", message.TypeURL, err)
+	}
+	return msg, route.handler, nil
+}
@@ -14,6 +14,16 @@ const (
     VoteAbstain VoteOption = "abstain"
 )
 
+// DepositParams defines the parameters for proposal deposits
+type DepositParams struct {
+    // MinDeposit is what a proposal's deposits must add up to before it is
+    // voted on
+    MinDeposit sdk.Coins `json:"min_deposit"`
+    // MaxDepositPeriod is how long a proposal may wait for its minimum
+    // deposit before it fails
+    MaxDepositPeriod time.Duration `json:"max_deposit_period"`
+}
+
 // VotingParams defines the parameters for voting
 type VotingParams struct {
     VotingPeriod    time.Duration `json:"voting_period"`
@@ -1,10 +1,34 @@
 package types
 
+import (
+	"encoding/json"
+	"fmt"
+)
+
 // ProtoMessage implements the proto.Message interface for Vote.
 func (v *Vote) ProtoMessage() {}
 
 // Reset implements the proto.Message interface for Vote.
-func (v *Vote) Reset() {}
+func (v *Vote) Reset() { *v = Vote{} }
 
 // String implements the fmt.Stringer interface for Vote.
-func (v *Vote) String() string { return "Vote stub" }
\ No newline at end of file
+func (v *Vote) String() string {
+	return fmt.Sprintf("Vote{ProposalID: %d, Voter: %s, Option: %s, Weight: %s}", v.ProposalID, v.Voter, v.Option, v.Weight)
+}
+
+// Marshal implements codec.ProtoMarshaler for Vote.
+func (v *Vote) Marshal() ([]byte, error) { return json.Marshal(v) }
+
+// MarshalTo implements codec.ProtoMarshaler for Vote.
+func (v *Vote) MarshalTo(data []byte) (int, error) { return jsonMarshalTo(v, data) }
+
+// MarshalToSizedBuffer implements codec.ProtoMarshaler for Vote.
+func (v *Vote) MarshalToSizedBuffer(data []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(v, data)
+}
+
+// Unmarshal implements codec.ProtoMarshaler for Vote.
+func (v *Vote) Unmarshal(data []byte) error { return json.Unmarshal(data, v) }
+
+// Size implements codec.ProtoMarshaler for Vote.
+func (v *Vote) Size() int { return jsonSize(v) }
@@ -0,0 +1,40 @@
+package marketplace
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"skaffacity/x/marketplace/keeper"
+	"skaffacity/x/marketplace/types"
+)
+
+// EndBlocker settles the auctions that have ended, refunds the offers that
+// have expired and withdraws the listings that have expired by the end of
+// the block. It also drops the sales that have left the volume window.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	for _, auction := range k.SettleAuctions(ctx) {
+		ctx.EventManager().EmitEvent(types.NewSettleAuctionEvent(auction))
+	}
+
+	for _, offer := range k.ExpireOffers(ctx) {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeOfferExpired,
+				sdk.NewAttribute(types.AttributeKeyOfferID, strconv.FormatUint(offer.ID, 10)),
+				sdk.NewAttribute(types.AttributeKeyBuyer, offer.Buyer),
+				sdk.NewAttribute(types.AttributeKeyPrice, offer.Price.String()),
+			),
+		)
+	}
+
+	for _, listing := range k.ExpireListings(ctx) {
+		ctx.EventManager().EmitEvent(types.NewListingEvent(types.EventTypeListingExpired, listing))
+	}
+
+	k.PruneSales(ctx)
+}
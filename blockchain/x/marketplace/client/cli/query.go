@@ -0,0 +1,593 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"skaffacity/x/marketplace/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdQueryListing(),
+		CmdQueryListings(),
+		CmdQueryListingsByType(),
+		CmdQueryListingsByOwner(),
+		CmdQueryMarketStats(),
+		CmdQueryAuction(),
+		CmdQueryAuctions(),
+		CmdQueryParams(),
+		CmdQueryOffer(),
+		CmdQueryOffersByNFT(),
+		CmdQueryOffersMade(),
+		CmdQueryOffersReceived(),
+		CmdQueryCollectionBids(),
+		CmdQuerySaleHistory(),
+		CmdQueryLastSale(),
+		CmdQueryFloorPrice(),
+		CmdQueryVolume(),
+	)
+
+	return cmd
+}
+
+func CmdQueryListing() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "listing [listing-id]",
+		Short: "Query a listing by ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			listingID, err := parseListingID(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Listing(cmd.Context(), &types.QueryListingRequest{Id: listingID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryListings() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "listings",
+		Short: "Query the active listings",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Listings(cmd.Context(), &types.QueryListingsRequest{Denom: denom, Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagDenom, "", "Only show listings priced in this denom")
+	flags.AddPaginationFlagsToCmd(cmd, "listings")
+	return cmd
+}
+
+func CmdQueryListingsByType() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "listings-by-type [nft-type]",
+		Short: "Query the active listings of an NFT type",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ListingsByType(cmd.Context(), &types.QueryListingsByTypeRequest{Type: args[0], Denom: denom, Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagDenom, "", "Only show listings priced in this denom")
+	flags.AddPaginationFlagsToCmd(cmd, "listings-by-type")
+	return cmd
+}
+
+func CmdQueryListingsByOwner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "listings-by-owner [address]",
+		Short: "Query all listings created by an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ListingsByOwner(cmd.Context(), &types.QueryListingsByOwnerRequest{Owner: args[0], Denom: denom, Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagDenom, "", "Only show listings priced in this denom")
+	flags.AddPaginationFlagsToCmd(cmd, "listings-by-owner")
+	return cmd
+}
+
+func CmdQueryMarketStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Query the marketplace statistics",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MarketStats(cmd.Context(), &types.QueryMarketStatsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryAuction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auction [auction-id]",
+		Short: "Query an auction and its current price",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			auctionID, err := parseAuctionID(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Auction(cmd.Context(), &types.QueryAuctionRequest{Id: auctionID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryAuctions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auctions",
+		Short: "Query the active auctions, ending soonest first",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Auctions(cmd.Context(), &types.QueryAuctionsRequest{Denom: denom, Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagDenom, "", "Only show auctions priced in this denom")
+	flags.AddPaginationFlagsToCmd(cmd, "auctions")
+	return cmd
+}
+
+func CmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the marketplace module params",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "offer [offer-id]",
+		Short: "Query an offer or collection bid by ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			offerID, err := parseOfferID(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Offer(cmd.Context(), &types.QueryOfferRequest{Id: offerID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryOffersByNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nft-offers [nft-id]",
+		Short: "Query the active offers on an NFT",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.OffersByNFT(cmd.Context(), &types.QueryOffersByNFTRequest{NftId: args[0], Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "nft-offers")
+	return cmd
+}
+
+func CmdQueryOffersMade() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "offers-made [buyer]",
+		Short: "Query the offers and collection bids made by an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.OffersMade(cmd.Context(), &types.QueryOffersMadeRequest{Buyer: args[0], Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "offers-made")
+	return cmd
+}
+
+func CmdQueryOffersReceived() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "offers-received [owner]",
+		Short: "Query the active offers and collection bids an owner can accept",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.OffersReceived(cmd.Context(), &types.QueryOffersReceivedRequest{Owner: args[0], Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "offers-received")
+	return cmd
+}
+
+func CmdQueryCollectionBids() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "collection-bids",
+		Short: "Query the active collection bids, expiring soonest first",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CollectionBids(cmd.Context(), &types.QueryCollectionBidsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "collection-bids")
+	return cmd
+}
+
+func CmdQuerySaleHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sale-history [nft-id]",
+		Short: "Query the sales of an NFT, oldest first",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SaleHistory(cmd.Context(), &types.QuerySaleHistoryRequest{NftId: args[0], Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "sale-history")
+	return cmd
+}
+
+func CmdQueryLastSale() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "last-sale [nft-id]",
+		Short: "Query the most recent sale of an NFT",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LastSale(cmd.Context(), &types.QueryLastSaleRequest{NftId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryFloorPrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "floor [nft-type]",
+		Short: "Query the lowest price an NFT type is listed at",
+		Long: `Query the cheapest active listing of an NFT type in each accepted payment
+denom, or only in the denom given with --denom. Bundle listings are not counted.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FloorPrice(cmd.Context(), &types.QueryFloorPriceRequest{Type: args[0], Denom: denom})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagDenom, "", "Only show the floor price in this denom")
+	return cmd
+}
+
+func CmdQueryVolume() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "volume",
+		Short: "Query the trading volume of the last 24 hours and 7 days",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			nftType, err := cmd.Flags().GetString(FlagNFTType)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Volume(cmd.Context(), &types.QueryVolumeRequest{Type: nftType})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagNFTType, "", "Only count sales of this NFT type")
+	return cmd
+}
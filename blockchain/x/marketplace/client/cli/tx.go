@@ -1,10 +1,29 @@
 package cli
 
 import (
+	"fmt"
+	"strconv"
+	"time"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"skaffacity/x/marketplace/types"
+)
+
+const (
+	FlagReservePrice    = "reserve-price"
+	FlagMinBidIncrement = "min-increment"
+	FlagEndPrice        = "end-price"
+	FlagClassID         = "class"
+	FlagNFTType         = "type"
+	FlagMinLandSize     = "min-land-size"
+	FlagDenom           = "denom"
+	FlagDuration        = "duration"
 )
 
 func GetTxCmd() *cobra.Command {
@@ -18,8 +37,16 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetCmdCreateListing(),
+		GetCmdCreateBundleListing(),
 		GetCmdBuyItem(),
 		GetCmdCancelListing(),
+		GetCmdCreateAuction(),
+		GetCmdPlaceBid(),
+		GetCmdCancelAuction(),
+		GetCmdMakeOffer(),
+		GetCmdPlaceCollectionBid(),
+		GetCmdAcceptOffer(),
+		GetCmdCancelOffer(),
 	)
 
 	return cmd
@@ -29,14 +56,88 @@ func GetCmdCreateListing() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list [nft-id] [price]",
 		Short: "Create a new marketplace listing",
-		Args:  cobra.ExactArgs(2),
+		Long: `List an NFT you own for sale at a fixed price, for example 100skaf. The NFT
+is held in escrow by the marketplace until it is sold or the listing is cancelled.
+With --duration, for example 72h, the listing expires and the NFT is returned
+once that time has passed.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			price, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			duration, err := cmd.Flags().GetDuration(FlagDuration)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateListing(
+				clientCtx.GetFromAddress().String(),
+				args[0], // nft-id
+				price,
+				duration,
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
 
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
 
+	cmd.Flags().Duration(FlagDuration, 0, "How long the listing stays open (0 means it never expires)")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdCreateBundleListing() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-bundle [price] [nft-id] [nft-id]...",
+		Short: "List several NFTs for sale as one lot",
+		Long: fmt.Sprintf(`List between 2 and %d NFTs you own, such as a land parcel with its buildings,
+for sale together at one fixed price. All of them are held in escrow until
+the lot is sold, cancelled or expires, and a buyer gets all of them or none.
+The lot is bought and cancelled by listing id like any other listing.`, types.MaxBundleSize),
+		Args: cobra.MinimumNArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return nil
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			price, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			duration, err := cmd.Flags().GetDuration(FlagDuration)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateBundleListing(
+				clientCtx.GetFromAddress().String(),
+				args[1:], // nft-ids
+				price,
+				duration,
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().Duration(FlagDuration, 0, "How long the listing stays open (0 means it never expires)")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -46,9 +147,24 @@ func GetCmdBuyItem() *cobra.Command {
 		Use:   "buy [listing-id]",
 		Short: "Buy an item from the marketplace",
 		Args:  cobra.ExactArgs(1),
-
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return nil
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			listingID, err := parseListingID(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBuyItem(clientCtx.GetFromAddress().String(), listingID)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
@@ -61,12 +177,361 @@ func GetCmdCancelListing() *cobra.Command {
 		Use:   "cancel [listing-id]",
 		Short: "Cancel a marketplace listing",
 		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			listingID, err := parseListingID(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelListing(clientCtx.GetFromAddress().String(), listingID)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdCreateAuction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auction [nft-id] [english|dutch] [start-price] [duration]",
+		Short: "Put an NFT you own up for auction",
+		Long: `Put an NFT you own up for auction for a duration such as 72h. The NFT is held
+in escrow by the marketplace until the auction is settled or cancelled.
+
+An english auction opens at the start price and sells to the highest bid when
+it ends, provided the bid meets the --reserve-price. Every bid must beat the
+previous one by at least --min-increment.
+
+A dutch auction asks the start price and falls linearly to the --end-price
+over its duration. The first bid at or above the asking price buys the NFT.`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			startPrice, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			duration, err := time.ParseDuration(args[3])
+			if err != nil {
+				return err
+			}
+
+			var prices [3]sdk.Coin
+			for i, flag := range []string{FlagReservePrice, FlagMinBidIncrement, FlagEndPrice} {
+				value, err := cmd.Flags().GetString(flag)
+				if err != nil {
+					return err
+				}
+				if value == "" {
+					continue
+				}
+				if prices[i], err = sdk.ParseCoinNormalized(value); err != nil {
+					return fmt.Errorf("invalid --%s: %w", flag, err)
+				}
+			}
+
+			msg := types.NewMsgCreateAuction(
+				clientCtx.GetFromAddress().String(),
+				args[0], // nft-id
+				args[1], // auction type
+				startPrice,
+				prices[0], // reserve price
+				prices[1], // minimum bid increment
+				prices[2], // end price
+				duration,
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagReservePrice, "", "Lowest winning bid of an english auction")
+	cmd.Flags().String(FlagMinBidIncrement, "", "Amount each english bid must beat the previous one by")
+	cmd.Flags().String(FlagEndPrice, "", "Price a dutch auction falls to when it ends")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdPlaceBid() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bid [auction-id] [amount]",
+		Short: "Bid on an auction",
+		Long: `Bid on an auction. English bids are escrowed and refunded when outbid. On a
+dutch auction a bid at or above the asking price buys the NFT at the asking price.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			auctionID, err := parseAuctionID(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPlaceBid(clientCtx.GetFromAddress().String(), auctionID, amount)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdCancelAuction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-auction [auction-id]",
+		Short: "Cancel an auction that has no bids",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			auctionID, err := parseAuctionID(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelAuction(clientCtx.GetFromAddress().String(), auctionID)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdMakeOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "offer [nft-id] [price] [duration]",
+		Short: "Offer to buy any NFT, listed or not",
+		Long: `Offer a price for an NFT, whether or not it is listed, for a duration such as
+72h. The price is held in escrow until the owner accepts the offer, you cancel
+it or it expires.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			price, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			duration, err := time.ParseDuration(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMakeOffer(clientCtx.GetFromAddress().String(), args[0], price, duration)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
 
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdPlaceCollectionBid() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "collection-bid [price] [duration]",
+		Short: "Bid on any one NFT of a class or type",
+		Long: `Bid a price for any one NFT matching the --class, --type and --min-land-size
+criteria, for a duration such as 72h. For example, to buy any land parcel of
+size 4 or more for 500skaf:
+
+  collection-bid 500skaf 168h --type land --min-land-size 4
+
+The price is held in escrow until the owner of a matching NFT accepts the bid,
+you cancel it or it expires.`,
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return nil
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			price, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			duration, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			classID, err := cmd.Flags().GetString(FlagClassID)
+			if err != nil {
+				return err
+			}
+			nftType, err := cmd.Flags().GetString(FlagNFTType)
+			if err != nil {
+				return err
+			}
+			minLandSize, err := cmd.Flags().GetUint32(FlagMinLandSize)
+			if err != nil {
+				return err
+			}
+
+			criteria := types.BidCriteria{
+				ClassID:     classID,
+				NFTType:     nftType,
+				MinLandSize: minLandSize,
+			}
+			msg := types.NewMsgPlaceCollectionBid(clientCtx.GetFromAddress().String(), criteria, price, duration)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagClassID, "", "Only accept NFTs of this class")
+	cmd.Flags().String(FlagNFTType, "", "Only accept NFTs of this type")
+	cmd.Flags().Uint32(FlagMinLandSize, 0, "Only accept land parcels at least this size (requires --type land)")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func GetCmdAcceptOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-offer [offer-id] [nft-id]",
+		Short: "Sell an NFT you own to an offer or collection bid",
+		Long: `Sell an NFT you own to an offer and receive its escrowed price, less any
+royalty and marketplace fee. The NFT id can be left out for an offer on a
+single NFT and names the NFT to sell for a collection bid.`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			offerID, err := parseOfferID(args[0])
+			if err != nil {
+				return err
+			}
+
+			nftID := ""
+			if len(args) > 1 {
+				nftID = args[1]
+			}
+
+			msg := types.NewMsgAcceptOffer(clientCtx.GetFromAddress().String(), offerID, nftID)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdCancelOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-offer [offer-id]",
+		Short: "Cancel an offer or collection bid and get its price back",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			offerID, err := parseOfferID(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelOffer(clientCtx.GetFromAddress().String(), offerID)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseOfferID parses an offer ID argument
+func parseOfferID(arg string) (uint64, error) {
+	id, err := strconv.ParseUint(arg, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid offer id %q: %w", arg, err)
+	}
+	return id, nil
+}
+
+// parseAuctionID parses an auction ID argument
+func parseAuctionID(arg string) (uint64, error) {
+	id, err := strconv.ParseUint(arg, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid auction id %q: %w", arg, err)
+	}
+	return id, nil
+}
+
+// parseListingID parses a listing ID argument
+func parseListingID(arg string) (uint64, error) {
+	id, err := strconv.ParseUint(arg, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid listing id %q: %w", arg, err)
+	}
+	return id, nil
+}
@@ -0,0 +1,62 @@
+package marketplace
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"skaffacity/x/marketplace/keeper"
+	"skaffacity/x/marketplace/types"
+)
+
+// InitGenesis loads params, listings, auctions, offers and sales from
+// genesis, rebuilding their indexes and queues, and restores the ID counters
+// and market statistics. The escrowed NFTs and coins are not moved: the nft
+// and bank genesis must already credit them to the escrow account.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+
+	for _, listing := range genState.Listings {
+		if err := k.ImportListing(ctx, listing); err != nil {
+			panic(fmt.Errorf("failed to import listing %d: %w", listing.ID, err))
+		}
+	}
+
+	for _, auction := range genState.Auctions {
+		if err := k.ImportAuction(ctx, auction); err != nil {
+			panic(fmt.Errorf("failed to import auction %d: %w", auction.ID, err))
+		}
+	}
+
+	for _, offer := range genState.Offers {
+		k.ImportOffer(ctx, offer)
+	}
+
+	for _, sale := range genState.Sales {
+		k.ImportSale(ctx, sale)
+	}
+
+	k.SetNextID(ctx, types.NextListingIDKey, genState.NextListingID)
+	k.SetNextID(ctx, types.NextAuctionIDKey, genState.NextAuctionID)
+	k.SetNextID(ctx, types.NextOfferIDKey, genState.NextOfferID)
+	k.SetNextID(ctx, types.NextSaleIDKey, genState.NextSaleID)
+	k.SetMarketStats(ctx, genState.Stats)
+}
+
+// ExportGenesis returns the marketplace module's exported genesis
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	genesis := types.DefaultGenesisState()
+
+	genesis.Params = k.GetParams(ctx)
+	genesis.Listings = append(genesis.Listings, k.GetAllListings(ctx)...)
+	genesis.NextListingID = k.GetNextID(ctx, types.NextListingIDKey)
+	genesis.Auctions = append(genesis.Auctions, k.GetAllAuctions(ctx)...)
+	genesis.NextAuctionID = k.GetNextID(ctx, types.NextAuctionIDKey)
+	genesis.Offers = append(genesis.Offers, k.GetAllOffers(ctx)...)
+	genesis.NextOfferID = k.GetNextID(ctx, types.NextOfferIDKey)
+	genesis.Sales = append(genesis.Sales, k.GetAllSales(ctx)...)
+	genesis.NextSaleID = k.GetNextID(ctx, types.NextSaleIDKey)
+	genesis.Stats = k.GetMarketStats(ctx)
+
+	return genesis
+}
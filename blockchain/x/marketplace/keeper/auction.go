@@ -0,0 +1,294 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"skaffacity/x/marketplace/types"
+)
+
+// CreateAuction starts an auction at the current block time and moves the
+// NFT into escrow until the auction is settled or cancelled
+func (k Keeper) CreateAuction(ctx sdk.Context, auction types.Auction) (types.Auction, error) {
+	if err := auction.Validate(); err != nil {
+		return types.Auction{}, err
+	}
+
+	if err := k.validatePaymentDenom(ctx, auction.StartPrice); err != nil {
+		return types.Auction{}, err
+	}
+
+	if k.IsListed(ctx, auction.NFTID) {
+		return types.Auction{}, sdkerrors.Wrap(types.ErrNFTAlreadyListed, auction.NFTID)
+	}
+
+	if err := k.escrowNFT(ctx, auction.Creator, auction.NFTID); err != nil {
+		return types.Auction{}, err
+	}
+
+	auction.ID = k.nextID(ctx, types.NextAuctionIDKey)
+	auction.Active = true
+	auction.HighestBid = sdk.NewCoin(auction.StartPrice.Denom, sdk.ZeroInt())
+	auction.HighestBidder = ""
+	auction.Winner = ""
+	k.setAuction(ctx, auction)
+	k.recordOpened(ctx)
+
+	return auction, nil
+}
+
+// ImportAuction stores an auction from genesis without escrowing its NFT.
+// The NFT of an active auction must already be held by the escrow account.
+func (k Keeper) ImportAuction(ctx sdk.Context, auction types.Auction) error {
+	if auction.Active {
+		if err := k.checkEscrowed(ctx, auction.NFTID); err != nil {
+			return err
+		}
+	}
+	k.setAuction(ctx, auction)
+	return nil
+}
+
+// PlaceBid bids amount on an auction. English bids are escrowed, refunding
+// the bid they beat. A Dutch bid at or above the current price buys the NFT
+// at the current price right away, in which case sold is true.
+func (k Keeper) PlaceBid(ctx sdk.Context, bidder string, auctionID uint64, amount sdk.Coin) (auction types.Auction, sold bool, err error) {
+	auction, err = k.activeAuction(ctx, auctionID)
+	if err != nil {
+		return types.Auction{}, false, err
+	}
+
+	if !ctx.BlockTime().Before(auction.EndTime) {
+		return types.Auction{}, false, sdkerrors.Wrapf(types.ErrAuctionNotActive, "auction %d has ended", auctionID)
+	}
+
+	if auction.Creator == bidder {
+		return types.Auction{}, false, types.ErrSelfPurchase
+	}
+
+	if amount.Denom != auction.StartPrice.Denom {
+		return types.Auction{}, false, sdkerrors.Wrapf(types.ErrInvalidPrice, "bids must be in %s", auction.StartPrice.Denom)
+	}
+
+	bidderAddr, err := sdk.AccAddressFromBech32(bidder)
+	if err != nil {
+		return types.Auction{}, false, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid bidder address (%s)", err)
+	}
+
+	if auction.AuctionType == types.AuctionTypeDutch {
+		price := auction.CurrentPrice(ctx.BlockTime())
+		if amount.IsLT(price) {
+			return types.Auction{}, false, sdkerrors.Wrapf(types.ErrBidTooLow, "the current price is %s", price)
+		}
+
+		auction.HighestBid = price
+		auction.HighestBidder = bidder
+		if err := k.sellAuction(ctx, auction, bidderAddr); err != nil {
+			return types.Auction{}, false, err
+		}
+		auction.Active = false
+		auction.Winner = bidder
+		return auction, true, nil
+	}
+
+	if minimum := auction.MinimumBid(); amount.IsLT(minimum) {
+		return types.Auction{}, false, sdkerrors.Wrapf(types.ErrBidTooLow, "bid must be at least %s", minimum)
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, bidderAddr, types.EscrowAddress, sdk.NewCoins(amount)); err != nil {
+		return types.Auction{}, false, err
+	}
+	if err := k.refundHighestBid(ctx, auction); err != nil {
+		return types.Auction{}, false, err
+	}
+
+	auction.HighestBid = amount
+	auction.HighestBidder = bidder
+	k.SetAuction(ctx, auction)
+
+	return auction, false, nil
+}
+
+// CancelAuction withdraws an auction and returns the NFT to its creator.
+// English auctions can no longer be cancelled once they have a bid.
+func (k Keeper) CancelAuction(ctx sdk.Context, sender string, auctionID uint64) (types.Auction, error) {
+	auction, err := k.activeAuction(ctx, auctionID)
+	if err != nil {
+		return types.Auction{}, err
+	}
+
+	if auction.Creator != sender {
+		return types.Auction{}, sdkerrors.Wrap(types.ErrUnauthorized, "only the creator can cancel an auction")
+	}
+
+	if auction.HighestBidder != "" {
+		return types.Auction{}, sdkerrors.Wrap(types.ErrInvalidAuction, "an auction cannot be cancelled once it has bids")
+	}
+
+	if err := k.nftKeeper.TransferNFT(ctx, auction.NFTID, types.EscrowAddress.String(), auction.Creator); err != nil {
+		return types.Auction{}, err
+	}
+
+	k.closeAuction(ctx, auction)
+	k.recordClosed(ctx)
+
+	return auction, nil
+}
+
+// SettleAuctions closes every active auction whose end time has passed. An
+// English auction whose highest bid meets the reserve sells to the highest
+// bidder; any other auction returns the NFT to its creator and refunds the
+// highest bid. At most MaxAuctionSettlementsPerBlock are settled per call so
+// the work per block stays bounded. An auction that fails to settle leaves
+// the queue, so it cannot hold a slot every block, and stays active with its
+// escrow untouched. The closed auctions are returned.
+func (k Keeper) SettleAuctions(ctx sdk.Context) []types.Auction {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.AuctionQueueKey, sdk.PrefixEndBytes(types.GetAuctionQueuePrefix(ctx.BlockTime())))
+
+	var ids []uint64
+	for ; iterator.Valid() && len(ids) < types.MaxAuctionSettlementsPerBlock; iterator.Next() {
+		ids = append(ids, sdk.BigEndianToUint64(iterator.Value()))
+	}
+	// settling an auction deletes its queue entry, so the iterator must be
+	// closed first
+	iterator.Close()
+
+	var settled []types.Auction
+	for _, id := range ids {
+		auction, found := k.GetAuction(ctx, id)
+		if !found || !auction.Active {
+			continue
+		}
+
+		// settle each auction on its own so a failure leaves the others,
+		// and its own escrow, untouched
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.settleAuction(cacheCtx, &auction); err != nil {
+			ctx.Logger().Error("failed to settle auction", "auction_id", id, "err", err)
+			store.Delete(types.GetAuctionQueueKey(auction.EndTime, auction.ID))
+			continue
+		}
+		write()
+		settled = append(settled, auction)
+	}
+	return settled
+}
+
+// GetAuction returns an auction by ID, whether or not it is still active
+func (k Keeper) GetAuction(ctx sdk.Context, id uint64) (types.Auction, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetAuctionKey(id))
+	if bz == nil {
+		return types.Auction{}, false
+	}
+
+	var auction types.Auction
+	k.cdc.MustUnmarshal(bz, &auction)
+	return auction, true
+}
+
+// SetAuction stores an auction
+func (k Keeper) SetAuction(ctx sdk.Context, auction types.Auction) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAuctionKey(auction.ID), k.cdc.MustMarshal(&auction))
+}
+
+// setAuction stores an auction and, while it is active, its settlement
+// queue entry
+func (k Keeper) setAuction(ctx sdk.Context, auction types.Auction) {
+	k.SetAuction(ctx, auction)
+	if auction.Active {
+		ctx.KVStore(k.storeKey).Set(types.GetAuctionQueueKey(auction.EndTime, auction.ID), sdk.Uint64ToBigEndian(auction.ID))
+	}
+}
+
+// GetAllAuctions returns every auction, active or not
+func (k Keeper) GetAllAuctions(ctx sdk.Context) []types.Auction {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var auctions []types.Auction
+	for ; iterator.Valid(); iterator.Next() {
+		var auction types.Auction
+		k.cdc.MustUnmarshal(iterator.Value(), &auction)
+		auctions = append(auctions, auction)
+	}
+	return auctions
+}
+
+// settleAuction closes an auction that has reached its end time
+func (k Keeper) settleAuction(ctx sdk.Context, auction *types.Auction) error {
+	if auction.AuctionType == types.AuctionTypeEnglish && auction.ReserveMet() {
+		if err := k.sellAuction(ctx, *auction, types.EscrowAddress); err != nil {
+			return err
+		}
+		auction.Active = false
+		auction.Winner = auction.HighestBidder
+		return nil
+	}
+
+	if err := k.refundHighestBid(ctx, *auction); err != nil {
+		return err
+	}
+	if err := k.nftKeeper.TransferNFT(ctx, auction.NFTID, types.EscrowAddress.String(), auction.Creator); err != nil {
+		return err
+	}
+
+	k.closeAuction(ctx, *auction)
+	k.recordClosed(ctx)
+	auction.Active = false
+	return nil
+}
+
+// sellAuction pays the highest bid from payer, split as for a listing sale,
+// and hands the NFT to the highest bidder
+func (k Keeper) sellAuction(ctx sdk.Context, auction types.Auction, payer sdk.AccAddress) error {
+	if err := k.paySale(ctx, payer, auction.Creator, auction.NFTID, auction.HighestBid); err != nil {
+		return err
+	}
+
+	if err := k.nftKeeper.TransferNFT(ctx, auction.NFTID, types.EscrowAddress.String(), auction.HighestBidder); err != nil {
+		return err
+	}
+
+	auction.Winner = auction.HighestBidder
+	k.closeAuction(ctx, auction)
+	k.recordSale(ctx, auction.HighestBid)
+	k.appendSale(ctx, types.SaleSourceAuction, auction.ID, auction.NFTID, auction.Creator, auction.HighestBidder, auction.HighestBid)
+	return nil
+}
+
+// refundHighestBid returns the escrowed highest bid of an English auction
+func (k Keeper) refundHighestBid(ctx sdk.Context, auction types.Auction) error {
+	if auction.AuctionType != types.AuctionTypeEnglish || auction.HighestBidder == "" {
+		return nil
+	}
+
+	bidder, err := sdk.AccAddressFromBech32(auction.HighestBidder)
+	if err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoins(ctx, types.EscrowAddress, bidder, sdk.NewCoins(auction.HighestBid))
+}
+
+// activeAuction returns an auction that still accepts bids or cancellation
+func (k Keeper) activeAuction(ctx sdk.Context, id uint64) (types.Auction, error) {
+	auction, found := k.GetAuction(ctx, id)
+	if !found {
+		return types.Auction{}, sdkerrors.Wrapf(types.ErrAuctionNotFound, "auction %d", id)
+	}
+	if !auction.Active {
+		return types.Auction{}, sdkerrors.Wrapf(types.ErrAuctionNotActive, "auction %d", id)
+	}
+	return auction, nil
+}
+
+// closeAuction marks an auction inactive and removes it from the settlement queue
+func (k Keeper) closeAuction(ctx sdk.Context, auction types.Auction) {
+	auction.Active = false
+	k.SetAuction(ctx, auction)
+	ctx.KVStore(k.storeKey).Delete(types.GetAuctionQueueKey(auction.EndTime, auction.ID))
+}
@@ -0,0 +1,196 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"skaffacity/x/marketplace/types"
+	nftkeeper "skaffacity/x/nft/keeper"
+	nfttypes "skaffacity/x/nft/types"
+)
+
+// mintSwords mints n more swords to seller and returns their full IDs
+func (f fixture) mintSwords(t *testing.T, n int) []string {
+	t.Helper()
+
+	nftServer := nftkeeper.NewMsgServerImpl(*f.nft)
+	nftIDs := make([]string, n)
+	for i := range nftIDs {
+		id := fmt.Sprintf("extra-%d", i)
+		_, err := nftServer.MintNFT(sdk.WrapSDKContext(f.ctx), nfttypes.NewMsgMintNFT(creator, "swords", id, nfttypes.TypeItem, seller, nfttypes.Metadata{Name: id}))
+		require.NoError(t, err)
+		nftIDs[i] = sword(id)
+	}
+	return nftIDs
+}
+
+// englishAuction auctions nftID for a day, opening at 100 with bids rising
+// by at least 10
+func (f fixture) englishAuction(t *testing.T, nftID string, reserve int64) types.Auction {
+	t.Helper()
+
+	auction, err := f.keeper.CreateAuction(f.ctx, types.Auction{
+		AuctionType:     types.AuctionTypeEnglish,
+		Creator:         seller,
+		NFTID:           nftID,
+		StartPrice:      skaf(100),
+		ReservePrice:    skaf(reserve),
+		MinBidIncrement: skaf(10),
+		StartTime:       f.ctx.BlockTime(),
+		EndTime:         f.ctx.BlockTime().Add(24 * time.Hour),
+	})
+	require.NoError(t, err)
+	return auction
+}
+
+// escrowed returns the coins held by the escrow account
+func (f fixture) escrowed() sdk.Coins {
+	return f.bank[types.EscrowAddress.String()]
+}
+
+func TestOutbidBidsAreRefunded(t *testing.T) {
+	f := setupKeeper(t, 0)
+	auction := f.englishAuction(t, sword("1"), 0)
+	first, second := testAddr(6), testAddr(7)
+	f.fund(first, skaf(200))
+	f.fund(second, skaf(200))
+
+	_, _, err := f.keeper.PlaceBid(f.ctx, seller, auction.ID, skaf(100))
+	require.ErrorIs(t, err, types.ErrSelfPurchase)
+	_, _, err = f.keeper.PlaceBid(f.ctx, first, auction.ID, skaf(99))
+	require.ErrorIs(t, err, types.ErrBidTooLow)
+
+	_, _, err = f.keeper.PlaceBid(f.ctx, first, auction.ID, skaf(100))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(skaf(100)), f.bank[first])
+	require.Equal(t, sdk.NewCoins(skaf(100)), f.escrowed())
+
+	_, _, err = f.keeper.PlaceBid(f.ctx, second, auction.ID, skaf(109))
+	require.ErrorIs(t, err, types.ErrBidTooLow)
+	_, _, err = f.keeper.PlaceBid(f.ctx, second, auction.ID, skaf(110))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(skaf(200)), f.bank[first])
+	require.Equal(t, sdk.NewCoins(skaf(90)), f.bank[second])
+	require.Equal(t, sdk.NewCoins(skaf(110)), f.escrowed())
+
+	_, err = f.keeper.CancelAuction(f.ctx, seller, auction.ID)
+	require.ErrorIs(t, err, types.ErrInvalidAuction)
+
+	// bids close when the auction ends, and it settles in that same block
+	f.ctx = f.ctx.WithBlockTime(auction.EndTime)
+	_, _, err = f.keeper.PlaceBid(f.ctx, first, auction.ID, skaf(200))
+	require.ErrorIs(t, err, types.ErrAuctionNotActive)
+
+	settled := f.keeper.SettleAuctions(f.ctx)
+	require.Len(t, settled, 1)
+	require.Equal(t, second, settled[0].Winner)
+	require.Equal(t, second, f.owner(t, sword("1")))
+	require.Equal(t, sdk.NewCoins(skaf(110)), f.bank[seller])
+	require.True(t, f.escrowed().IsZero())
+}
+
+func TestDutchPriceFallsUntilTheFirstBid(t *testing.T) {
+	f := setupKeeper(t, 0)
+	auction, err := f.keeper.CreateAuction(f.ctx, types.Auction{
+		AuctionType: types.AuctionTypeDutch,
+		Creator:     seller,
+		NFTID:       sword("1"),
+		StartPrice:  skaf(1000),
+		EndPrice:    skaf(100),
+		StartTime:   f.ctx.BlockTime(),
+		EndTime:     f.ctx.BlockTime().Add(10 * time.Hour),
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, skaf(1000), auction.CurrentPrice(genesisTime))
+	require.Equal(t, skaf(910), auction.CurrentPrice(genesisTime.Add(time.Hour)))
+	require.Equal(t, skaf(550), auction.CurrentPrice(genesisTime.Add(5*time.Hour)))
+	require.Equal(t, skaf(100), auction.CurrentPrice(genesisTime.Add(11*time.Hour)))
+
+	bidder := testAddr(6)
+	f.fund(bidder, skaf(600))
+	f.ctx = f.ctx.WithBlockTime(genesisTime.Add(5 * time.Hour))
+	_, _, err = f.keeper.PlaceBid(f.ctx, bidder, auction.ID, skaf(549))
+	require.ErrorIs(t, err, types.ErrBidTooLow)
+
+	// the bid buys at the current price, not at the amount bid
+	auction, sold, err := f.keeper.PlaceBid(f.ctx, bidder, auction.ID, skaf(600))
+	require.NoError(t, err)
+	require.True(t, sold)
+	require.Equal(t, skaf(550), auction.HighestBid)
+	require.Equal(t, bidder, f.owner(t, sword("1")))
+	require.Equal(t, sdk.NewCoins(skaf(50)), f.bank[bidder])
+	require.Equal(t, sdk.NewCoins(skaf(550)), f.bank[seller])
+
+	stored, found := f.keeper.GetAuction(f.ctx, auction.ID)
+	require.True(t, found)
+	require.False(t, stored.Active)
+	require.Empty(t, f.keeper.SettleAuctions(f.ctx.WithBlockTime(auction.EndTime)))
+}
+
+func TestAuctionWithoutWinningBidReturnsTheNFT(t *testing.T) {
+	f := setupKeeper(t, 0)
+	unbid := f.englishAuction(t, sword("1"), 0)
+	belowReserve := f.englishAuction(t, sword("2"), 500)
+	bidder := testAddr(6)
+	f.fund(bidder, skaf(200))
+	_, _, err := f.keeper.PlaceBid(f.ctx, bidder, belowReserve.ID, skaf(200))
+	require.NoError(t, err)
+
+	require.Empty(t, f.keeper.SettleAuctions(f.ctx.WithBlockTime(unbid.EndTime.Add(-time.Nanosecond))))
+
+	settled := f.keeper.SettleAuctions(f.ctx.WithBlockTime(unbid.EndTime))
+	require.Len(t, settled, 2)
+	for _, auction := range settled {
+		require.False(t, auction.Active)
+		require.Empty(t, auction.Winner)
+	}
+	require.Equal(t, seller, f.owner(t, sword("1")))
+	require.Equal(t, seller, f.owner(t, sword("2")))
+	require.Equal(t, sdk.NewCoins(skaf(200)), f.bank[bidder])
+	require.True(t, f.escrowed().IsZero())
+	require.Empty(t, f.bank[seller])
+	require.Zero(t, f.keeper.GetMarketStats(f.ctx).ActiveListings)
+}
+
+func TestAuctionSettlementsPerBlockAreCapped(t *testing.T) {
+	f := setupKeeper(t, 0)
+	nftIDs := f.mintSwords(t, types.MaxAuctionSettlementsPerBlock+1)
+	var endTime time.Time
+	for _, nftID := range nftIDs {
+		endTime = f.englishAuction(t, nftID, 0).EndTime
+	}
+
+	ctx := f.ctx.WithBlockTime(endTime)
+	require.Len(t, f.keeper.SettleAuctions(ctx), types.MaxAuctionSettlementsPerBlock)
+	require.Len(t, f.keeper.SettleAuctions(ctx.WithBlockTime(endTime.Add(5*time.Second))), 1)
+	require.Empty(t, f.nft.GetNFTsByOwner(ctx, types.EscrowAddress.String()))
+}
+
+func TestFailedSettlementLeavesTheQueue(t *testing.T) {
+	f := setupKeeper(t, 0)
+	failing := f.englishAuction(t, sword("1"), 0)
+	bidder := testAddr(6)
+	f.fund(bidder, skaf(100))
+	_, _, err := f.keeper.PlaceBid(f.ctx, bidder, failing.ID, skaf(100))
+	require.NoError(t, err)
+	other := f.englishAuction(t, sword("2"), 0)
+
+	// the escrowed bid has gone missing, so the sale cannot be paid
+	delete(f.bank, types.EscrowAddress.String())
+
+	settled := f.keeper.SettleAuctions(f.ctx.WithBlockTime(failing.EndTime))
+	require.Len(t, settled, 1)
+	require.Equal(t, other.ID, settled[0].ID)
+
+	stored, found := f.keeper.GetAuction(f.ctx, failing.ID)
+	require.True(t, found)
+	require.True(t, stored.Active)
+	require.Equal(t, types.EscrowAddress.String(), f.owner(t, sword("1")))
+	require.False(t, f.ctx.KVStore(f.storeKey).Has(types.GetAuctionQueueKey(failing.EndTime, failing.ID)))
+	require.Empty(t, f.keeper.SettleAuctions(f.ctx.WithBlockTime(failing.EndTime.Add(time.Hour))))
+}
@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"skaffacity/x/marketplace"
+	"skaffacity/x/marketplace/types"
+)
+
+// trade opens a listing, an auction with a bid and an offer, and sells a
+// bundle, leaving the swords "1" and "4" and the open bids in escrow
+func (f fixture) trade(t *testing.T) {
+	t.Helper()
+
+	_, err := f.keeper.CreateListing(f.ctx, seller, sword("1"), skaf(100), 24*time.Hour)
+	require.NoError(t, err)
+
+	bundle, err := f.keeper.CreateBundleListing(f.ctx, seller, []string{sword("2"), sword("3")}, skaf(301), 0)
+	require.NoError(t, err)
+	f.fund(buyer, skaf(301))
+	_, err = f.keeper.BuyItem(f.ctx, buyer, bundle.ID)
+	require.NoError(t, err)
+
+	auction, err := f.keeper.CreateAuction(f.ctx, types.Auction{
+		AuctionType: types.AuctionTypeEnglish,
+		Creator:     seller,
+		NFTID:       sword("4"),
+		StartPrice:  skaf(100),
+		StartTime:   f.ctx.BlockTime(),
+		EndTime:     f.ctx.BlockTime().Add(24 * time.Hour),
+	})
+	require.NoError(t, err)
+	bidder := testAddr(6)
+	f.fund(bidder, skaf(200))
+	_, _, err = f.keeper.PlaceBid(f.ctx, bidder, auction.ID, skaf(200))
+	require.NoError(t, err)
+
+	f.fund(buyer, skaf(50))
+	_, err = f.keeper.MakeOffer(f.ctx, types.Offer{
+		Buyer:     buyer,
+		NFTID:     sword("1"),
+		Price:     skaf(50),
+		CreatedAt: f.ctx.BlockTime(),
+		ExpiresAt: f.ctx.BlockTime().Add(24 * time.Hour),
+	})
+	require.NoError(t, err)
+}
+
+// clearStore deletes everything the marketplace keeper has stored
+func (f fixture) clearStore() {
+	store := f.ctx.KVStore(f.storeKey)
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func TestGenesisRoundTrip(t *testing.T) {
+	f := setupKeeper(t, 500)
+	f.trade(t)
+
+	exported := marketplace.ExportGenesis(f.ctx, *f.keeper)
+	require.NoError(t, exported.Validate())
+	require.Len(t, exported.Listings, 2)
+	require.Len(t, exported.Auctions, 1)
+	require.Len(t, exported.Offers, 1)
+	require.Len(t, exported.Sales, 2)
+	require.Equal(t, uint64(2), exported.Stats.ActiveListings)
+
+	f.clearStore()
+	require.Empty(t, f.keeper.GetAllListings(f.ctx))
+
+	marketplace.InitGenesis(f.ctx, *f.keeper, *exported)
+	require.Equal(t, exported, marketplace.ExportGenesis(f.ctx, *f.keeper))
+
+	// the indexes and counters are rebuilt along with the records
+	require.True(t, f.keeper.IsListed(f.ctx, sword("1")))
+	sale, found := f.keeper.GetLastSale(f.ctx, sword("3"))
+	require.True(t, found)
+	require.Equal(t, skaf(150), sale.Price)
+	listing, err := f.keeper.CreateListing(f.ctx, buyer, sword("2"), skaf(100), 0)
+	require.NoError(t, err)
+	require.Equal(t, exported.NextListingID, listing.ID)
+}
+
+func TestInitGenesisRejectsUnescrowedListing(t *testing.T) {
+	f := setupKeeper(t, 0)
+
+	genesis := types.DefaultGenesisState()
+	genesis.Listings = []types.Listing{{
+		ID:        1,
+		Creator:   seller,
+		NFTID:     sword("1"),
+		Price:     skaf(100),
+		Active:    true,
+		CreatedAt: genesisTime,
+	}}
+	genesis.NextListingID = 2
+	genesis.Stats.ActiveListings = 1
+	require.NoError(t, genesis.Validate())
+
+	// sword "1" is still held by the seller rather than the escrow account
+	require.Panics(t, func() { marketplace.InitGenesis(f.ctx, *f.keeper, *genesis) })
+}
@@ -1,88 +1,399 @@
 package keeper
 
 import (
-    "context"
-    
-    "google.golang.org/grpc/codes"
-    "google.golang.org/grpc/status"
-    
-    "skaffacity/x/marketplace/types"
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"skaffacity/x/marketplace/types"
 )
 
 type queryServer struct {
-    Keeper
+	Keeper
 }
 
 func NewQueryServer(k Keeper) types.QueryServer {
-    return &queryServer{Keeper: k}
+	return &queryServer{Keeper: k}
 }
 
+var _ types.QueryServer = queryServer{}
+
 // Listing returns the listing information
 func (k queryServer) Listing(c context.Context, req *types.QueryListingRequest) (*types.QueryListingResponse, error) {
-    if req == nil {
-        return nil, status.Error(codes.InvalidArgument, "invalid request")
-    }
-    
-    ctx := c
-    resp, err := k.GetListing(ctx, req)
-    if err != nil {
-        return nil, err
-    }
-    return resp, nil
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	listing, found := k.GetListing(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "listing %d not found", req.Id)
+	}
+
+	return &types.QueryListingResponse{Listing: listing}, nil
 }
 
 // Listings returns all active listings
 func (k queryServer) Listings(c context.Context, req *types.QueryListingsRequest) (*types.QueryListingsResponse, error) {
-    if req == nil {
-        return nil, status.Error(codes.InvalidArgument, "invalid request")
-    }
-    
-    ctx := c
-    resp, err := k.GetAllListings(ctx, req)
-    if err != nil {
-        return nil, err
-    }
-    return resp, nil
-}
-
-// ListingsByType returns all active listings of a specific NFT type
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	listings, pageRes, err := k.paginateNFTListings(ctx, req.Pagination, func(listing types.Listing) bool {
+		return req.Denom == "" || listing.Price.Denom == req.Denom
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryListingsResponse{Listings: listings, Pagination: pageRes}, nil
+}
+
+// ListingsByType returns all active listings of a specific NFT type,
+// including the bundles holding at least one NFT of that type
 func (k queryServer) ListingsByType(c context.Context, req *types.QueryListingsByTypeRequest) (*types.QueryListingsByTypeResponse, error) {
-    if req == nil {
-        return nil, status.Error(codes.InvalidArgument, "invalid request")
-    }
-    
-    ctx := c
-    resp, err := k.GetListingsByType(ctx, req)
-    if err != nil {
-        return nil, err
-    }
-    return resp, nil
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	listings, pageRes, err := k.paginateNFTListings(ctx, req.Pagination, func(listing types.Listing) bool {
+		if req.Denom != "" && listing.Price.Denom != req.Denom {
+			return false
+		}
+		for _, nftID := range listing.NFTIDs() {
+			if nft, err := k.nftKeeper.GetNFT(ctx, nftID); err == nil && nft.Type == req.Type {
+				return true
+			}
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryListingsByTypeResponse{Listings: listings, Pagination: pageRes}, nil
 }
 
 // ListingsByOwner returns all listings by an owner
 func (k queryServer) ListingsByOwner(c context.Context, req *types.QueryListingsByOwnerRequest) (*types.QueryListingsByOwnerResponse, error) {
-    if req == nil {
-        return nil, status.Error(codes.InvalidArgument, "invalid request")
-    }
-    
-    ctx := c
-    resp, err := k.GetListingsByOwner(ctx, req)
-    if err != nil {
-        return nil, err
-    }
-    return resp, nil
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Owner); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	listings, pageRes, err := k.paginateIndex(ctx, types.GetSellerListingPrefix(req.Owner), req.Pagination, func(_ []byte, listing types.Listing) bool {
+		return req.Denom == "" || listing.Price.Denom == req.Denom
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryListingsByOwnerResponse{Listings: listings, Pagination: pageRes}, nil
 }
 
 // MarketStats returns marketplace statistics
 func (k queryServer) MarketStats(c context.Context, req *types.QueryMarketStatsRequest) (*types.QueryMarketStatsResponse, error) {
-    if req == nil {
-        return nil, status.Error(codes.InvalidArgument, "invalid request")
-    }
-    
-    ctx := c
-    resp, err := k.GetMarketStats(ctx, req)
-    if err != nil {
-        return nil, err
-    }
-    return resp, nil
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryMarketStatsResponse{Stats: k.GetMarketStats(ctx)}, nil
+}
+
+// Auction returns an auction with its current price
+func (k queryServer) Auction(c context.Context, req *types.QueryAuctionRequest) (*types.QueryAuctionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	auction, found := k.GetAuction(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "auction %d not found", req.Id)
+	}
+
+	price := auction.MinimumBid()
+	if auction.AuctionType == types.AuctionTypeDutch {
+		price = auction.CurrentPrice(ctx.BlockTime())
+	}
+
+	return &types.QueryAuctionResponse{Auction: auction, CurrentPrice: price}, nil
+}
+
+// Auctions returns the active auctions in order of end time
+func (k queryServer) Auctions(c context.Context, req *types.QueryAuctionsRequest) (*types.QueryAuctionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionQueueKey)
+
+	auctions := []types.Auction{}
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		auction, found := k.GetAuction(ctx, sdk.BigEndianToUint64(value))
+		if !found || (req.Denom != "" && auction.StartPrice.Denom != req.Denom) {
+			return false, nil
+		}
+		if accumulate {
+			auctions = append(auctions, auction)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAuctionsResponse{Auctions: auctions, Pagination: pageRes}, nil
+}
+
+// Params returns the marketplace module params
+func (k queryServer) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// Offer returns a single offer or collection bid
+func (k queryServer) Offer(c context.Context, req *types.QueryOfferRequest) (*types.QueryOfferResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	offer, found := k.GetOffer(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "offer %d not found", req.Id)
+	}
+
+	return &types.QueryOfferResponse{Offer: offer}, nil
+}
+
+// OffersByNFT returns the active offers on an NFT
+func (k queryServer) OffersByNFT(c context.Context, req *types.QueryOffersByNFTRequest) (*types.QueryOffersByNFTResponse, error) {
+	if req == nil || req.NftId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	offers, pageRes, err := k.paginateOffers(ctx, types.GetNFTOfferPrefix(req.NftId), req.Pagination, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryOffersByNFTResponse{Offers: offers, Pagination: pageRes}, nil
+}
+
+// OffersMade returns all offers and collection bids made by a buyer
+func (k queryServer) OffersMade(c context.Context, req *types.QueryOffersMadeRequest) (*types.QueryOffersMadeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Buyer); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	offers, pageRes, err := k.paginateOffers(ctx, types.GetBuyerOfferPrefix(req.Buyer), req.Pagination, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryOffersMadeResponse{Offers: offers, Pagination: pageRes}, nil
+}
+
+// OffersReceived returns the active offers an owner can accept: offers on
+// the NFTs they hold and collection bids matching any of them
+func (k queryServer) OffersReceived(c context.Context, req *types.QueryOffersReceivedRequest) (*types.QueryOffersReceivedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Owner); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	owned := k.nftKeeper.GetNFTsByOwner(ctx, req.Owner)
+	offers, pageRes, err := k.paginateOffers(ctx, types.OfferQueueKey, req.Pagination, func(offer types.Offer) bool {
+		if offer.Buyer == req.Owner {
+			return false
+		}
+		for _, nft := range owned {
+			if offer.Matches(nft) {
+				return true
+			}
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryOffersReceivedResponse{Offers: offers, Pagination: pageRes}, nil
+}
+
+// CollectionBids returns the active collection bids in order of expiry
+func (k queryServer) CollectionBids(c context.Context, req *types.QueryCollectionBidsRequest) (*types.QueryCollectionBidsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	offers, pageRes, err := k.paginateOffers(ctx, types.OfferQueueKey, req.Pagination, types.Offer.IsCollectionBid)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCollectionBidsResponse{Offers: offers, Pagination: pageRes}, nil
+}
+
+// SaleHistory returns the sales of an NFT, oldest first
+func (k queryServer) SaleHistory(c context.Context, req *types.QuerySaleHistoryRequest) (*types.QuerySaleHistoryResponse, error) {
+	if req == nil || req.NftId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetNFTSalePrefix(req.NftId))
+
+	sales := []types.Sale{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		sale, found := k.GetSale(ctx, sdk.BigEndianToUint64(value))
+		if !found {
+			return sdkerrors.Wrapf(types.ErrSaleNotFound, "sale %d", sdk.BigEndianToUint64(value))
+		}
+		sales = append(sales, sale)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySaleHistoryResponse{Sales: sales, Pagination: pageRes}, nil
+}
+
+// LastSale returns the most recent sale of an NFT
+func (k queryServer) LastSale(c context.Context, req *types.QueryLastSaleRequest) (*types.QueryLastSaleResponse, error) {
+	if req == nil || req.NftId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sale, found := k.GetLastSale(ctx, req.NftId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "%s has never been sold", req.NftId)
+	}
+
+	return &types.QueryLastSaleResponse{Sale: sale}, nil
+}
+
+// FloorPrice returns the cheapest single NFT listing of an NFT type in the
+// requested denom, or in each accepted payment denom when none is requested
+func (k queryServer) FloorPrice(c context.Context, req *types.QueryFloorPriceRequest) (*types.QueryFloorPriceResponse, error) {
+	if req == nil || req.Type == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	denoms := []string{req.Denom}
+	if req.Denom == "" {
+		denoms = k.GetParams(ctx).AllowedDenoms
+	}
+
+	res := &types.QueryFloorPriceResponse{Floor: sdk.NewCoins(), Listings: []types.Listing{}}
+	for _, denom := range denoms {
+		listing, found := k.GetFloorListing(ctx, req.Type, denom)
+		if !found {
+			continue
+		}
+		res.Floor = res.Floor.Add(listing.Price)
+		res.Listings = append(res.Listings, listing)
+	}
+
+	return res, nil
+}
+
+// Volume returns the trading volume of the last 24 hours and 7 days, of
+// every NFT or only of one type
+func (k queryServer) Volume(c context.Context, req *types.QueryVolumeRequest) (*types.QueryVolumeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.QueryVolumeResponse{}
+	res.DayVolume, res.DaySales = k.GetVolume(ctx, types.VolumeWindowDay, req.Type)
+	res.WeekVolume, res.WeekSales = k.GetVolume(ctx, types.VolumeWindowWeek, req.Type)
+
+	return res, nil
+}
+
+// paginateIndex pages through an index whose values are listing IDs,
+// keeping only the listings accepted by keep, given the index key without
+// its prefix, when it is set
+func (k queryServer) paginateIndex(ctx sdk.Context, indexPrefix []byte, pageReq *query.PageRequest, keep func(key []byte, listing types.Listing) bool) ([]types.Listing, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), indexPrefix)
+
+	listings := []types.Listing{}
+	pageRes, err := query.FilteredPaginate(store, pageReq, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		listing, found := k.GetListing(ctx, sdk.BigEndianToUint64(value))
+		if !found || (keep != nil && !keep(key, listing)) {
+			return false, nil
+		}
+		if accumulate {
+			listings = append(listings, listing)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return listings, pageRes, nil
+}
+
+// paginateNFTListings pages through the active listings by way of the index
+// of listed NFTs. A bundle is indexed under each of its NFTs but only its
+// first NFT's entry is counted, so every listing appears once.
+func (k queryServer) paginateNFTListings(ctx sdk.Context, pageReq *query.PageRequest, keep func(types.Listing) bool) ([]types.Listing, *query.PageResponse, error) {
+	return k.paginateIndex(ctx, types.NFTListingKey, pageReq, func(key []byte, listing types.Listing) bool {
+		return string(key) == listing.NFTID && keep(listing)
+	})
+}
+
+// paginateOffers pages through an index whose values are offer IDs,
+// keeping only the offers accepted by keep when it is set
+func (k queryServer) paginateOffers(ctx sdk.Context, indexPrefix []byte, pageReq *query.PageRequest, keep func(types.Offer) bool) ([]types.Offer, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), indexPrefix)
+
+	offers := []types.Offer{}
+	pageRes, err := query.FilteredPaginate(store, pageReq, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		offer, found := k.GetOffer(ctx, sdk.BigEndianToUint64(value))
+		if !found || (keep != nil && !keep(offer)) {
+			return false, nil
+		}
+		if accumulate {
+			offers = append(offers, offer)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return offers, pageRes, nil
 }
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"skaffacity/x/marketplace/types"
+)
+
+// RegisterInvariants registers the marketplace module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "escrowed-nfts", EscrowedNFTsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "escrowed-coins", EscrowedCoinsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the marketplace module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := EscrowedNFTsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return EscrowedCoinsInvariant(k)(ctx)
+	}
+}
+
+// EscrowedNFTsInvariant checks that every NFT of an active listing or
+// auction is held by the escrow account
+func EscrowedNFTsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		check := func(nftID, by string) {
+			if err := k.checkEscrowed(ctx, nftID); err != nil {
+				broken++
+				msg += fmt.Sprintf("\t%s: %s\n", by, err)
+			}
+		}
+
+		for _, listing := range k.GetAllListings(ctx) {
+			if !listing.Active {
+				continue
+			}
+			for _, nftID := range listing.NFTIDs() {
+				check(nftID, fmt.Sprintf("listing %d", listing.ID))
+			}
+		}
+		for _, auction := range k.GetAllAuctions(ctx) {
+			if auction.Active {
+				check(auction.NFTID, fmt.Sprintf("auction %d", auction.ID))
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "escrowed-nfts", fmt.Sprintf(
+			"%d active listed or auctioned NFTs are not held in escrow\n%s", broken, msg,
+		)), broken != 0
+	}
+}
+
+// EscrowedCoinsInvariant checks that the escrow account holds at least the
+// coins of the open bids: the price of every active offer and the highest
+// bid of every active English auction. Anyone can send coins to the escrow
+// address, so a surplus does not break the invariant.
+func EscrowedCoinsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := sdk.NewCoins()
+		for _, offer := range k.GetAllOffers(ctx) {
+			if offer.Active {
+				expected = expected.Add(offer.Price)
+			}
+		}
+		for _, auction := range k.GetAllAuctions(ctx) {
+			if auction.Active && auction.HighestBidder != "" {
+				expected = expected.Add(auction.HighestBid)
+			}
+		}
+
+		balance := k.bankKeeper.GetAllBalances(ctx, types.EscrowAddress)
+		broken := !balance.IsAllGTE(expected)
+
+		return sdk.FormatInvariant(types.ModuleName, "escrowed-coins", fmt.Sprintf(
+			"\tescrow balance: %s\n\tsum of open bids: %s\n", balance, expected,
+		)), broken
+	}
+}
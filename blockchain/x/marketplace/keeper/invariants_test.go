@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"skaffacity/x/marketplace/keeper"
+	"skaffacity/x/marketplace/types"
+)
+
+func TestEscrowedCoinsInvariant(t *testing.T) {
+	f := setupKeeper(t, 0)
+	f.trade(t)
+
+	// the bid of 200 and the offer of 50 are held in escrow
+	escrow := types.EscrowAddress.String()
+	require.Equal(t, sdk.NewCoins(skaf(250)), f.bank[escrow])
+	_, broken := keeper.EscrowedCoinsInvariant(*f.keeper)(f.ctx)
+	require.False(t, broken)
+
+	// anyone can send coins to the escrow address
+	f.fund(escrow, skaf(1), sdk.NewInt64Coin("other", 5))
+	_, broken = keeper.EscrowedCoinsInvariant(*f.keeper)(f.ctx)
+	require.False(t, broken)
+
+	f.bank[escrow] = sdk.NewCoins(skaf(249))
+	_, broken = keeper.EscrowedCoinsInvariant(*f.keeper)(f.ctx)
+	require.True(t, broken)
+}
+
+func TestEscrowedNFTsInvariant(t *testing.T) {
+	f := setupKeeper(t, 0)
+	f.trade(t)
+
+	_, broken := keeper.EscrowedNFTsInvariant(*f.keeper)(f.ctx)
+	require.False(t, broken)
+
+	require.NoError(t, f.nft.TransferNFT(f.ctx, sword("4"), types.EscrowAddress.String(), seller))
+	_, broken = keeper.EscrowedNFTsInvariant(*f.keeper)(f.ctx)
+	require.True(t, broken)
+}
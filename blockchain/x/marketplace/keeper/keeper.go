@@ -1,7 +1,6 @@
 package keeper
 
 import (
-	"context"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"skaffacity/x/marketplace/types"
@@ -12,6 +11,10 @@ type Keeper struct {
 	cdc        codec.BinaryCodec
 	bankKeeper types.BankKeeper
 	nftKeeper  types.NFTKeeper
+
+	// authority is the address allowed to update the module params, usually
+	// the governance module account
+	authority string
 }
 
 func NewKeeper(
@@ -19,32 +22,18 @@ func NewKeeper(
 	storeKey storetypes.StoreKey,
 	bankKeeper types.BankKeeper,
 	nftKeeper types.NFTKeeper,
+	authority string,
 ) *Keeper {
 	return &Keeper{
 		storeKey:   storeKey,
 		cdc:        cdc,
 		bankKeeper: bankKeeper,
 		nftKeeper:  nftKeeper,
+		authority:  authority,
 	}
 }
 
-// Query handlers (stubs for now)
-func (k Keeper) GetListing(ctx context.Context, req *types.QueryListingRequest) (*types.QueryListingResponse, error) {
-	return &types.QueryListingResponse{}, nil
-}
-
-func (k Keeper) GetAllListings(ctx context.Context, req *types.QueryListingsRequest) (*types.QueryListingsResponse, error) {
-	return &types.QueryListingsResponse{}, nil
-}
-
-func (k Keeper) GetListingsByType(ctx context.Context, req *types.QueryListingsByTypeRequest) (*types.QueryListingsByTypeResponse, error) {
-	return &types.QueryListingsByTypeResponse{}, nil
-}
-
-func (k Keeper) GetListingsByOwner(ctx context.Context, req *types.QueryListingsByOwnerRequest) (*types.QueryListingsByOwnerResponse, error) {
-	return &types.QueryListingsByOwnerResponse{}, nil
-}
-
-func (k Keeper) GetMarketStats(ctx context.Context, req *types.QueryMarketStatsRequest) (*types.QueryMarketStatsResponse, error) {
-	return &types.QueryMarketStatsResponse{}, nil
+// GetAuthority returns the module's authority address
+func (k Keeper) GetAuthority() string {
+	return k.authority
 }
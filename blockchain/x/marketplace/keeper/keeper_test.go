@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"skaffacity/x/marketplace/keeper"
+	"skaffacity/x/marketplace/types"
+	nftkeeper "skaffacity/x/nft/keeper"
+	nfttypes "skaffacity/x/nft/types"
+)
+
+// genesisTime is the block time every test starts at
+var genesisTime = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// testAddr returns a distinct account address for each n
+func testAddr(n byte) string {
+	return sdk.AccAddress(append(make([]byte, 19), n)).String()
+}
+
+// skaf returns amount of the default payment denom
+func skaf(amount int64) sdk.Coin {
+	return sdk.NewInt64Coin(types.DefaultPaymentDenom, amount)
+}
+
+// sword returns the full ID of the NFT minted as id in the class "swords"
+func sword(id string) string {
+	return nfttypes.NFTID("swords", id)
+}
+
+// fakeBank is an in-memory BankKeeper
+type fakeBank map[string]sdk.Coins
+
+func (b fakeBank) SendCoins(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	balance := b[fromAddr.String()]
+	if !balance.IsAllGTE(amt) {
+		return fmt.Errorf("%s has %s, cannot send %s", fromAddr, balance, amt)
+	}
+	b[fromAddr.String()] = balance.Sub(amt...)
+	b[toAddr.String()] = b[toAddr.String()].Add(amt...)
+	return nil
+}
+
+func (b fakeBank) GetBalance(_ sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, b[addr.String()].AmountOf(denom))
+}
+
+func (b fakeBank) GetAllBalances(_ sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	return b[addr.String()]
+}
+
+var (
+	creator         = testAddr(1)
+	seller          = testAddr(2)
+	buyer           = testAddr(3)
+	royaltyReceiver = testAddr(4)
+)
+
+type fixture struct {
+	ctx      sdk.Context
+	keeper   *keeper.Keeper
+	nft      *nftkeeper.Keeper
+	bank     fakeBank
+	storeKey storetypes.StoreKey
+}
+
+// setupKeeper returns a marketplace keeper backed by the real nft keeper and
+// a fake bank, at genesisTime. The class "swords", with the given royalty
+// paid to royaltyReceiver, holds the swords "1" to "4", all owned by seller.
+func setupKeeper(t *testing.T, royaltyBasisPoints uint32) fixture {
+	t.Helper()
+
+	marketKey := storetypes.NewKVStoreKey(types.StoreKey)
+	nftKey := storetypes.NewKVStoreKey(nfttypes.StoreKey)
+
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(marketKey, storetypes.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(nftKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(cms, tmproto.Header{Time: genesisTime}, false, log.NewNopLogger())
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	bank := fakeBank{}
+	nft := nftkeeper.NewKeeper(cdc, nftKey, nil, testAddr(100))
+	f := fixture{
+		ctx:      ctx,
+		keeper:   keeper.NewKeeper(cdc, marketKey, bank, nft, testAddr(100)),
+		nft:      nft,
+		bank:     bank,
+		storeKey: marketKey,
+	}
+	f.keeper.SetParams(ctx, types.DefaultParams())
+
+	royalty := nfttypes.RoyaltyInfo{Recipient: royaltyReceiver, BasisPoints: royaltyBasisPoints}
+	nftServer := nftkeeper.NewMsgServerImpl(*nft)
+	goCtx := sdk.WrapSDKContext(ctx)
+	_, err := nftServer.CreateClass(goCtx, nfttypes.NewMsgCreateClass(creator, "swords", "Swords", "", creator, 0, true, royalty, nfttypes.MetadataMutability{}))
+	require.NoError(t, err)
+	for _, id := range []string{"1", "2", "3", "4"} {
+		_, err := nftServer.MintNFT(goCtx, nfttypes.NewMsgMintNFT(creator, "swords", id, nfttypes.TypeItem, seller, nfttypes.Metadata{Name: "Sword " + id}))
+		require.NoError(t, err)
+	}
+
+	return f
+}
+
+// fund credits coins to addr
+func (f fixture) fund(addr string, coins ...sdk.Coin) {
+	f.bank[addr] = f.bank[addr].Add(sdk.NewCoins(coins...)...)
+}
+
+// owner returns the current owner of an NFT
+func (f fixture) owner(t *testing.T, nftID string) string {
+	t.Helper()
+
+	nft, err := f.nft.GetNFT(f.ctx, nftID)
+	require.NoError(t, err)
+	return nft.Owner
+}
@@ -0,0 +1,397 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"skaffacity/x/marketplace/types"
+)
+
+// CreateListing puts an NFT up for sale at a fixed price. The NFT moves into
+// the marketplace escrow account, so the seller cannot transfer it away while
+// the listing is active. A non-zero duration makes the listing expire.
+func (k Keeper) CreateListing(ctx sdk.Context, seller, nftID string, price sdk.Coin, duration time.Duration) (types.Listing, error) {
+	return k.createListing(ctx, seller, []string{nftID}, price, duration)
+}
+
+// CreateBundleListing puts several NFTs up for sale as one lot at a single
+// price. All of them move into escrow, and the listing fails as a whole if
+// any of them cannot.
+func (k Keeper) CreateBundleListing(ctx sdk.Context, seller string, nftIDs []string, price sdk.Coin, duration time.Duration) (types.Listing, error) {
+	if err := types.ValidatePrice(price); err != nil {
+		return types.Listing{}, err
+	}
+	if err := types.ValidateBundle(nftIDs, price); err != nil {
+		return types.Listing{}, err
+	}
+	return k.createListing(ctx, seller, nftIDs, price, duration)
+}
+
+// createListing escrows nftIDs and lists them as one lot, a bundle when
+// there is more than one
+func (k Keeper) createListing(ctx sdk.Context, seller string, nftIDs []string, price sdk.Coin, duration time.Duration) (types.Listing, error) {
+	if err := types.ValidatePrice(price); err != nil {
+		return types.Listing{}, err
+	}
+
+	if err := types.ValidateListingDuration(duration); err != nil {
+		return types.Listing{}, err
+	}
+
+	if err := k.validatePaymentDenom(ctx, price); err != nil {
+		return types.Listing{}, err
+	}
+
+	// a transaction is rolled back as a whole, so an NFT that fails to
+	// escrow part way through a bundle also undoes the earlier ones
+	for _, nftID := range nftIDs {
+		if k.IsListed(ctx, nftID) {
+			return types.Listing{}, sdkerrors.Wrap(types.ErrNFTAlreadyListed, nftID)
+		}
+
+		if err := k.escrowNFT(ctx, seller, nftID); err != nil {
+			return types.Listing{}, sdkerrors.Wrap(err, nftID)
+		}
+	}
+
+	listing := types.Listing{
+		ID:            k.nextID(ctx, types.NextListingIDKey),
+		Creator:       seller,
+		NFTID:         nftIDs[0],
+		Price:         price,
+		Active:        true,
+		CreatedAt:     ctx.BlockTime(),
+		BundledNFTIDs: nftIDs[1:],
+	}
+	if !listing.IsBundle() {
+		listing.BundledNFTIDs = nil
+	}
+	if duration > 0 {
+		listing.ExpiresAt = ctx.BlockTime().Add(duration)
+	}
+	k.setListing(ctx, listing)
+	k.recordOpened(ctx)
+
+	return listing, nil
+}
+
+// ImportListing stores a listing from genesis without escrowing its NFTs.
+// The NFTs of an active listing must already be held by the escrow account.
+func (k Keeper) ImportListing(ctx sdk.Context, listing types.Listing) error {
+	if listing.Active {
+		for _, nftID := range listing.NFTIDs() {
+			if err := k.checkEscrowed(ctx, nftID); err != nil {
+				return err
+			}
+		}
+	}
+	k.setListing(ctx, listing)
+	return nil
+}
+
+// BuyItem pays the listing price, split between the seller, the creator
+// royalty and the marketplace fee, and releases the NFT from escrow to the
+// buyer. A bundle is bought as a whole: if any of its NFTs cannot be
+// transferred the purchase fails.
+func (k Keeper) BuyItem(ctx sdk.Context, buyer string, listingID uint64) (types.Listing, error) {
+	listing, err := k.activeListing(ctx, listingID)
+	if err != nil {
+		return types.Listing{}, err
+	}
+
+	if listing.IsExpired(ctx.BlockTime()) {
+		return types.Listing{}, sdkerrors.Wrapf(types.ErrListingNotActive, "listing %d has expired", listingID)
+	}
+
+	if listing.Creator == buyer {
+		return types.Listing{}, types.ErrSelfPurchase
+	}
+
+	buyerAddr, err := sdk.AccAddressFromBech32(buyer)
+	if err != nil {
+		return types.Listing{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid buyer address (%s)", err)
+	}
+
+	if err := k.payListing(ctx, buyerAddr, listing); err != nil {
+		return types.Listing{}, err
+	}
+
+	if err := k.releaseListing(ctx, listing, buyer); err != nil {
+		return types.Listing{}, err
+	}
+
+	listing.SoldTo = buyer
+	listing.SoldAt = ctx.BlockTime()
+	k.closeListing(ctx, listing)
+	k.recordSale(ctx, listing.Price)
+
+	shares := listing.PriceShares()
+	for i, nftID := range listing.NFTIDs() {
+		k.appendSale(ctx, types.SaleSourceListing, listing.ID, nftID, listing.Creator, buyer, shares[i])
+	}
+
+	return listing, nil
+}
+
+// CancelListing withdraws an active listing and returns its NFTs to the seller
+func (k Keeper) CancelListing(ctx sdk.Context, sender string, listingID uint64) (types.Listing, error) {
+	listing, err := k.activeListing(ctx, listingID)
+	if err != nil {
+		return types.Listing{}, err
+	}
+
+	if listing.Creator != sender {
+		return types.Listing{}, sdkerrors.Wrap(types.ErrUnauthorized, "only the seller can cancel a listing")
+	}
+
+	if err := k.releaseListing(ctx, listing, listing.Creator); err != nil {
+		return types.Listing{}, err
+	}
+
+	k.closeListing(ctx, listing)
+	k.recordClosed(ctx)
+
+	return listing, nil
+}
+
+// ExpireListings withdraws the active listings whose expiry has passed and
+// returns their NFTs to the sellers, at most MaxListingExpiriesPerBlock per
+// call so the work per block stays bounded. A listing that fails to expire
+// leaves the queue, so it cannot hold a slot every block, and stays active
+// until it is bought or cancelled. The expired listings are returned.
+func (k Keeper) ExpireListings(ctx sdk.Context) []types.Listing {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.ListingQueueKey, sdk.PrefixEndBytes(types.GetListingQueuePrefix(ctx.BlockTime())))
+
+	var ids []uint64
+	for ; iterator.Valid() && len(ids) < types.MaxListingExpiriesPerBlock; iterator.Next() {
+		ids = append(ids, sdk.BigEndianToUint64(iterator.Value()))
+	}
+	// expiring a listing deletes its queue entry, so the iterator must be
+	// closed first
+	iterator.Close()
+
+	var expired []types.Listing
+	for _, id := range ids {
+		listing, found := k.GetListing(ctx, id)
+		if !found || !listing.Active {
+			continue
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.releaseListing(cacheCtx, listing, listing.Creator); err != nil {
+			ctx.Logger().Error("failed to expire listing", "listing_id", id, "err", err)
+			store.Delete(types.GetListingQueueKey(listing.ExpiresAt, listing.ID))
+			continue
+		}
+		k.closeListing(cacheCtx, listing)
+		k.recordClosed(cacheCtx)
+		write()
+		expired = append(expired, listing)
+	}
+	return expired
+}
+
+// GetListing returns a listing by ID, whether or not it is still active
+func (k Keeper) GetListing(ctx sdk.Context, id uint64) (types.Listing, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetListingKey(id))
+	if bz == nil {
+		return types.Listing{}, false
+	}
+
+	var listing types.Listing
+	k.cdc.MustUnmarshal(bz, &listing)
+	return listing, true
+}
+
+// SetListing stores a listing
+func (k Keeper) SetListing(ctx sdk.Context, listing types.Listing) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetListingKey(listing.ID), k.cdc.MustMarshal(&listing))
+}
+
+// IsListed reports whether an NFT is in an active listing
+func (k Keeper) IsListed(ctx sdk.Context, nftID string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetNFTListingKey(nftID))
+}
+
+// GetAllListings returns every listing, active or not
+func (k Keeper) GetAllListings(ctx sdk.Context) []types.Listing {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ListingKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var listings []types.Listing
+	for ; iterator.Valid(); iterator.Next() {
+		var listing types.Listing
+		k.cdc.MustUnmarshal(iterator.Value(), &listing)
+		listings = append(listings, listing)
+	}
+	return listings
+}
+
+// GetMarketStats returns the marketplace statistics
+func (k Keeper) GetMarketStats(ctx sdk.Context) types.MarketStats {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.MarketStatsKey)
+	if bz == nil {
+		return types.NewMarketStats()
+	}
+
+	var stats types.MarketStats
+	k.cdc.MustUnmarshal(bz, &stats)
+	return stats
+}
+
+// SetMarketStats stores the marketplace statistics
+func (k Keeper) SetMarketStats(ctx sdk.Context, stats types.MarketStats) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.MarketStatsKey, k.cdc.MustMarshal(&stats))
+}
+
+// setListing stores a listing with its seller index entry and, while it is
+// active, its NFT, floor and expiry queue entries
+func (k Keeper) setListing(ctx sdk.Context, listing types.Listing) {
+	k.SetListing(ctx, listing)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetSellerListingKey(listing.Creator, listing.ID), sdk.Uint64ToBigEndian(listing.ID))
+	if !listing.Active {
+		return
+	}
+
+	k.setFloor(ctx, listing)
+	for _, nftID := range listing.NFTIDs() {
+		store.Set(types.GetNFTListingKey(nftID), sdk.Uint64ToBigEndian(listing.ID))
+	}
+	if listing.HasExpiry() {
+		store.Set(types.GetListingQueueKey(listing.ExpiresAt, listing.ID), sdk.Uint64ToBigEndian(listing.ID))
+	}
+}
+
+// activeListing returns a listing that can still be bought or cancelled
+func (k Keeper) activeListing(ctx sdk.Context, id uint64) (types.Listing, error) {
+	listing, found := k.GetListing(ctx, id)
+	if !found {
+		return types.Listing{}, sdkerrors.Wrapf(types.ErrListingNotFound, "listing %d", id)
+	}
+	if !listing.Active {
+		return types.Listing{}, sdkerrors.Wrapf(types.ErrListingNotActive, "listing %d", id)
+	}
+	return listing, nil
+}
+
+// closeListing marks a listing inactive and frees its NFTs for a new listing.
+// The listing itself is kept as a record of the sale or cancellation.
+func (k Keeper) closeListing(ctx sdk.Context, listing types.Listing) {
+	listing.Active = false
+	k.SetListing(ctx, listing)
+	k.deleteFloor(ctx, listing)
+
+	store := ctx.KVStore(k.storeKey)
+	for _, nftID := range listing.NFTIDs() {
+		store.Delete(types.GetNFTListingKey(nftID))
+	}
+	if listing.HasExpiry() {
+		store.Delete(types.GetListingQueueKey(listing.ExpiresAt, listing.ID))
+	}
+}
+
+// escrowNFT moves an NFT from its owner, the seller, into the marketplace
+// escrow account
+func (k Keeper) escrowNFT(ctx sdk.Context, seller, nftID string) error {
+	nft, err := k.nftKeeper.GetNFT(ctx, nftID)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrNFTNotFound, err.Error())
+	}
+
+	if nft.Owner != seller {
+		return sdkerrors.Wrap(types.ErrUnauthorized, "only the owner can sell an NFT")
+	}
+
+	if err := k.nftKeeper.TransferNFT(ctx, nftID, seller, types.EscrowAddress.String()); err != nil {
+		return sdkerrors.Wrap(types.ErrNonTransferable, err.Error())
+	}
+	return nil
+}
+
+// checkEscrowed returns an error unless an NFT is held by the escrow account
+func (k Keeper) checkEscrowed(ctx sdk.Context, nftID string) error {
+	nft, err := k.nftKeeper.GetNFT(ctx, nftID)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrNFTNotFound, err.Error())
+	}
+	if nft.Owner != types.EscrowAddress.String() {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s is owned by %s, not the marketplace escrow", nftID, nft.Owner)
+	}
+	return nil
+}
+
+// releaseListing transfers every NFT of a listing out of escrow to recipient
+func (k Keeper) releaseListing(ctx sdk.Context, listing types.Listing, recipient string) error {
+	for _, nftID := range listing.NFTIDs() {
+		if err := k.nftKeeper.TransferNFT(ctx, nftID, types.EscrowAddress.String(), recipient); err != nil {
+			return sdkerrors.Wrap(err, nftID)
+		}
+	}
+	return nil
+}
+
+// recordOpened counts a new listing or auction in the market statistics
+func (k Keeper) recordOpened(ctx sdk.Context) {
+	stats := k.GetMarketStats(ctx)
+	stats.TotalListings++
+	stats.ActiveListings++
+	k.SetMarketStats(ctx, stats)
+}
+
+// recordClosed counts a listing or auction that closed without a sale
+func (k Keeper) recordClosed(ctx sdk.Context) {
+	stats := k.GetMarketStats(ctx)
+	stats.ActiveListings--
+	k.SetMarketStats(ctx, stats)
+}
+
+// recordSale counts a listing or auction that sold for price
+func (k Keeper) recordSale(ctx sdk.Context, price sdk.Coin) {
+	stats := k.GetMarketStats(ctx)
+	stats.ActiveListings--
+	stats.SoldItems++
+	stats.TotalVolume = stats.TotalVolume.Add(price)
+	k.SetMarketStats(ctx, stats)
+}
+
+// recordOfferSale counts an NFT sold to an offer, which unlike a listing
+// was never counted as open
+func (k Keeper) recordOfferSale(ctx sdk.Context, price sdk.Coin) {
+	stats := k.GetMarketStats(ctx)
+	stats.SoldItems++
+	stats.TotalVolume = stats.TotalVolume.Add(price)
+	k.SetMarketStats(ctx, stats)
+}
+
+// GetNextID returns the next ID the counter stored under key hands out
+func (k Keeper) GetNextID(ctx sdk.Context, key []byte) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextID sets the next ID the counter stored under key hands out
+func (k Keeper) SetNextID(ctx sdk.Context, key []byte, id uint64) {
+	ctx.KVStore(k.storeKey).Set(key, sdk.Uint64ToBigEndian(id))
+}
+
+// nextID returns the next free ID of the counter stored under key and
+// advances it. IDs start at 1.
+func (k Keeper) nextID(ctx sdk.Context, key []byte) uint64 {
+	id := k.GetNextID(ctx, key)
+	k.SetNextID(ctx, key, id+1)
+	return id
+}
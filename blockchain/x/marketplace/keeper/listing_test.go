@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"skaffacity/x/marketplace/types"
+)
+
+func TestExpiredListingReturnsTheNFT(t *testing.T) {
+	f := setupKeeper(t, 0)
+	expiring, err := f.keeper.CreateListing(f.ctx, seller, sword("1"), skaf(100), time.Hour)
+	require.NoError(t, err)
+	open, err := f.keeper.CreateListing(f.ctx, seller, sword("2"), skaf(100), 0)
+	require.NoError(t, err)
+
+	_, err = f.keeper.CreateListing(f.ctx, seller, sword("3"), skaf(100), types.MinListingDuration-time.Second)
+	require.Error(t, err)
+
+	require.Empty(t, f.keeper.ExpireListings(f.ctx.WithBlockTime(expiring.ExpiresAt.Add(-time.Nanosecond))))
+
+	// the listing can no longer be bought from its expiry on
+	f.ctx = f.ctx.WithBlockTime(expiring.ExpiresAt)
+	f.fund(buyer, skaf(100))
+	_, err = f.keeper.BuyItem(f.ctx, buyer, expiring.ID)
+	require.ErrorIs(t, err, types.ErrListingNotActive)
+
+	expired := f.keeper.ExpireListings(f.ctx)
+	require.Len(t, expired, 1)
+	require.Equal(t, expiring.ID, expired[0].ID)
+	require.Equal(t, seller, f.owner(t, sword("1")))
+	require.False(t, f.keeper.IsListed(f.ctx, sword("1")))
+	require.Equal(t, uint64(1), f.keeper.GetMarketStats(f.ctx).ActiveListings)
+
+	// a listing without expiry stays up
+	require.Empty(t, f.keeper.ExpireListings(f.ctx.WithBlockTime(genesisTime.Add(types.MaxListingDuration))))
+	_, err = f.keeper.BuyItem(f.ctx, buyer, open.ID)
+	require.NoError(t, err)
+}
+
+func TestListingExpiriesPerBlockAreCapped(t *testing.T) {
+	f := setupKeeper(t, 0)
+	for _, nftID := range f.mintSwords(t, types.MaxListingExpiriesPerBlock+1) {
+		_, err := f.keeper.CreateListing(f.ctx, seller, nftID, skaf(100), time.Hour)
+		require.NoError(t, err)
+	}
+
+	ctx := f.ctx.WithBlockTime(genesisTime.Add(time.Hour))
+	require.Len(t, f.keeper.ExpireListings(ctx), types.MaxListingExpiriesPerBlock)
+	require.Len(t, f.keeper.ExpireListings(ctx.WithBlockTime(genesisTime.Add(time.Hour+5*time.Second))), 1)
+	require.Empty(t, f.nft.GetNFTsByOwner(ctx, types.EscrowAddress.String()))
+}
+
+func TestFailedListingExpiryLeavesTheQueue(t *testing.T) {
+	f := setupKeeper(t, 0)
+	failing, err := f.keeper.CreateListing(f.ctx, seller, sword("1"), skaf(100), time.Hour)
+	require.NoError(t, err)
+	other, err := f.keeper.CreateListing(f.ctx, seller, sword("2"), skaf(100), time.Hour)
+	require.NoError(t, err)
+
+	// the escrowed NFT has gone missing, so it cannot be returned
+	require.NoError(t, f.nft.TransferNFT(f.ctx, sword("1"), types.EscrowAddress.String(), buyer))
+
+	expired := f.keeper.ExpireListings(f.ctx.WithBlockTime(failing.ExpiresAt))
+	require.Len(t, expired, 1)
+	require.Equal(t, other.ID, expired[0].ID)
+
+	stored, found := f.keeper.GetListing(f.ctx, failing.ID)
+	require.True(t, found)
+	require.True(t, stored.Active)
+	require.False(t, f.ctx.KVStore(f.storeKey).Has(types.GetListingQueueKey(failing.ExpiresAt, failing.ID)))
+}
@@ -1,30 +1,267 @@
 package keeper
 
 import (
-    "context"
-    "skaffacity/x/marketplace/types"
+	"context"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"skaffacity/x/marketplace/types"
 )
 
 type msgServer struct {
-    Keeper
-    // nftKeeper and other dependencies are stubbed for build
+	Keeper
 }
 
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
 func NewMsgServerImpl(keeper Keeper) types.MsgServer {
-    return &msgServer{Keeper: keeper}
+	return &msgServer{Keeper: keeper}
 }
 
+var _ types.MsgServer = msgServer{}
+
 func (k msgServer) CreateListing(goCtx context.Context, msg *types.MsgCreateListing) (*types.MsgCreateListingResponse, error) {
-    // Stubbed implementation for build
-    return &types.MsgCreateListingResponse{}, nil
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	listing, err := k.Keeper.CreateListing(ctx, msg.Creator, msg.NFTID, msg.Price, msg.Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(newCreateListingEvent(types.EventTypeCreateListing, listing))
+
+	return &types.MsgCreateListingResponse{ListingID: listing.ID}, nil
+}
+
+func (k msgServer) CreateBundleListing(goCtx context.Context, msg *types.MsgCreateBundleListing) (*types.MsgCreateBundleListingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	listing, err := k.Keeper.CreateBundleListing(ctx, msg.Creator, msg.NFTIDs, msg.Price, msg.Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(newCreateListingEvent(types.EventTypeCreateBundleListing, listing))
+
+	return &types.MsgCreateBundleListingResponse{ListingID: listing.ID}, nil
+}
+
+// newCreateListingEvent returns the event of a new listing, with its expiry
+// when it has one
+func newCreateListingEvent(eventType string, listing types.Listing) sdk.Event {
+	attrs := []sdk.Attribute{sdk.NewAttribute(types.AttributeKeyPrice, listing.Price.String())}
+	if listing.HasExpiry() {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyExpiresAt, listing.ExpiresAt.Format(time.RFC3339)))
+	}
+	return types.NewListingEvent(eventType, listing, attrs...)
 }
 
 func (k msgServer) BuyItem(goCtx context.Context, msg *types.MsgBuyItem) (*types.MsgBuyItemResponse, error) {
-    // Stubbed implementation for build
-    return &types.MsgBuyItemResponse{}, nil
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	listing, err := k.Keeper.BuyItem(ctx, msg.Buyer, msg.ListingID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		types.NewListingEvent(
+			types.EventTypeBuyItem,
+			listing,
+			sdk.NewAttribute(types.AttributeKeyBuyer, msg.Buyer),
+			sdk.NewAttribute(types.AttributeKeyPrice, listing.Price.String()),
+		),
+	)
+
+	return &types.MsgBuyItemResponse{}, nil
 }
 
 func (k msgServer) CancelListing(goCtx context.Context, msg *types.MsgCancelListing) (*types.MsgCancelListingResponse, error) {
-    // Stubbed implementation for build
-    return &types.MsgCancelListingResponse{}, nil
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	listing, err := k.Keeper.CancelListing(ctx, msg.Creator, msg.ListingID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(types.NewListingEvent(types.EventTypeCancelListing, listing))
+
+	return &types.MsgCancelListingResponse{}, nil
+}
+
+func (k msgServer) CreateAuction(goCtx context.Context, msg *types.MsgCreateAuction) (*types.MsgCreateAuctionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	auction, err := k.Keeper.CreateAuction(ctx, msg.Auction(ctx.BlockTime()))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateAuction,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, strconv.FormatUint(auction.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyAuctionType, auction.AuctionType),
+			sdk.NewAttribute(types.AttributeKeyNFTID, auction.NFTID),
+			sdk.NewAttribute(types.AttributeKeySeller, auction.Creator),
+			sdk.NewAttribute(types.AttributeKeyPrice, auction.StartPrice.String()),
+		),
+	)
+
+	return &types.MsgCreateAuctionResponse{AuctionID: auction.ID}, nil
+}
+
+func (k msgServer) PlaceBid(goCtx context.Context, msg *types.MsgPlaceBid) (*types.MsgPlaceBidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	auction, sold, err := k.Keeper.PlaceBid(ctx, msg.Bidder, msg.AuctionID, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePlaceBid,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, strconv.FormatUint(auction.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyBidder, msg.Bidder),
+			sdk.NewAttribute(types.AttributeKeyAmount, auction.HighestBid.String()),
+		),
+	)
+	if sold {
+		ctx.EventManager().EmitEvent(types.NewSettleAuctionEvent(auction))
+	}
+
+	return &types.MsgPlaceBidResponse{Sold: sold}, nil
+}
+
+func (k msgServer) CancelAuction(goCtx context.Context, msg *types.MsgCancelAuction) (*types.MsgCancelAuctionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	auction, err := k.Keeper.CancelAuction(ctx, msg.Creator, msg.AuctionID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelAuction,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, strconv.FormatUint(auction.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyNFTID, auction.NFTID),
+			sdk.NewAttribute(types.AttributeKeySeller, auction.Creator),
+		),
+	)
+
+	return &types.MsgCancelAuctionResponse{}, nil
+}
+
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != k.authority {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "expected %s as authority, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	k.SetParams(ctx, msg.Params)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateParams,
+			sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+		),
+	)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+func (k msgServer) MakeOffer(goCtx context.Context, msg *types.MsgMakeOffer) (*types.MsgMakeOfferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	offer, err := k.Keeper.MakeOffer(ctx, msg.Offer(ctx.BlockTime()))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMakeOffer,
+			sdk.NewAttribute(types.AttributeKeyOfferID, strconv.FormatUint(offer.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyNFTID, offer.NFTID),
+			sdk.NewAttribute(types.AttributeKeyBuyer, offer.Buyer),
+			sdk.NewAttribute(types.AttributeKeyPrice, offer.Price.String()),
+		),
+	)
+
+	return &types.MsgMakeOfferResponse{OfferID: offer.ID}, nil
+}
+
+func (k msgServer) PlaceCollectionBid(goCtx context.Context, msg *types.MsgPlaceCollectionBid) (*types.MsgPlaceCollectionBidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	offer, err := k.Keeper.MakeOffer(ctx, msg.Offer(ctx.BlockTime()))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePlaceCollectionBid,
+			sdk.NewAttribute(types.AttributeKeyOfferID, strconv.FormatUint(offer.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyBuyer, offer.Buyer),
+			sdk.NewAttribute(types.AttributeKeyClassID, offer.Criteria.ClassID),
+			sdk.NewAttribute(types.AttributeKeyNFTType, offer.Criteria.NFTType),
+			sdk.NewAttribute(types.AttributeKeyMinLandSize, strconv.FormatUint(uint64(offer.Criteria.MinLandSize), 10)),
+			sdk.NewAttribute(types.AttributeKeyPrice, offer.Price.String()),
+		),
+	)
+
+	return &types.MsgPlaceCollectionBidResponse{OfferID: offer.ID}, nil
+}
+
+func (k msgServer) AcceptOffer(goCtx context.Context, msg *types.MsgAcceptOffer) (*types.MsgAcceptOfferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	offer, err := k.Keeper.AcceptOffer(ctx, msg.Owner, msg.OfferID, msg.NFTID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAcceptOffer,
+			sdk.NewAttribute(types.AttributeKeyOfferID, strconv.FormatUint(offer.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyNFTID, offer.SoldNFTID),
+			sdk.NewAttribute(types.AttributeKeySeller, offer.Seller),
+			sdk.NewAttribute(types.AttributeKeyBuyer, offer.Buyer),
+			sdk.NewAttribute(types.AttributeKeyPrice, offer.Price.String()),
+		),
+	)
+
+	return &types.MsgAcceptOfferResponse{}, nil
+}
+
+func (k msgServer) CancelOffer(goCtx context.Context, msg *types.MsgCancelOffer) (*types.MsgCancelOfferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	offer, err := k.Keeper.CancelOffer(ctx, msg.Buyer, msg.OfferID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelOffer,
+			sdk.NewAttribute(types.AttributeKeyOfferID, strconv.FormatUint(offer.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyBuyer, offer.Buyer),
+			sdk.NewAttribute(types.AttributeKeyPrice, offer.Price.String()),
+		),
+	)
+
+	return &types.MsgCancelOfferResponse{}, nil
 }
@@ -0,0 +1,258 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"skaffacity/x/marketplace/types"
+)
+
+// MakeOffer opens an offer on a single NFT or a collection bid and escrows
+// its price from the buyer until it is accepted, cancelled or expires
+func (k Keeper) MakeOffer(ctx sdk.Context, offer types.Offer) (types.Offer, error) {
+	if err := offer.Validate(); err != nil {
+		return types.Offer{}, err
+	}
+
+	if err := k.validatePaymentDenom(ctx, offer.Price); err != nil {
+		return types.Offer{}, err
+	}
+
+	if !offer.IsCollectionBid() {
+		nft, err := k.nftKeeper.GetNFT(ctx, offer.NFTID)
+		if err != nil {
+			return types.Offer{}, sdkerrors.Wrap(types.ErrNFTNotFound, err.Error())
+		}
+		if nft.Owner == offer.Buyer {
+			return types.Offer{}, types.ErrSelfPurchase
+		}
+	}
+
+	buyer, err := sdk.AccAddressFromBech32(offer.Buyer)
+	if err != nil {
+		return types.Offer{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid buyer address (%s)", err)
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, buyer, types.EscrowAddress, sdk.NewCoins(offer.Price)); err != nil {
+		return types.Offer{}, err
+	}
+
+	offer.ID = k.nextID(ctx, types.NextOfferIDKey)
+	offer.Active = true
+	offer.Seller = ""
+	offer.SoldNFTID = ""
+	k.setOffer(ctx, offer)
+
+	return offer, nil
+}
+
+// ImportOffer stores an offer from genesis without escrowing its price,
+// which genesis must already hold in the escrow account
+func (k Keeper) ImportOffer(ctx sdk.Context, offer types.Offer) {
+	k.setOffer(ctx, offer)
+}
+
+// AcceptOffer sells an NFT held by owner to an offer or collection bid. The
+// escrowed price is paid out as for any other sale and the NFT moves to the
+// buyer. nftID may be empty for an offer on a single NFT.
+func (k Keeper) AcceptOffer(ctx sdk.Context, owner string, offerID uint64, nftID string) (types.Offer, error) {
+	offer, err := k.activeOffer(ctx, offerID)
+	if err != nil {
+		return types.Offer{}, err
+	}
+
+	if !offer.IsCollectionBid() {
+		if nftID != "" && nftID != offer.NFTID {
+			return types.Offer{}, sdkerrors.Wrapf(types.ErrInvalidOffer, "offer %d is for %s", offerID, offer.NFTID)
+		}
+		nftID = offer.NFTID
+	} else if nftID == "" {
+		return types.Offer{}, sdkerrors.Wrap(types.ErrNFTNotFound, "NFT id cannot be empty")
+	}
+
+	nft, err := k.nftKeeper.GetNFT(ctx, nftID)
+	if err != nil {
+		return types.Offer{}, sdkerrors.Wrap(types.ErrNFTNotFound, err.Error())
+	}
+
+	if nft.Owner != owner {
+		if nft.Owner == types.EscrowAddress.String() {
+			return types.Offer{}, sdkerrors.Wrapf(types.ErrUnauthorized, "%s is listed or auctioned; withdraw it first", nftID)
+		}
+		return types.Offer{}, sdkerrors.Wrap(types.ErrUnauthorized, "only the owner can accept an offer")
+	}
+
+	if offer.Buyer == owner {
+		return types.Offer{}, types.ErrSelfPurchase
+	}
+
+	if !offer.Matches(nft) {
+		return types.Offer{}, sdkerrors.Wrapf(types.ErrInvalidOffer, "%s does not match the criteria of offer %d", nftID, offerID)
+	}
+
+	if err := k.paySale(ctx, types.EscrowAddress, owner, nftID, offer.Price); err != nil {
+		return types.Offer{}, err
+	}
+
+	if err := k.nftKeeper.TransferNFT(ctx, nftID, owner, offer.Buyer); err != nil {
+		return types.Offer{}, sdkerrors.Wrap(types.ErrNonTransferable, err.Error())
+	}
+
+	offer.Seller = owner
+	offer.SoldNFTID = nftID
+	k.closeOffer(ctx, offer)
+	k.recordOfferSale(ctx, offer.Price)
+	k.appendSale(ctx, types.SaleSourceOffer, offer.ID, nftID, owner, offer.Buyer, offer.Price)
+
+	return offer, nil
+}
+
+// CancelOffer withdraws an active offer and refunds its price to the buyer
+func (k Keeper) CancelOffer(ctx sdk.Context, sender string, offerID uint64) (types.Offer, error) {
+	offer, err := k.activeOffer(ctx, offerID)
+	if err != nil {
+		return types.Offer{}, err
+	}
+
+	if offer.Buyer != sender {
+		return types.Offer{}, sdkerrors.Wrap(types.ErrUnauthorized, "only the buyer can cancel an offer")
+	}
+
+	if err := k.refundOffer(ctx, offer); err != nil {
+		return types.Offer{}, err
+	}
+
+	return offer, nil
+}
+
+// ExpireOffers refunds and closes every active offer whose expiry has
+// passed, at most MaxOfferExpiriesPerBlock per call so the work per block
+// stays bounded. An offer that fails to expire leaves the queue, so it
+// cannot hold a slot every block, and stays active until it is accepted or
+// cancelled. The expired offers are returned.
+func (k Keeper) ExpireOffers(ctx sdk.Context) []types.Offer {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.OfferQueueKey, sdk.PrefixEndBytes(types.GetOfferQueuePrefix(ctx.BlockTime())))
+
+	var ids []uint64
+	for ; iterator.Valid() && len(ids) < types.MaxOfferExpiriesPerBlock; iterator.Next() {
+		ids = append(ids, sdk.BigEndianToUint64(iterator.Value()))
+	}
+	// expiring an offer deletes its queue entry, so the iterator must be
+	// closed first
+	iterator.Close()
+
+	var expired []types.Offer
+	for _, id := range ids {
+		offer, found := k.GetOffer(ctx, id)
+		if !found || !offer.Active {
+			continue
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.refundOffer(cacheCtx, offer); err != nil {
+			ctx.Logger().Error("failed to expire offer", "offer_id", id, "err", err)
+			store.Delete(types.GetOfferQueueKey(offer.ExpiresAt, offer.ID))
+			continue
+		}
+		write()
+		expired = append(expired, offer)
+	}
+	return expired
+}
+
+// GetOffer returns an offer by ID, whether or not it is still active
+func (k Keeper) GetOffer(ctx sdk.Context, id uint64) (types.Offer, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetOfferKey(id))
+	if bz == nil {
+		return types.Offer{}, false
+	}
+
+	var offer types.Offer
+	k.cdc.MustUnmarshal(bz, &offer)
+	return offer, true
+}
+
+// SetOffer stores an offer
+func (k Keeper) SetOffer(ctx sdk.Context, offer types.Offer) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetOfferKey(offer.ID), k.cdc.MustMarshal(&offer))
+}
+
+// setOffer stores an offer with its buyer index entry and, while it is
+// active, its expiry queue and NFT index entries
+func (k Keeper) setOffer(ctx sdk.Context, offer types.Offer) {
+	k.SetOffer(ctx, offer)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetBuyerOfferKey(offer.Buyer, offer.ID), sdk.Uint64ToBigEndian(offer.ID))
+	if !offer.Active {
+		return
+	}
+
+	store.Set(types.GetOfferQueueKey(offer.ExpiresAt, offer.ID), sdk.Uint64ToBigEndian(offer.ID))
+	if !offer.IsCollectionBid() {
+		store.Set(types.GetNFTOfferKey(offer.NFTID, offer.ID), sdk.Uint64ToBigEndian(offer.ID))
+	}
+}
+
+// GetAllOffers returns every offer, active or not
+func (k Keeper) GetAllOffers(ctx sdk.Context) []types.Offer {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OfferKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var offers []types.Offer
+	for ; iterator.Valid(); iterator.Next() {
+		var offer types.Offer
+		k.cdc.MustUnmarshal(iterator.Value(), &offer)
+		offers = append(offers, offer)
+	}
+	return offers
+}
+
+// activeOffer returns an offer that can still be accepted or cancelled
+func (k Keeper) activeOffer(ctx sdk.Context, id uint64) (types.Offer, error) {
+	offer, found := k.GetOffer(ctx, id)
+	if !found {
+		return types.Offer{}, sdkerrors.Wrapf(types.ErrOfferNotFound, "offer %d", id)
+	}
+	if !offer.Active {
+		return types.Offer{}, sdkerrors.Wrapf(types.ErrOfferNotActive, "offer %d", id)
+	}
+	if !ctx.BlockTime().Before(offer.ExpiresAt) {
+		return types.Offer{}, sdkerrors.Wrapf(types.ErrOfferNotActive, "offer %d has expired", id)
+	}
+	return offer, nil
+}
+
+// refundOffer returns the escrowed price of an offer to its buyer and
+// closes the offer
+func (k Keeper) refundOffer(ctx sdk.Context, offer types.Offer) error {
+	buyer, err := sdk.AccAddressFromBech32(offer.Buyer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid buyer address (%s)", err)
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, types.EscrowAddress, buyer, sdk.NewCoins(offer.Price)); err != nil {
+		return err
+	}
+
+	k.closeOffer(ctx, offer)
+	return nil
+}
+
+// closeOffer marks an offer inactive and removes it from the expiry queue
+// and NFT index. The offer itself is kept as a record.
+func (k Keeper) closeOffer(ctx sdk.Context, offer types.Offer) {
+	offer.Active = false
+	k.SetOffer(ctx, offer)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetOfferQueueKey(offer.ExpiresAt, offer.ID))
+	if !offer.IsCollectionBid() {
+		store.Delete(types.GetNFTOfferKey(offer.NFTID, offer.ID))
+	}
+}
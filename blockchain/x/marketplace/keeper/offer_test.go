@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"skaffacity/x/marketplace/types"
+)
+
+// offer funds buyer and makes a day-long offer of price on nftID
+func (f fixture) offer(t *testing.T, nftID string, price int64) types.Offer {
+	t.Helper()
+
+	f.fund(buyer, skaf(price))
+	offer, err := f.keeper.MakeOffer(f.ctx, types.Offer{
+		Buyer:     buyer,
+		NFTID:     nftID,
+		Price:     skaf(price),
+		CreatedAt: f.ctx.BlockTime(),
+		ExpiresAt: f.ctx.BlockTime().Add(24 * time.Hour),
+	})
+	require.NoError(t, err)
+	return offer
+}
+
+func TestCancelledOfferIsRefunded(t *testing.T) {
+	f := setupKeeper(t, 0)
+	offer := f.offer(t, sword("1"), 100)
+	require.Empty(t, f.bank[buyer])
+	require.Equal(t, sdk.NewCoins(skaf(100)), f.escrowed())
+
+	_, err := f.keeper.CancelOffer(f.ctx, seller, offer.ID)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = f.keeper.CancelOffer(f.ctx, buyer, offer.ID)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(skaf(100)), f.bank[buyer])
+	require.True(t, f.escrowed().IsZero())
+
+	_, err = f.keeper.AcceptOffer(f.ctx, seller, offer.ID, "")
+	require.ErrorIs(t, err, types.ErrOfferNotActive)
+	_, err = f.keeper.CancelOffer(f.ctx, buyer, offer.ID)
+	require.ErrorIs(t, err, types.ErrOfferNotActive)
+}
+
+func TestAcceptedOfferPaysTheOwner(t *testing.T) {
+	f := setupKeeper(t, 1000)
+	offer := f.offer(t, sword("1"), 100)
+	other := f.offer(t, sword("1"), 80)
+
+	_, err := f.keeper.AcceptOffer(f.ctx, buyer, offer.ID, "")
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = f.keeper.AcceptOffer(f.ctx, seller, offer.ID, sword("2"))
+	require.ErrorIs(t, err, types.ErrInvalidOffer)
+
+	accepted, err := f.keeper.AcceptOffer(f.ctx, seller, offer.ID, "")
+	require.NoError(t, err)
+	require.Equal(t, seller, accepted.Seller)
+	require.Equal(t, buyer, f.owner(t, sword("1")))
+	require.Equal(t, sdk.NewCoins(skaf(90)), f.bank[seller])
+	require.Equal(t, sdk.NewCoins(skaf(10)), f.bank[royaltyReceiver])
+
+	// the other offer stays escrowed until it is cancelled or expires
+	require.Equal(t, sdk.NewCoins(skaf(80)), f.escrowed())
+	_, err = f.keeper.AcceptOffer(f.ctx, seller, other.ID, "")
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+}
+
+func TestExpiredOfferIsRefunded(t *testing.T) {
+	f := setupKeeper(t, 0)
+	offer := f.offer(t, sword("1"), 100)
+
+	require.Empty(t, f.keeper.ExpireOffers(f.ctx.WithBlockTime(offer.ExpiresAt.Add(-time.Nanosecond))))
+
+	f.ctx = f.ctx.WithBlockTime(offer.ExpiresAt)
+	_, err := f.keeper.AcceptOffer(f.ctx, seller, offer.ID, "")
+	require.ErrorIs(t, err, types.ErrOfferNotActive)
+
+	expired := f.keeper.ExpireOffers(f.ctx)
+	require.Len(t, expired, 1)
+	require.Equal(t, sdk.NewCoins(skaf(100)), f.bank[buyer])
+	require.True(t, f.escrowed().IsZero())
+	stored, found := f.keeper.GetOffer(f.ctx, offer.ID)
+	require.True(t, found)
+	require.False(t, stored.Active)
+}
+
+func TestOfferExpiriesPerBlockAreCapped(t *testing.T) {
+	f := setupKeeper(t, 0)
+	var expiresAt time.Time
+	for i := 0; i < types.MaxOfferExpiriesPerBlock+1; i++ {
+		expiresAt = f.offer(t, sword("1"), 1).ExpiresAt
+	}
+
+	ctx := f.ctx.WithBlockTime(expiresAt)
+	require.Len(t, f.keeper.ExpireOffers(ctx), types.MaxOfferExpiriesPerBlock)
+	require.Len(t, f.keeper.ExpireOffers(ctx.WithBlockTime(expiresAt.Add(5*time.Second))), 1)
+	require.Equal(t, sdk.NewCoins(skaf(types.MaxOfferExpiriesPerBlock+1)), f.bank[buyer])
+}
+
+func TestFailedOfferExpiryLeavesTheQueue(t *testing.T) {
+	f := setupKeeper(t, 0)
+	failing := f.offer(t, sword("1"), 100)
+
+	// the escrowed price has gone missing, so it cannot be refunded
+	delete(f.bank, types.EscrowAddress.String())
+	other := f.offer(t, sword("2"), 50)
+
+	expired := f.keeper.ExpireOffers(f.ctx.WithBlockTime(failing.ExpiresAt))
+	require.Len(t, expired, 1)
+	require.Equal(t, other.ID, expired[0].ID)
+
+	stored, found := f.keeper.GetOffer(f.ctx, failing.ID)
+	require.True(t, found)
+	require.True(t, stored.Active)
+	require.False(t, f.ctx.KVStore(f.storeKey).Has(types.GetOfferQueueKey(failing.ExpiresAt, failing.ID)))
+}
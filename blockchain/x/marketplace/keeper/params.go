@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"skaffacity/x/marketplace/types"
+)
+
+// GetParams returns the marketplace params, or the defaults if none are set
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	bz := ctx.KVStore(k.storeKey).Get(types.ParamsKey)
+	if bz == nil {
+		return types.DefaultParams()
+	}
+
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams stores the marketplace params
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	ctx.KVStore(k.storeKey).Set(types.ParamsKey, k.cdc.MustMarshal(&params))
+}
+
+// validatePaymentDenom checks that price is in one of the allowed payment denoms
+func (k Keeper) validatePaymentDenom(ctx sdk.Context, price sdk.Coin) error {
+	if !k.GetParams(ctx).IsDenomAllowed(price.Denom) {
+		return sdkerrors.Wrapf(types.ErrInvalidPrice, "%s is not an accepted payment denom", price.Denom)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"skaffacity/x/marketplace/types"
+)
+
+// paySale pays the price of a sold NFT from payer. The royalty set on the
+// NFT's class, capped by the MaxRoyaltyBasisPoints param, goes to the class
+// royalty recipient, the marketplace fee to the fee recipient, the protocol
+// fee to the fee collector and the rest to the seller, with a payout event
+// for each transfer.
+func (k Keeper) paySale(ctx sdk.Context, payer sdk.AccAddress, seller, nftID string, price sdk.Coin) error {
+	nft, err := k.nftKeeper.GetNFT(ctx, nftID)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrNFTNotFound, err.Error())
+	}
+	class, err := k.nftKeeper.GetClass(ctx, nft.ClassID)
+	if err != nil {
+		return err
+	}
+
+	params := k.GetParams(ctx)
+	split := params.SplitSale(price, class.Royalty.BasisPoints)
+
+	if err := k.payout(ctx, payer, class.Royalty.Recipient, nftID, types.PayoutRoyalty, split.Royalty); err != nil {
+		return err
+	}
+	if err := k.payout(ctx, payer, params.FeeRecipient, nftID, types.PayoutFee, split.Fee); err != nil {
+		return err
+	}
+	if err := k.payProtocolFee(ctx, payer, nftID, split.ProtocolFee); err != nil {
+		return err
+	}
+	return k.payout(ctx, payer, seller, nftID, types.PayoutSeller, split.Proceeds)
+}
+
+// payListing pays the price of a listing from payer. The price of a bundle
+// is divided between its NFTs by PriceShares, so that each NFT's creator
+// royalty is paid on its share.
+func (k Keeper) payListing(ctx sdk.Context, payer sdk.AccAddress, listing types.Listing) error {
+	shares := listing.PriceShares()
+	for i, nftID := range listing.NFTIDs() {
+		if err := k.paySale(ctx, payer, listing.Creator, nftID, shares[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// payProtocolFee sends the protocol fee of a sale to the fee collector, where
+// the web module's BeginBlock splits it between the developer and the
+// validators together with the transaction fees.
+func (k Keeper) payProtocolFee(ctx sdk.Context, payer sdk.AccAddress, nftID string, amount sdk.Coin) error {
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName).String()
+	return k.payout(ctx, payer, feeCollector, nftID, types.PayoutProtocolFee, amount)
+}
+
+// payout sends one share of a sale to recipient. Empty shares are skipped.
+func (k Keeper) payout(ctx sdk.Context, payer sdk.AccAddress, recipient, nftID, payoutType string, amount sdk.Coin) error {
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	recipientAddr, err := sdk.AccAddressFromBech32(recipient)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid %s recipient address (%s)", payoutType, err)
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, payer, recipientAddr, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePayout,
+			sdk.NewAttribute(types.AttributeKeyNFTID, nftID),
+			sdk.NewAttribute(types.AttributeKeyPayoutType, payoutType),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+	return nil
+}
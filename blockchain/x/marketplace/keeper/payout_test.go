@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"skaffacity/x/marketplace/types"
+)
+
+func TestSplitSale(t *testing.T) {
+	params := types.DefaultParams()
+	params.MarketFeeBasisPoints = 250
+	params.ProtocolFeeBasisPoints = 100
+
+	testCases := []struct {
+		name               string
+		price              int64
+		royaltyBasisPoints uint32
+		// royalty, fee, protocol fee and proceeds
+		expected [4]int64
+	}{
+		{
+			"royalty under the cap",
+			1000, 500,
+			[4]int64{50, 25, 10, 915},
+		},
+		{
+			"royalty capped at MaxRoyaltyBasisPoints",
+			1000, 5000,
+			[4]int64{100, 25, 10, 865},
+		},
+		{
+			// every share rounds down, the remainder goes to the seller
+			"shares rounded down",
+			99, 500,
+			[4]int64{4, 2, 0, 93},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			split := params.SplitSale(skaf(tc.price), tc.royaltyBasisPoints)
+			require.Equal(t, tc.expected, [4]int64{
+				split.Royalty.Amount.Int64(),
+				split.Fee.Amount.Int64(),
+				split.ProtocolFee.Amount.Int64(),
+				split.Proceeds.Amount.Int64(),
+			})
+		})
+	}
+}
+
+func TestBuyItemPaysEveryShare(t *testing.T) {
+	f := setupKeeper(t, 500)
+
+	feeRecipient := testAddr(5)
+	params := f.keeper.GetParams(f.ctx)
+	params.MarketFeeBasisPoints = 250
+	params.FeeRecipient = feeRecipient
+	params.ProtocolFeeBasisPoints = 100
+	f.keeper.SetParams(f.ctx, params)
+
+	listing, err := f.keeper.CreateListing(f.ctx, seller, sword("1"), skaf(1000), 0)
+	require.NoError(t, err)
+	require.Equal(t, types.EscrowAddress.String(), f.owner(t, sword("1")))
+
+	// the fee collector is a plain address here, with no module account
+	// behind it, as the protocol fee must not depend on one
+	f.fund(buyer, skaf(1000))
+	_, err = f.keeper.BuyItem(f.ctx, buyer, listing.ID)
+	require.NoError(t, err)
+	require.Equal(t, buyer, f.owner(t, sword("1")))
+
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName).String()
+	require.Equal(t, sdk.NewCoins(skaf(50)), f.bank[royaltyReceiver])
+	require.Equal(t, sdk.NewCoins(skaf(25)), f.bank[feeRecipient])
+	require.Equal(t, sdk.NewCoins(skaf(10)), f.bank[feeCollector])
+	require.Equal(t, sdk.NewCoins(skaf(915)), f.bank[seller])
+	require.True(t, f.bank[buyer].IsZero())
+
+	var payouts []string
+	for _, event := range f.ctx.EventManager().Events() {
+		if event.Type != types.EventTypePayout {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == types.AttributeKeyPayoutType {
+				payouts = append(payouts, attr.Value)
+			}
+		}
+	}
+	require.Equal(t, []string{types.PayoutRoyalty, types.PayoutFee, types.PayoutProtocolFee, types.PayoutSeller}, payouts)
+}
+
+func TestBuyItemWithoutFees(t *testing.T) {
+	f := setupKeeper(t, 0)
+
+	listing, err := f.keeper.CreateListing(f.ctx, seller, sword("1"), skaf(1000), 0)
+	require.NoError(t, err)
+
+	f.fund(buyer, skaf(1000))
+	_, err = f.keeper.BuyItem(f.ctx, buyer, listing.ID)
+	require.NoError(t, err)
+
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName).String()
+	require.Equal(t, sdk.NewCoins(skaf(1000)), f.bank[seller])
+	require.True(t, f.bank[feeCollector].IsZero())
+}
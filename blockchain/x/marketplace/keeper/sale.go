@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"skaffacity/x/marketplace/types"
+)
+
+// GetSale returns a sale record by ID
+func (k Keeper) GetSale(ctx sdk.Context, id uint64) (types.Sale, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetSaleKey(id))
+	if bz == nil {
+		return types.Sale{}, false
+	}
+
+	var sale types.Sale
+	k.cdc.MustUnmarshal(bz, &sale)
+	return sale, true
+}
+
+// SetSale stores a sale record
+func (k Keeper) SetSale(ctx sdk.Context, sale types.Sale) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetSaleKey(sale.ID), k.cdc.MustMarshal(&sale))
+}
+
+// GetLastSale returns the most recent sale of an NFT
+func (k Keeper) GetLastSale(ctx sdk.Context, nftID string) (types.Sale, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetNFTSalePrefix(nftID))
+	iterator := store.ReverseIterator(nil, nil)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return types.Sale{}, false
+	}
+	return k.GetSale(ctx, sdk.BigEndianToUint64(iterator.Value()))
+}
+
+// GetVolume returns the volume in each denom and the number of the sales
+// made within window of the block time, limited to NFTs of nftType unless it
+// is empty. Window must not exceed VolumeWindowWeek, the span of sales kept
+// in the time index.
+func (k Keeper) GetVolume(ctx sdk.Context, window time.Duration, nftType string) (sdk.Coins, uint64) {
+	store := ctx.KVStore(k.storeKey)
+	start := types.GetSaleTimePrefix(ctx.BlockTime().Add(-window))
+	iterator := store.Iterator(start, sdk.PrefixEndBytes(types.SaleTimeKey))
+	defer iterator.Close()
+
+	volume := sdk.NewCoins()
+	var count uint64
+	for ; iterator.Valid(); iterator.Next() {
+		sale, found := k.GetSale(ctx, sdk.BigEndianToUint64(iterator.Value()))
+		if !found || (nftType != "" && sale.NFTType != nftType) {
+			continue
+		}
+		volume = volume.Add(sale.Price)
+		count++
+	}
+	return volume, count
+}
+
+// GetFloorListing returns the cheapest active single NFT listing of an NFT
+// type priced in denom
+func (k Keeper) GetFloorListing(ctx sdk.Context, nftType, denom string) (types.Listing, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetFloorPrefix(nftType, denom))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return types.Listing{}, false
+	}
+	return k.GetListing(ctx, sdk.BigEndianToUint64(iterator.Value()))
+}
+
+// PruneSales drops the sales older than VolumeWindowWeek from the time
+// index, at most MaxSalePrunesPerBlock per call. The sale records are kept
+// as the NFTs' price history.
+func (k Keeper) PruneSales(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.SaleTimeKey, types.GetSaleTimePrefix(ctx.BlockTime().Add(-types.VolumeWindowWeek)))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid() && len(keys) < types.MaxSalePrunesPerBlock; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// appendSale records an NFT sold to buyer for price by the listing, auction
+// or offer sourceID of kind source
+func (k Keeper) appendSale(ctx sdk.Context, source string, sourceID uint64, nftID, seller, buyer string, price sdk.Coin) {
+	sale := types.Sale{
+		ID:       k.nextID(ctx, types.NextSaleIDKey),
+		NFTID:    nftID,
+		NFTType:  k.nftType(ctx, nftID),
+		Seller:   seller,
+		Buyer:    buyer,
+		Price:    price,
+		Source:   source,
+		SourceID: sourceID,
+		SoldAt:   ctx.BlockTime(),
+	}
+	k.setSale(ctx, sale)
+}
+
+// ImportSale stores a sale record from genesis. Sales older than the volume
+// window drop out of the time index again as PruneSales catches up with them.
+func (k Keeper) ImportSale(ctx sdk.Context, sale types.Sale) {
+	k.setSale(ctx, sale)
+}
+
+// setSale stores a sale record with its NFT and time index entries
+func (k Keeper) setSale(ctx sdk.Context, sale types.Sale) {
+	k.SetSale(ctx, sale)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetNFTSaleKey(sale.NFTID, sale.ID), sdk.Uint64ToBigEndian(sale.ID))
+	store.Set(types.GetSaleTimeKey(sale.SoldAt, sale.ID), sdk.Uint64ToBigEndian(sale.ID))
+}
+
+// GetAllSales returns every sale record
+func (k Keeper) GetAllSales(ctx sdk.Context) []types.Sale {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SaleKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var sales []types.Sale
+	for ; iterator.Valid(); iterator.Next() {
+		var sale types.Sale
+		k.cdc.MustUnmarshal(iterator.Value(), &sale)
+		sales = append(sales, sale)
+	}
+	return sales
+}
+
+// setFloor adds a single NFT listing to the floor index of its NFT type.
+// Bundles have no per-NFT price and are left out.
+func (k Keeper) setFloor(ctx sdk.Context, listing types.Listing) {
+	if listing.IsBundle() {
+		return
+	}
+	key := types.GetFloorKey(k.nftType(ctx, listing.NFTID), listing.Price, listing.ID)
+	ctx.KVStore(k.storeKey).Set(key, sdk.Uint64ToBigEndian(listing.ID))
+}
+
+// deleteFloor removes a listing from the floor index
+func (k Keeper) deleteFloor(ctx sdk.Context, listing types.Listing) {
+	if listing.IsBundle() {
+		return
+	}
+	ctx.KVStore(k.storeKey).Delete(types.GetFloorKey(k.nftType(ctx, listing.NFTID), listing.Price, listing.ID))
+}
+
+// nftType returns the type of an NFT, or "" if it does not exist
+func (k Keeper) nftType(ctx sdk.Context, nftID string) string {
+	nft, err := k.nftKeeper.GetNFT(ctx, nftID)
+	if err != nil {
+		return ""
+	}
+	return nft.Type
+}
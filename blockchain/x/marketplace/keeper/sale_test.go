@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"skaffacity/x/marketplace/types"
+	nfttypes "skaffacity/x/nft/types"
+)
+
+func TestBundleSaleRecordsEachNFTsShare(t *testing.T) {
+	f := setupKeeper(t, 500)
+
+	listing, err := f.keeper.CreateBundleListing(f.ctx, seller, []string{sword("1"), sword("2"), sword("3")}, skaf(1000), 0)
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Coin{skaf(334), skaf(333), skaf(333)}, listing.PriceShares())
+
+	f.fund(buyer, skaf(1000))
+	_, err = f.keeper.BuyItem(f.ctx, buyer, listing.ID)
+	require.NoError(t, err)
+
+	for nftID, price := range map[string]int64{sword("1"): 334, sword("2"): 333, sword("3"): 333} {
+		require.Equal(t, buyer, f.owner(t, nftID))
+
+		sale, found := f.keeper.GetLastSale(f.ctx, nftID)
+		require.True(t, found, nftID)
+		require.Equal(t, skaf(price), sale.Price, nftID)
+		require.Equal(t, seller, sale.Seller)
+		require.Equal(t, buyer, sale.Buyer)
+		require.Equal(t, types.SaleSourceListing, sale.Source)
+		require.Equal(t, listing.ID, sale.SourceID)
+	}
+
+	// the royalty is paid on each share, 5% of 334, 333 and 333 rounded down
+	require.Equal(t, sdk.NewCoins(skaf(48)), f.bank[royaltyReceiver])
+	require.Equal(t, sdk.NewCoins(skaf(952)), f.bank[seller])
+
+	volume, count := f.keeper.GetVolume(f.ctx, types.VolumeWindowDay, nfttypes.TypeItem)
+	require.Equal(t, sdk.NewCoins(skaf(1000)), volume)
+	require.Equal(t, uint64(3), count)
+}
+
+func TestBundlePricedBelowItsSizeIsRejected(t *testing.T) {
+	f := setupKeeper(t, 0)
+
+	// two NFTs at 1skaf would leave the second one a zero price share
+	_, err := f.keeper.CreateBundleListing(f.ctx, seller, []string{sword("1"), sword("2")}, skaf(1), 0)
+	require.ErrorIs(t, err, types.ErrInvalidPrice)
+	require.Equal(t, seller, f.owner(t, sword("1")))
+
+	listing, err := f.keeper.CreateBundleListing(f.ctx, seller, []string{sword("1"), sword("2")}, skaf(2), 0)
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Coin{skaf(1), skaf(1)}, listing.PriceShares())
+}
+
+func TestVolumeOnlyCountsSalesWithinWindow(t *testing.T) {
+	f := setupKeeper(t, 0)
+	f.fund(buyer, skaf(300))
+
+	for _, nftID := range []string{sword("1"), sword("2")} {
+		listing, err := f.keeper.CreateListing(f.ctx, seller, nftID, skaf(100), 0)
+		require.NoError(t, err)
+		_, err = f.keeper.BuyItem(f.ctx, buyer, listing.ID)
+		require.NoError(t, err)
+		f.ctx = f.ctx.WithBlockTime(f.ctx.BlockTime().Add(2 * 24 * time.Hour))
+	}
+
+	volume, count := f.keeper.GetVolume(f.ctx, types.VolumeWindowDay, "")
+	require.True(t, volume.IsZero())
+	require.Zero(t, count)
+
+	volume, count = f.keeper.GetVolume(f.ctx, types.VolumeWindowWeek, "")
+	require.Equal(t, sdk.NewCoins(skaf(200)), volume)
+	require.Equal(t, uint64(2), count)
+}
@@ -1,7 +1,9 @@
 package marketplace
 
 import (
+    "context"
     "encoding/json"
+    "fmt"
 
     "github.com/cosmos/cosmos-sdk/codec"
     codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -12,6 +14,7 @@ import (
     "github.com/grpc-ecosystem/grpc-gateway/runtime"
     abci "github.com/cometbft/cometbft/abci/types"
     
+    "skaffacity/x/marketplace/client/cli"
     "skaffacity/x/marketplace/keeper"
     marketplacetypes "skaffacity/x/marketplace/types"
 )
@@ -26,36 +29,72 @@ func NewAppModule(k keeper.Keeper) AppModule {
 
 func (am AppModule) Name() string { return marketplacetypes.ModuleName }
 
-func (am AppModule) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {}
+func (am AppModule) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+    marketplacetypes.RegisterCodec(cdc)
+}
 
-func (am AppModule) RegisterInterfaces(registry codectypes.InterfaceRegistry) {}
+func (am AppModule) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+    marketplacetypes.RegisterInterfaces(registry)
+}
 
+// DefaultGenesis returns the marketplace module's default genesis state. As
+// in the nft module, the types are JSON-encoded rather than generated
+// protobuf, so genesis uses encoding/json directly instead of the codec.
 func (am AppModule) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
-    return []byte(`{}`) // Simple empty JSON for now
+    bz, err := json.Marshal(marketplacetypes.DefaultGenesisState())
+    if err != nil {
+        panic(err)
+    }
+    return bz
 }
 
 func (am AppModule) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncodingConfig, bz json.RawMessage) error {
-    return nil
+    var genState marketplacetypes.GenesisState
+    if err := json.Unmarshal(bz, &genState); err != nil {
+        return fmt.Errorf("failed to unmarshal %s genesis state: %w", marketplacetypes.ModuleName, err)
+    }
+    return genState.Validate()
 }
 
-func (am AppModule) RegisterServices(cfg module.Configurator) {}
+// RegisterInvariants registers the marketplace escrow invariants
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+    keeper.RegisterInvariants(ir, am.keeper)
+}
 
-func (am AppModule) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {}
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+    marketplacetypes.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
+    marketplacetypes.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServer(am.keeper))
+}
+
+func (am AppModule) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+    marketplacetypes.RegisterQueryHandlerClient(context.Background(), mux, marketplacetypes.NewQueryClient(clientCtx))
+}
 
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
+    var genState marketplacetypes.GenesisState
+    if err := json.Unmarshal(data, &genState); err != nil {
+        panic(fmt.Errorf("failed to unmarshal %s genesis state: %w", marketplacetypes.ModuleName, err))
+    }
+
+    InitGenesis(ctx, am.keeper, genState)
     return []abci.ValidatorUpdate{}
 }
 
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
-    return []byte(`{}`) // Simple empty JSON for now
+    bz, err := json.Marshal(ExportGenesis(ctx, am.keeper))
+    if err != nil {
+        panic(err)
+    }
+    return bz
 }
 
 func (am AppModule) ConsensusVersion() uint64 { return 1 }
 
 func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {}
 func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
+    EndBlocker(ctx, am.keeper)
     return []abci.ValidatorUpdate{}
 }
 
-func (am AppModule) GetTxCmd() *cobra.Command { return nil }
-func (am AppModule) GetQueryCmd() *cobra.Command { return nil }
+func (am AppModule) GetTxCmd() *cobra.Command { return cli.GetTxCmd() }
+func (am AppModule) GetQueryCmd() *cobra.Command { return cli.GetQueryCmd() }
@@ -0,0 +1,172 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// AuctionTypeEnglish is an ascending-price auction won by the highest
+	// bid at or above the reserve price when the auction ends
+	AuctionTypeEnglish = "english"
+	// AuctionTypeDutch is a descending-price auction won by the first bid
+	// at or above the current price, which falls linearly from the start
+	// price to the end price
+	AuctionTypeDutch = "dutch"
+
+	// MinAuctionDuration and MaxAuctionDuration bound how long an auction runs
+	MinAuctionDuration = time.Minute
+	MaxAuctionDuration = 30 * 24 * time.Hour
+
+	// MaxAuctionSettlementsPerBlock bounds the auctions the EndBlocker
+	// settles in one block; the rest are settled in the following blocks
+	MaxAuctionSettlementsPerBlock = 100
+)
+
+// Auction sells an NFT through bidding. The NFT and, for English auctions,
+// the highest bid are held in escrow until the auction is settled.
+type Auction struct {
+	ID          uint64 `json:"id"`
+	AuctionType string `json:"auction_type"`
+	Creator     string `json:"creator"`
+	NFTID       string `json:"nft_id"`
+	// StartPrice is the lowest opening bid of an English auction and the
+	// opening price of a Dutch auction
+	StartPrice sdk.Coin `json:"start_price"`
+	// ReservePrice is the lowest winning bid of an English auction; lower
+	// bids are refunded when the auction ends
+	ReservePrice sdk.Coin `json:"reserve_price"`
+	// MinBidIncrement is how much each English bid must exceed the last
+	MinBidIncrement sdk.Coin `json:"min_bid_increment"`
+	// EndPrice is the price a Dutch auction falls to at EndTime
+	EndPrice      sdk.Coin  `json:"end_price"`
+	StartTime     time.Time `json:"start_time"`
+	EndTime       time.Time `json:"end_time"`
+	HighestBid    sdk.Coin  `json:"highest_bid"`
+	HighestBidder string    `json:"highest_bidder,omitempty"`
+	Active        bool      `json:"active"`
+	// Winner is set once the auction sells, for HighestBid
+	Winner string `json:"winner,omitempty"`
+}
+
+// Validate checks the auction terms
+func (a Auction) Validate() error {
+	if err := ValidatePrice(a.StartPrice); err != nil {
+		return err
+	}
+
+	duration := a.EndTime.Sub(a.StartTime)
+	if duration < MinAuctionDuration || duration > MaxAuctionDuration {
+		return sdkerrors.Wrapf(ErrInvalidAuction, "auctions must run between %s and %s", MinAuctionDuration, MaxAuctionDuration)
+	}
+
+	switch a.AuctionType {
+	case AuctionTypeEnglish:
+		if err := validateOptionalPrice(a.ReservePrice, a.StartPrice.Denom); err != nil {
+			return sdkerrors.Wrap(err, "reserve price")
+		}
+		if err := validateOptionalPrice(a.MinBidIncrement, a.StartPrice.Denom); err != nil {
+			return sdkerrors.Wrap(err, "minimum bid increment")
+		}
+	case AuctionTypeDutch:
+		if err := validatePriceDenom(a.EndPrice, a.StartPrice.Denom); err != nil {
+			return sdkerrors.Wrap(err, "end price")
+		}
+		if !a.EndPrice.IsLT(a.StartPrice) {
+			return sdkerrors.Wrap(ErrInvalidAuction, "a dutch auction must end below its start price")
+		}
+	default:
+		return sdkerrors.Wrapf(ErrInvalidAuction, "unknown auction type %q", a.AuctionType)
+	}
+
+	return nil
+}
+
+// MinimumBid returns the lowest bid an English auction accepts next
+func (a Auction) MinimumBid() sdk.Coin {
+	if a.HighestBidder == "" {
+		return a.StartPrice
+	}
+	if a.MinBidIncrement.IsNil() || a.MinBidIncrement.IsZero() {
+		// without an increment a new bid must still beat the highest one
+		return a.HighestBid.AddAmount(sdk.OneInt())
+	}
+	return a.HighestBid.Add(a.MinBidIncrement)
+}
+
+// ReserveMet reports whether the highest bid of an English auction is high
+// enough to sell
+func (a Auction) ReserveMet() bool {
+	if a.HighestBidder == "" {
+		return false
+	}
+	return a.ReservePrice.IsNil() || a.ReservePrice.IsZero() || a.HighestBid.IsGTE(a.ReservePrice)
+}
+
+// CurrentPrice returns the asking price of a Dutch auction at now. The price
+// falls linearly from StartPrice at StartTime to EndPrice at EndTime.
+func (a Auction) CurrentPrice(now time.Time) sdk.Coin {
+	if !now.After(a.StartTime) {
+		return a.StartPrice
+	}
+	if !now.Before(a.EndTime) {
+		return a.EndPrice
+	}
+
+	elapsed := sdk.NewInt(now.Sub(a.StartTime).Nanoseconds())
+	duration := sdk.NewInt(a.EndTime.Sub(a.StartTime).Nanoseconds())
+	drop := a.StartPrice.Amount.Sub(a.EndPrice.Amount).Mul(elapsed).Quo(duration)
+	return sdk.NewCoin(a.StartPrice.Denom, a.StartPrice.Amount.Sub(drop))
+}
+
+// validateOptionalPrice accepts an unset or zero price, or a valid one in denom
+func validateOptionalPrice(price sdk.Coin, denom string) error {
+	if price.IsNil() || price.IsZero() {
+		return nil
+	}
+	return validatePriceDenom(price, denom)
+}
+
+// validatePriceDenom checks that a price is valid and in the same denom as
+// the rest of the auction
+func validatePriceDenom(price sdk.Coin, denom string) error {
+	if err := ValidatePrice(price); err != nil {
+		return err
+	}
+	if price.Denom != denom {
+		return sdkerrors.Wrapf(ErrInvalidPrice, "price must be in %s, like the start price", denom)
+	}
+	return nil
+}
+
+// ProtoMessage implements the proto.Message interface for Auction.
+func (a *Auction) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for Auction.
+func (a *Auction) Reset() { *a = Auction{} }
+
+// String implements the fmt.Stringer interface for Auction.
+func (a *Auction) String() string {
+	return fmt.Sprintf("Auction{ID: %d, Type: %s, Creator: %s, NFTID: %s, Active: %t}", a.ID, a.AuctionType, a.Creator, a.NFTID, a.Active)
+}
+
+// Marshal implements codec.ProtoMarshaler for Auction.
+func (a *Auction) Marshal() ([]byte, error) { return json.Marshal(a) }
+
+// MarshalTo implements codec.ProtoMarshaler for Auction.
+func (a *Auction) MarshalTo(data []byte) (int, error) { return jsonMarshalTo(a, data) }
+
+// MarshalToSizedBuffer implements codec.ProtoMarshaler for Auction.
+func (a *Auction) MarshalToSizedBuffer(data []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(a, data)
+}
+
+// Unmarshal implements codec.ProtoMarshaler for Auction.
+func (a *Auction) Unmarshal(data []byte) error { return json.Unmarshal(data, a) }
+
+// Size implements codec.ProtoMarshaler for Auction.
+func (a *Auction) Size() int { return jsonSize(a) }
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgCreateListing{}, "marketplace/CreateListing", nil)
+	cdc.RegisterConcrete(&MsgBuyItem{}, "marketplace/BuyItem", nil)
+	cdc.RegisterConcrete(&MsgCancelListing{}, "marketplace/CancelListing", nil)
+	cdc.RegisterConcrete(&MsgCreateAuction{}, "marketplace/CreateAuction", nil)
+	cdc.RegisterConcrete(&MsgPlaceBid{}, "marketplace/PlaceBid", nil)
+	cdc.RegisterConcrete(&MsgCancelAuction{}, "marketplace/CancelAuction", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "marketplace/UpdateParams", nil)
+	cdc.RegisterConcrete(&MsgMakeOffer{}, "marketplace/MakeOffer", nil)
+	cdc.RegisterConcrete(&MsgPlaceCollectionBid{}, "marketplace/PlaceCollectionBid", nil)
+	cdc.RegisterConcrete(&MsgAcceptOffer{}, "marketplace/AcceptOffer", nil)
+	cdc.RegisterConcrete(&MsgCancelOffer{}, "marketplace/CancelOffer", nil)
+	cdc.RegisterConcrete(&MsgCreateBundleListing{}, "marketplace/CreateBundleListing", nil)
+}
+
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateListing{},
+		&MsgBuyItem{},
+		&MsgCancelListing{},
+		&MsgCreateAuction{},
+		&MsgPlaceBid{},
+		&MsgCancelAuction{},
+		&MsgUpdateParams{},
+		&MsgMakeOffer{},
+		&MsgPlaceCollectionBid{},
+		&MsgAcceptOffer{},
+		&MsgCancelOffer{},
+		&MsgCreateBundleListing{},
+	)
+}
+
+var (
+	Amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
+)
+
+func init() {
+	RegisterCodec(Amino)
+	RegisterInterfaces(ModuleCdc.InterfaceRegistry())
+	Amino.Seal()
+}
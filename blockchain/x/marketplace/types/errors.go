@@ -13,4 +13,14 @@ var (
 	ErrListingNotActive   = sdkerrors.Register(ModuleName, 6, "listing not active")
 	ErrSelfPurchase       = sdkerrors.Register(ModuleName, 7, "cannot buy your own NFT")
 	ErrNonTransferable    = sdkerrors.Register(ModuleName, 8, "NFT cannot be transferred")
+	ErrInvalidAuction     = sdkerrors.Register(ModuleName, 9, "invalid auction")
+	ErrAuctionNotFound    = sdkerrors.Register(ModuleName, 10, "auction not found")
+	ErrAuctionNotActive   = sdkerrors.Register(ModuleName, 11, "auction not active")
+	ErrBidTooLow          = sdkerrors.Register(ModuleName, 12, "bid too low")
+	ErrInvalidParams      = sdkerrors.Register(ModuleName, 13, "invalid params")
+	ErrInvalidOffer       = sdkerrors.Register(ModuleName, 14, "invalid offer")
+	ErrOfferNotFound      = sdkerrors.Register(ModuleName, 15, "offer not found")
+	ErrOfferNotActive     = sdkerrors.Register(ModuleName, 16, "offer not active")
+	ErrInvalidListing     = sdkerrors.Register(ModuleName, 17, "invalid listing")
+	ErrSaleNotFound       = sdkerrors.Register(ModuleName, 18, "sale not found")
 )
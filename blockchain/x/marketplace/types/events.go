@@ -0,0 +1,127 @@
+package types
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// marketplace module event types
+const (
+	// EventTypeCreateListing defines the event type for listing an NFT for sale
+	EventTypeCreateListing = "create_listing"
+	// EventTypeCreateBundleListing defines the event type for listing several
+	// NFTs for sale as one lot
+	EventTypeCreateBundleListing = "create_bundle_listing"
+	// EventTypeBuyItem defines the event type for buying a listed NFT
+	EventTypeBuyItem = "buy_item"
+	// EventTypeCancelListing defines the event type for withdrawing a listing
+	EventTypeCancelListing = "cancel_listing"
+	// EventTypeListingExpired defines the event type for a listing withdrawn
+	// on expiry, its NFT returned to the seller
+	EventTypeListingExpired = "listing_expired"
+	// EventTypeCreateAuction defines the event type for putting an NFT up for auction
+	EventTypeCreateAuction = "create_auction"
+	// EventTypePlaceBid defines the event type for a bid on an auction
+	EventTypePlaceBid = "place_bid"
+	// EventTypeCancelAuction defines the event type for withdrawing an auction
+	EventTypeCancelAuction = "cancel_auction"
+	// EventTypeSettleAuction defines the event type for an auction closing,
+	// with or without a winner
+	EventTypeSettleAuction = "settle_auction"
+	// EventTypePayout defines the event type for one share of a sale price
+	// paid out to the seller, the royalty recipient or the fee recipient
+	EventTypePayout = "payout"
+	// EventTypeUpdateParams defines the event type for a params update
+	EventTypeUpdateParams = "update_params"
+	// EventTypeMakeOffer defines the event type for an escrowed offer on an NFT
+	EventTypeMakeOffer = "make_offer"
+	// EventTypePlaceCollectionBid defines the event type for an escrowed bid
+	// on any NFT matching a set of criteria
+	EventTypePlaceCollectionBid = "place_collection_bid"
+	// EventTypeAcceptOffer defines the event type for an owner selling to an
+	// offer or collection bid
+	EventTypeAcceptOffer = "accept_offer"
+	// EventTypeCancelOffer defines the event type for a buyer withdrawing an offer
+	EventTypeCancelOffer = "cancel_offer"
+	// EventTypeOfferExpired defines the event type for an offer refunded on expiry
+	EventTypeOfferExpired = "offer_expired"
+
+	// AttributeKeyListingID defines the event attribute for the listing id
+	AttributeKeyListingID = "listing_id"
+	// AttributeKeyNFTID defines the event attribute for the NFT id
+	AttributeKeyNFTID = "nft_id"
+	// AttributeKeySeller defines the event attribute for the listing creator
+	AttributeKeySeller = "seller"
+	// AttributeKeyBuyer defines the event attribute for the buyer
+	AttributeKeyBuyer = "buyer"
+	// AttributeKeyPrice defines the event attribute for the sale price
+	AttributeKeyPrice = "price"
+	// AttributeKeyAuctionID defines the event attribute for the auction id
+	AttributeKeyAuctionID = "auction_id"
+	// AttributeKeyAuctionType defines the event attribute for the auction type
+	AttributeKeyAuctionType = "auction_type"
+	// AttributeKeyBidder defines the event attribute for the bidder
+	AttributeKeyBidder = "bidder"
+	// AttributeKeyAmount defines the event attribute for the bid amount
+	AttributeKeyAmount = "amount"
+	// AttributeKeyWinner defines the event attribute for the auction winner,
+	// empty when the auction closed unsold
+	AttributeKeyWinner = "winner"
+	// AttributeKeyPayoutType defines the event attribute for the kind of
+	// payout, one of the Payout* values
+	AttributeKeyPayoutType = "payout_type"
+	// AttributeKeyRecipient defines the event attribute for the payout recipient
+	AttributeKeyRecipient = "recipient"
+	// AttributeKeyAuthority defines the event attribute for the module authority
+	AttributeKeyAuthority = "authority"
+	// AttributeKeyOfferID defines the event attribute for the offer id
+	AttributeKeyOfferID = "offer_id"
+	// AttributeKeyClassID defines the event attribute for a collection bid's class
+	AttributeKeyClassID = "class_id"
+	// AttributeKeyNFTType defines the event attribute for a collection bid's NFT type
+	AttributeKeyNFTType = "nft_type"
+	// AttributeKeyMinLandSize defines the event attribute for a collection
+	// bid's minimum land size
+	AttributeKeyMinLandSize = "min_land_size"
+	// AttributeKeyExpiresAt defines the event attribute for a listing's expiry
+	AttributeKeyExpiresAt = "expires_at"
+
+	// PayoutSeller marks the seller's proceeds of a sale
+	PayoutSeller = "seller"
+	// PayoutRoyalty marks the creator royalty of a sale
+	PayoutRoyalty = "royalty"
+	// PayoutFee marks the marketplace fee of a sale
+	PayoutFee = "fee"
+	// PayoutProtocolFee marks the protocol fee of a sale, paid to the fee
+	// collector and split like transaction fees
+	PayoutProtocolFee = "protocol_fee"
+)
+
+// NewListingEvent returns an event of eventType about a listing, with an
+// nft_id attribute for each NFT it sells followed by attrs
+func NewListingEvent(eventType string, listing Listing, attrs ...sdk.Attribute) sdk.Event {
+	event := sdk.NewEvent(eventType, sdk.NewAttribute(AttributeKeyListingID, strconv.FormatUint(listing.ID, 10)))
+	for _, nftID := range listing.NFTIDs() {
+		event = event.AppendAttributes(sdk.NewAttribute(AttributeKeyNFTID, nftID))
+	}
+	return event.AppendAttributes(append([]sdk.Attribute{sdk.NewAttribute(AttributeKeySeller, listing.Creator)}, attrs...)...)
+}
+
+// NewSettleAuctionEvent returns the event of an auction closing, with its
+// winner and price when it sold
+func NewSettleAuctionEvent(auction Auction) sdk.Event {
+	price := ""
+	if auction.Winner != "" {
+		price = auction.HighestBid.String()
+	}
+
+	return sdk.NewEvent(
+		EventTypeSettleAuction,
+		sdk.NewAttribute(AttributeKeyAuctionID, strconv.FormatUint(auction.ID, 10)),
+		sdk.NewAttribute(AttributeKeyNFTID, auction.NFTID),
+		sdk.NewAttribute(AttributeKeySeller, auction.Creator),
+		sdk.NewAttribute(AttributeKeyWinner, auction.Winner),
+		sdk.NewAttribute(AttributeKeyPrice, price),
+	)
+}
@@ -9,12 +9,15 @@ import (
 type BankKeeper interface {
 	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 }
 
 // NFTKeeper defines the expected NFT keeper interface
 type NFTKeeper interface {
 	GetNFT(ctx sdk.Context, nftID string) (nfttypes.NFT, error) // Use actual NFT type and error
 	TransferNFT(ctx sdk.Context, nftID, from, to string) error
+	GetClass(ctx sdk.Context, classID string) (nfttypes.Class, error)
+	GetNFTsByOwner(ctx sdk.Context, owner string) []nfttypes.NFT
 }
 
 // NFT represents an NFT for marketplace operations - using actual NFT type
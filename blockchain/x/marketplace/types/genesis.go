@@ -1,14 +1,141 @@
 package types
 
-// GenesisState defines the marketplace module's genesis state
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GenesisState defines the marketplace module's genesis state. Listings,
+// auctions and offers are exported whether or not they are still active,
+// as a record of past trades; the active ones are what the escrow account
+// holds NFTs and coins for.
 type GenesisState struct {
-	// Empty for now, basic structure
+	Params        Params      `json:"params"`
+	Listings      []Listing   `json:"listings"`
+	NextListingID uint64      `json:"next_listing_id"`
+	Auctions      []Auction   `json:"auctions"`
+	NextAuctionID uint64      `json:"next_auction_id"`
+	Offers        []Offer     `json:"offers"`
+	NextOfferID   uint64      `json:"next_offer_id"`
+	Sales         []Sale      `json:"sales"`
+	NextSaleID    uint64      `json:"next_sale_id"`
+	Stats         MarketStats `json:"stats"`
 }
 
+// DefaultGenesisState returns the marketplace module's default genesis state
 func DefaultGenesisState() *GenesisState {
-	return &GenesisState{}
+	return &GenesisState{
+		Params:        DefaultParams(),
+		Listings:      []Listing{},
+		NextListingID: 1,
+		Auctions:      []Auction{},
+		NextAuctionID: 1,
+		Offers:        []Offer{},
+		NextOfferID:   1,
+		Sales:         []Sale{},
+		NextSaleID:    1,
+		Stats:         NewMarketStats(),
+	}
 }
 
+// Validate performs basic genesis state validation
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	// an NFT can be escrowed by at most one active listing or auction
+	escrowed := make(map[string]string)
+	escrow := func(nftID, by string) error {
+		if other, ok := escrowed[nftID]; ok {
+			return sdkerrors.Wrapf(ErrNFTAlreadyListed, "%s is escrowed by both %s and %s", nftID, other, by)
+		}
+		escrowed[nftID] = by
+		return nil
+	}
+
+	var active uint64
+	listingIDs := make(map[uint64]bool, len(gs.Listings))
+	for _, listing := range gs.Listings {
+		if err := validateGenesisID("listing", listing.ID, gs.NextListingID, listingIDs); err != nil {
+			return err
+		}
+		if err := ValidatePrice(listing.Price); err != nil {
+			return sdkerrors.Wrapf(err, "listing %d", listing.ID)
+		}
+		if listing.IsBundle() {
+			if err := ValidateBundle(listing.NFTIDs(), listing.Price); err != nil {
+				return sdkerrors.Wrapf(err, "listing %d", listing.ID)
+			}
+		}
+		if !listing.Active {
+			continue
+		}
+		for _, nftID := range listing.NFTIDs() {
+			if err := escrow(nftID, fmt.Sprintf("listing %d", listing.ID)); err != nil {
+				return err
+			}
+		}
+		active++
+	}
+
+	auctionIDs := make(map[uint64]bool, len(gs.Auctions))
+	for _, auction := range gs.Auctions {
+		if err := validateGenesisID("auction", auction.ID, gs.NextAuctionID, auctionIDs); err != nil {
+			return err
+		}
+		if err := auction.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "auction %d", auction.ID)
+		}
+		if !auction.Active {
+			continue
+		}
+		if err := escrow(auction.NFTID, fmt.Sprintf("auction %d", auction.ID)); err != nil {
+			return err
+		}
+		active++
+	}
+
+	if gs.Stats.ActiveListings != active {
+		return fmt.Errorf("stats count %d active listings and auctions, genesis holds %d", gs.Stats.ActiveListings, active)
+	}
+	if err := gs.Stats.TotalVolume.Validate(); err != nil {
+		return sdkerrors.Wrap(err, "total volume")
+	}
+
+	offerIDs := make(map[uint64]bool, len(gs.Offers))
+	for _, offer := range gs.Offers {
+		if err := validateGenesisID("offer", offer.ID, gs.NextOfferID, offerIDs); err != nil {
+			return err
+		}
+		if err := offer.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "offer %d", offer.ID)
+		}
+	}
+
+	saleIDs := make(map[uint64]bool, len(gs.Sales))
+	for _, sale := range gs.Sales {
+		if err := validateGenesisID("sale", sale.ID, gs.NextSaleID, saleIDs); err != nil {
+			return err
+		}
+		if err := ValidatePrice(sale.Price); err != nil {
+			return sdkerrors.Wrapf(err, "sale %d", sale.ID)
+		}
+	}
+
+	return nil
+}
+
+// validateGenesisID checks that an ID is unique and below the next ID its
+// counter hands out
+func validateGenesisID(kind string, id, nextID uint64, seen map[uint64]bool) error {
+	if id == 0 || id >= nextID {
+		return fmt.Errorf("%s id %d must be between 1 and the next %s id %d", kind, id, kind, nextID)
+	}
+	if seen[id] {
+		return fmt.Errorf("duplicate %s %d", kind, id)
+	}
+	seen[id] = true
 	return nil
 }
@@ -1,5 +1,13 @@
 package types
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
 const (
     StoreKey     = "marketplace"
     RouterKey    = "marketplace"
@@ -11,4 +19,155 @@ var (
 	ListingKey       = []byte{0x01}
 	NextListingIDKey = []byte{0x02}
 	NFTListingKey    = []byte{0x03}
+	MarketStatsKey   = []byte{0x04}
+	// SellerListingKey indexes every listing, active or not, by its creator
+	SellerListingKey = []byte{0x05}
+	AuctionKey       = []byte{0x06}
+	NextAuctionIDKey = []byte{0x07}
+	// AuctionQueueKey orders active auctions by end time for settlement
+	AuctionQueueKey = []byte{0x08}
+	ParamsKey       = []byte{0x09}
+	OfferKey        = []byte{0x0A}
+	NextOfferIDKey  = []byte{0x0B}
+	// OfferQueueKey orders active offers and collection bids by expiry
+	OfferQueueKey = []byte{0x0C}
+	// NFTOfferKey indexes the active offers on each NFT
+	NFTOfferKey = []byte{0x0D}
+	// BuyerOfferKey indexes every offer, active or not, by its buyer
+	BuyerOfferKey = []byte{0x0E}
+	// ListingQueueKey orders active listings that expire by expiry
+	ListingQueueKey = []byte{0x0F}
+	SaleKey         = []byte{0x10}
+	NextSaleIDKey   = []byte{0x11}
+	// NFTSaleKey indexes the sales of each NFT
+	NFTSaleKey = []byte{0x12}
+	// SaleTimeKey orders the sales of the last VolumeWindowWeek by time
+	SaleTimeKey = []byte{0x13}
+	// FloorKey orders the active single NFT listings of each NFT type and
+	// denom by price
+	FloorKey = []byte{0x14}
 )
+
+// EscrowAddress is the module account holding listed NFTs until they are
+// sold or the listing is cancelled
+var EscrowAddress = authtypes.NewModuleAddress(ModuleName)
+
+// GetListingKey returns the store key of a listing
+func GetListingKey(id uint64) []byte {
+	return append(ListingKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetNFTListingKey returns the key mapping an NFT to its active listing
+func GetNFTListingKey(nftID string) []byte {
+	return append(NFTListingKey, []byte(nftID)...)
+}
+
+// GetSellerListingPrefix returns the prefix of the listings created by seller
+func GetSellerListingPrefix(seller string) []byte {
+	return append(SellerListingKey, []byte(seller+"/")...)
+}
+
+// GetSellerListingKey returns the seller index key of a listing
+func GetSellerListingKey(seller string, id uint64) []byte {
+	return append(GetSellerListingPrefix(seller), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetAuctionKey returns the store key of an auction
+func GetAuctionKey(id uint64) []byte {
+	return append(AuctionKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetAuctionQueuePrefix returns the queue prefix of auctions ending at endTime
+func GetAuctionQueuePrefix(endTime time.Time) []byte {
+	return append(AuctionQueueKey, sdk.FormatTimeBytes(endTime)...)
+}
+
+// GetAuctionQueueKey returns the queue key of an auction
+func GetAuctionQueueKey(endTime time.Time, id uint64) []byte {
+	return append(GetAuctionQueuePrefix(endTime), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetOfferKey returns the store key of an offer
+func GetOfferKey(id uint64) []byte {
+	return append(OfferKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetOfferQueuePrefix returns the queue prefix of offers expiring at expiry
+func GetOfferQueuePrefix(expiry time.Time) []byte {
+	return append(OfferQueueKey, sdk.FormatTimeBytes(expiry)...)
+}
+
+// GetOfferQueueKey returns the queue key of an offer
+func GetOfferQueueKey(expiry time.Time, id uint64) []byte {
+	return append(GetOfferQueuePrefix(expiry), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetNFTOfferPrefix returns the prefix of the active offers on an NFT
+func GetNFTOfferPrefix(nftID string) []byte {
+	return append(NFTOfferKey, []byte(nftID+"/")...)
+}
+
+// GetNFTOfferKey returns the NFT index key of an offer
+func GetNFTOfferKey(nftID string, id uint64) []byte {
+	return append(GetNFTOfferPrefix(nftID), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetBuyerOfferPrefix returns the prefix of the offers made by buyer
+func GetBuyerOfferPrefix(buyer string) []byte {
+	return append(BuyerOfferKey, []byte(buyer+"/")...)
+}
+
+// GetBuyerOfferKey returns the buyer index key of an offer
+func GetBuyerOfferKey(buyer string, id uint64) []byte {
+	return append(GetBuyerOfferPrefix(buyer), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetListingQueuePrefix returns the queue prefix of listings expiring at expiry
+func GetListingQueuePrefix(expiry time.Time) []byte {
+	return append(ListingQueueKey, sdk.FormatTimeBytes(expiry)...)
+}
+
+// GetListingQueueKey returns the queue key of a listing
+func GetListingQueueKey(expiry time.Time, id uint64) []byte {
+	return append(GetListingQueuePrefix(expiry), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetSaleKey returns the store key of a sale
+func GetSaleKey(id uint64) []byte {
+	return append(SaleKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetNFTSalePrefix returns the prefix of the sales of an NFT
+func GetNFTSalePrefix(nftID string) []byte {
+	return append(NFTSaleKey, []byte(nftID+"/")...)
+}
+
+// GetNFTSaleKey returns the NFT index key of a sale
+func GetNFTSaleKey(nftID string, id uint64) []byte {
+	return append(GetNFTSalePrefix(nftID), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetSaleTimePrefix returns the time index prefix of sales made at soldAt
+func GetSaleTimePrefix(soldAt time.Time) []byte {
+	return append(SaleTimeKey, sdk.FormatTimeBytes(soldAt)...)
+}
+
+// GetSaleTimeKey returns the time index key of a sale
+func GetSaleTimeKey(soldAt time.Time, id uint64) []byte {
+	return append(GetSaleTimePrefix(soldAt), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetFloorPrefix returns the prefix of the floor index of listings of
+// nftType priced in denom. Both are length-prefixed since denoms may
+// contain "/".
+func GetFloorPrefix(nftType, denom string) []byte {
+	key := append(FloorKey, address.MustLengthPrefix([]byte(nftType))...)
+	return append(key, address.MustLengthPrefix([]byte(denom))...)
+}
+
+// GetFloorKey returns the floor index key of a listing. The price amount is
+// encoded as fixed-width big-endian so that keys sort by price.
+func GetFloorKey(nftType string, price sdk.Coin, id uint64) []byte {
+	amount := price.Amount.BigInt().FillBytes(make([]byte, 32))
+	return append(append(GetFloorPrefix(nftType, price.Denom), amount...), sdk.Uint64ToBigEndian(id)...)
+}
@@ -1,8 +1,29 @@
 package types
 
 import (
+	"encoding/json"
+	"fmt"
 	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// DefaultPaymentDenom is the denom the marketplace accepts by default
+	DefaultPaymentDenom = "skaf"
+
+	// MinListingDuration and MaxListingDuration bound the lifetime of a
+	// listing that expires; a zero duration means the listing never does
+	MinListingDuration = time.Minute
+	MaxListingDuration = 180 * 24 * time.Hour
+
+	// MaxListingExpiriesPerBlock bounds the listings the EndBlocker expires
+	// in one block; the rest are expired in the following blocks
+	MaxListingExpiriesPerBlock = 100
+
+	// MaxBundleSize is the most NFTs a bundle listing can sell as one lot
+	MaxBundleSize = 20
 )
 
 // Listing represents a marketplace listing
@@ -15,6 +36,12 @@ type Listing struct {
 	CreatedAt time.Time `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
 	SoldTo    string    `protobuf:"bytes,7,opt,name=sold_to,json=soldTo,proto3" json:"sold_to,omitempty"`
 	SoldAt    time.Time `protobuf:"bytes,8,opt,name=sold_at,json=soldAt,proto3,stdtime" json:"sold_at"`
+	// ExpiresAt is when the listing is withdrawn unless sold; zero for a
+	// listing that never expires
+	ExpiresAt time.Time `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at"`
+	// BundledNFTIDs are the further NFTs of a bundle listing, sold together
+	// with NFTID as one lot; empty for a listing of a single NFT
+	BundledNFTIDs []string `protobuf:"bytes,10,rep,name=bundled_nft_ids,json=bundledNftIds,proto3" json:"bundled_nft_ids,omitempty"`
 }
 
 // MarketStats represents marketplace statistics
@@ -22,14 +49,152 @@ type MarketStats struct {
 	TotalListings  uint64   `protobuf:"varint,1,opt,name=total_listings,json=totalListings,proto3" json:"total_listings,omitempty"`
 	ActiveListings uint64   `protobuf:"varint,2,opt,name=active_listings,json=activeListings,proto3" json:"active_listings,omitempty"`
 	SoldItems      uint64   `protobuf:"varint,3,opt,name=sold_items,json=soldItems,proto3" json:"sold_items,omitempty"`
-	TotalVolume    sdk.Coin `protobuf:"bytes,4,opt,name=total_volume,json=totalVolume,proto3" json:"total_volume"`
+	// TotalVolume is the sales volume in each payment denom
+	TotalVolume sdk.Coins `protobuf:"bytes,4,rep,name=total_volume,json=totalVolume,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_volume"`
+}
+
+// NewMarketStats returns empty statistics
+func NewMarketStats() MarketStats {
+	return MarketStats{TotalVolume: sdk.NewCoins()}
+}
+
+// IsBundle reports whether the listing sells several NFTs as one lot
+func (l Listing) IsBundle() bool {
+	return len(l.BundledNFTIDs) > 0
+}
+
+// NFTIDs returns every NFT the listing sells, starting with NFTID
+func (l Listing) NFTIDs() []string {
+	return append([]string{l.NFTID}, l.BundledNFTIDs...)
+}
+
+// PriceShares divides the price between the NFTs the listing sells, in the
+// order of NFTIDs. Shares are even and the remainder goes with the first NFT,
+// so the shares of a single NFT listing are just its price.
+func (l Listing) PriceShares() []sdk.Coin {
+	nftIDs := l.NFTIDs()
+	share := l.Price.Amount.QuoRaw(int64(len(nftIDs)))
+
+	shares := make([]sdk.Coin, len(nftIDs))
+	for i := range shares {
+		shares[i] = sdk.NewCoin(l.Price.Denom, share)
+	}
+	shares[0].Amount = l.Price.Amount.Sub(share.MulRaw(int64(len(nftIDs) - 1)))
+	return shares
+}
+
+// HasExpiry reports whether the listing expires
+func (l Listing) HasExpiry() bool {
+	return !l.ExpiresAt.IsZero()
+}
+
+// IsExpired reports whether the listing has expired by now
+func (l Listing) IsExpired(now time.Time) bool {
+	return l.HasExpiry() && !now.Before(l.ExpiresAt)
+}
+
+// ValidateListingDuration checks the lifetime of a listing, where zero means
+// the listing never expires
+func ValidateListingDuration(duration time.Duration) error {
+	if duration == 0 {
+		return nil
+	}
+	if duration < MinListingDuration || duration > MaxListingDuration {
+		return sdkerrors.Wrapf(ErrInvalidListing, "expiring listings must run between %s and %s", MinListingDuration, MaxListingDuration)
+	}
+	return nil
+}
+
+// ValidateBundle checks the NFTs of a bundle listing: at least two and at
+// most MaxBundleSize, none empty or repeated. The price must be at least one
+// base unit per NFT, so that PriceShares gives each of them a positive share.
+func ValidateBundle(nftIDs []string, price sdk.Coin) error {
+	if len(nftIDs) < 2 || len(nftIDs) > MaxBundleSize {
+		return sdkerrors.Wrapf(ErrInvalidListing, "a bundle must hold between 2 and %d NFTs", MaxBundleSize)
+	}
+
+	seen := make(map[string]bool, len(nftIDs))
+	for _, nftID := range nftIDs {
+		if nftID == "" {
+			return sdkerrors.Wrap(ErrNFTNotFound, "NFT id cannot be empty")
+		}
+		if seen[nftID] {
+			return sdkerrors.Wrapf(ErrInvalidListing, "%s appears more than once in the bundle", nftID)
+		}
+		seen[nftID] = true
+	}
+
+	if price.Amount.LT(sdk.NewInt(int64(len(nftIDs)))) {
+		return sdkerrors.Wrapf(ErrInvalidPrice, "a bundle of %d NFTs must be priced at least %d%s", len(nftIDs), len(nftIDs), price.Denom)
+	}
+	return nil
+}
+
+// ValidatePrice checks that a price is a valid positive coin. Whether its
+// denom is accepted depends on the AllowedDenoms param and is checked by the
+// keeper.
+func ValidatePrice(price sdk.Coin) error {
+	if err := price.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidPrice, err.Error())
+	}
+	if !price.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidPrice, "price must be positive")
+	}
+	return nil
 }
 
 // ProtoMessage implements the proto.Message interface for Listing.
 func (l *Listing) ProtoMessage() {}
 
 // Reset implements the proto.Message interface for Listing.
-func (l *Listing) Reset() {}
+func (l *Listing) Reset() { *l = Listing{} }
 
 // String implements the fmt.Stringer interface for Listing.
-func (l *Listing) String() string { return "Listing stub" }
\ No newline at end of file
+func (l *Listing) String() string {
+	return fmt.Sprintf("Listing{ID: %d, Creator: %s, NFTIDs: %v, Price: %s, Active: %t}", l.ID, l.Creator, l.NFTIDs(), l.Price, l.Active)
+}
+
+// Marshal implements codec.ProtoMarshaler for Listing.
+func (l *Listing) Marshal() ([]byte, error) { return json.Marshal(l) }
+
+// MarshalTo implements codec.ProtoMarshaler for Listing.
+func (l *Listing) MarshalTo(data []byte) (int, error) { return jsonMarshalTo(l, data) }
+
+// MarshalToSizedBuffer implements codec.ProtoMarshaler for Listing.
+func (l *Listing) MarshalToSizedBuffer(data []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(l, data)
+}
+
+// Unmarshal implements codec.ProtoMarshaler for Listing.
+func (l *Listing) Unmarshal(data []byte) error { return json.Unmarshal(data, l) }
+
+// Size implements codec.ProtoMarshaler for Listing.
+func (l *Listing) Size() int { return jsonSize(l) }
+
+// ProtoMessage implements the proto.Message interface for MarketStats.
+func (s *MarketStats) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MarketStats.
+func (s *MarketStats) Reset() { *s = MarketStats{} }
+
+// String implements the fmt.Stringer interface for MarketStats.
+func (s *MarketStats) String() string {
+	return fmt.Sprintf("MarketStats{TotalListings: %d, ActiveListings: %d, SoldItems: %d, TotalVolume: %s}", s.TotalListings, s.ActiveListings, s.SoldItems, s.TotalVolume)
+}
+
+// Marshal implements codec.ProtoMarshaler for MarketStats.
+func (s *MarketStats) Marshal() ([]byte, error) { return json.Marshal(s) }
+
+// MarshalTo implements codec.ProtoMarshaler for MarketStats.
+func (s *MarketStats) MarshalTo(data []byte) (int, error) { return jsonMarshalTo(s, data) }
+
+// MarshalToSizedBuffer implements codec.ProtoMarshaler for MarketStats.
+func (s *MarketStats) MarshalToSizedBuffer(data []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(s, data)
+}
+
+// Unmarshal implements codec.ProtoMarshaler for MarketStats.
+func (s *MarketStats) Unmarshal(data []byte) error { return json.Unmarshal(data, s) }
+
+// Size implements codec.ProtoMarshaler for MarketStats.
+func (s *MarketStats) Size() int { return jsonSize(s) }
@@ -1,5 +1,163 @@
 package types
 
-func NewMsgCreateListing(sender, id string, price interface{}) {}
-func NewMsgBuyItem(sender, id string) {}
-func NewMsgCancelListing(sender, id string) {}
+import (
+	"encoding/json"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgCreateListing = "create_listing"
+	TypeMsgBuyItem       = "buy_item"
+	TypeMsgCancelListing = "cancel_listing"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateListing{}
+	_ sdk.Msg = &MsgBuyItem{}
+	_ sdk.Msg = &MsgCancelListing{}
+)
+
+func NewMsgCreateListing(creator, nftID string, price sdk.Coin, duration time.Duration) *MsgCreateListing {
+	return &MsgCreateListing{
+		Creator:  creator,
+		NFTID:    nftID,
+		Price:    price,
+		Duration: duration,
+	}
+}
+
+// Route returns the route of MsgCreateListing
+func (msg *MsgCreateListing) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of MsgCreateListing
+func (msg *MsgCreateListing) Type() string {
+	return TypeMsgCreateListing
+}
+
+// GetSigners returns the signers of MsgCreateListing
+func (msg *MsgCreateListing) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// GetSignBytes returns the sign bytes of MsgCreateListing
+func (msg *MsgCreateListing) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validates the basic fields of MsgCreateListing
+func (msg *MsgCreateListing) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if msg.NFTID == "" {
+		return sdkerrors.Wrap(ErrNFTNotFound, "NFT id cannot be empty")
+	}
+
+	if err := ValidatePrice(msg.Price); err != nil {
+		return err
+	}
+
+	return ValidateListingDuration(msg.Duration)
+}
+
+func NewMsgBuyItem(buyer string, listingID uint64) *MsgBuyItem {
+	return &MsgBuyItem{
+		Buyer:     buyer,
+		ListingID: listingID,
+	}
+}
+
+// Route returns the route of MsgBuyItem
+func (msg *MsgBuyItem) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of MsgBuyItem
+func (msg *MsgBuyItem) Type() string {
+	return TypeMsgBuyItem
+}
+
+// GetSigners returns the signers of MsgBuyItem
+func (msg *MsgBuyItem) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Buyer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// GetSignBytes returns the sign bytes of MsgBuyItem
+func (msg *MsgBuyItem) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validates the basic fields of MsgBuyItem
+func (msg *MsgBuyItem) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Buyer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid buyer address (%s)", err)
+	}
+
+	return nil
+}
+
+func NewMsgCancelListing(creator string, listingID uint64) *MsgCancelListing {
+	return &MsgCancelListing{
+		Creator:   creator,
+		ListingID: listingID,
+	}
+}
+
+// Route returns the route of MsgCancelListing
+func (msg *MsgCancelListing) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of MsgCancelListing
+func (msg *MsgCancelListing) Type() string {
+	return TypeMsgCancelListing
+}
+
+// GetSigners returns the signers of MsgCancelListing
+func (msg *MsgCancelListing) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// GetSignBytes returns the sign bytes of MsgCancelListing
+func (msg *MsgCancelListing) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validates the basic fields of MsgCancelListing
+func (msg *MsgCancelListing) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,213 @@
+package types
+
+import (
+	"encoding/json"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgCreateAuction = "create_auction"
+	TypeMsgPlaceBid      = "place_bid"
+	TypeMsgCancelAuction = "cancel_auction"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateAuction{}
+	_ sdk.Msg = &MsgPlaceBid{}
+	_ sdk.Msg = &MsgCancelAuction{}
+)
+
+// MsgCreateAuction puts an NFT up for auction, starting at the current block
+// time and running for Duration. ReservePrice and MinBidIncrement apply to
+// English auctions only, EndPrice to Dutch auctions only.
+type MsgCreateAuction struct {
+	Creator         string        `json:"creator"`
+	NFTID           string        `json:"nft_id"`
+	AuctionType     string        `json:"auction_type"`
+	StartPrice      sdk.Coin      `json:"start_price"`
+	ReservePrice    sdk.Coin      `json:"reserve_price"`
+	MinBidIncrement sdk.Coin      `json:"min_bid_increment"`
+	EndPrice        sdk.Coin      `json:"end_price"`
+	Duration        time.Duration `json:"duration"`
+}
+
+// NewMsgCreateAuction creates a new MsgCreateAuction
+func NewMsgCreateAuction(creator, nftID, auctionType string, startPrice, reservePrice, minBidIncrement, endPrice sdk.Coin, duration time.Duration) *MsgCreateAuction {
+	return &MsgCreateAuction{
+		Creator:         creator,
+		NFTID:           nftID,
+		AuctionType:     auctionType,
+		StartPrice:      startPrice,
+		ReservePrice:    reservePrice,
+		MinBidIncrement: minBidIncrement,
+		EndPrice:        endPrice,
+		Duration:        duration,
+	}
+}
+
+// Auction returns the auction the message creates when it starts at start
+func (msg *MsgCreateAuction) Auction(start time.Time) Auction {
+	return Auction{
+		AuctionType:     msg.AuctionType,
+		Creator:         msg.Creator,
+		NFTID:           msg.NFTID,
+		StartPrice:      msg.StartPrice,
+		ReservePrice:    msg.ReservePrice,
+		MinBidIncrement: msg.MinBidIncrement,
+		EndPrice:        msg.EndPrice,
+		StartTime:       start,
+		EndTime:         start.Add(msg.Duration),
+	}
+}
+
+// Route returns the route of MsgCreateAuction
+func (msg *MsgCreateAuction) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of MsgCreateAuction
+func (msg *MsgCreateAuction) Type() string {
+	return TypeMsgCreateAuction
+}
+
+// GetSigners returns the signers of MsgCreateAuction
+func (msg *MsgCreateAuction) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// GetSignBytes returns the sign bytes of MsgCreateAuction
+func (msg *MsgCreateAuction) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validates the basic fields of MsgCreateAuction
+func (msg *MsgCreateAuction) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if msg.NFTID == "" {
+		return sdkerrors.Wrap(ErrNFTNotFound, "NFT id cannot be empty")
+	}
+
+	// the start time does not affect validity, only the duration does
+	return msg.Auction(time.Unix(0, 0)).Validate()
+}
+
+// MsgPlaceBid bids on an auction. On an English auction the bid is escrowed
+// and refunded if it is outbid or the reserve is not met. On a Dutch auction
+// a bid at or above the current price buys the NFT at the current price.
+type MsgPlaceBid struct {
+	Bidder    string   `json:"bidder"`
+	AuctionID uint64   `json:"auction_id"`
+	Amount    sdk.Coin `json:"amount"`
+}
+
+// NewMsgPlaceBid creates a new MsgPlaceBid
+func NewMsgPlaceBid(bidder string, auctionID uint64, amount sdk.Coin) *MsgPlaceBid {
+	return &MsgPlaceBid{
+		Bidder:    bidder,
+		AuctionID: auctionID,
+		Amount:    amount,
+	}
+}
+
+// Route returns the route of MsgPlaceBid
+func (msg *MsgPlaceBid) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of MsgPlaceBid
+func (msg *MsgPlaceBid) Type() string {
+	return TypeMsgPlaceBid
+}
+
+// GetSigners returns the signers of MsgPlaceBid
+func (msg *MsgPlaceBid) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// GetSignBytes returns the sign bytes of MsgPlaceBid
+func (msg *MsgPlaceBid) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validates the basic fields of MsgPlaceBid
+func (msg *MsgPlaceBid) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Bidder); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid bidder address (%s)", err)
+	}
+
+	return ValidatePrice(msg.Amount)
+}
+
+// MsgCancelAuction withdraws an auction and returns the NFT. English auctions
+// can only be cancelled before the first bid.
+type MsgCancelAuction struct {
+	Creator   string `json:"creator"`
+	AuctionID uint64 `json:"auction_id"`
+}
+
+// NewMsgCancelAuction creates a new MsgCancelAuction
+func NewMsgCancelAuction(creator string, auctionID uint64) *MsgCancelAuction {
+	return &MsgCancelAuction{
+		Creator:   creator,
+		AuctionID: auctionID,
+	}
+}
+
+// Route returns the route of MsgCancelAuction
+func (msg *MsgCancelAuction) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of MsgCancelAuction
+func (msg *MsgCancelAuction) Type() string {
+	return TypeMsgCancelAuction
+}
+
+// GetSigners returns the signers of MsgCancelAuction
+func (msg *MsgCancelAuction) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// GetSignBytes returns the sign bytes of MsgCancelAuction
+func (msg *MsgCancelAuction) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validates the basic fields of MsgCancelAuction
+func (msg *MsgCancelAuction) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	return nil
+}
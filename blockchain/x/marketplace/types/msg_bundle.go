@@ -0,0 +1,79 @@
+package types
+
+import (
+	"encoding/json"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCreateBundleListing = "create_bundle_listing"
+
+var _ sdk.Msg = &MsgCreateBundleListing{}
+
+// MsgCreateBundleListing lists several NFTs, such as a land parcel with its
+// buildings, for sale as one lot at a single Price. All of them are
+// escrowed together and a buyer gets either all of them or none. The
+// listing is bought, cancelled and expires like any other listing.
+type MsgCreateBundleListing struct {
+	Creator  string        `json:"creator"`
+	NFTIDs   []string      `json:"nft_ids"`
+	Price    sdk.Coin      `json:"price"`
+	Duration time.Duration `json:"duration,omitempty"`
+}
+
+// NewMsgCreateBundleListing creates a new MsgCreateBundleListing
+func NewMsgCreateBundleListing(creator string, nftIDs []string, price sdk.Coin, duration time.Duration) *MsgCreateBundleListing {
+	return &MsgCreateBundleListing{
+		Creator:  creator,
+		NFTIDs:   nftIDs,
+		Price:    price,
+		Duration: duration,
+	}
+}
+
+// Route returns the route of MsgCreateBundleListing
+func (msg *MsgCreateBundleListing) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of MsgCreateBundleListing
+func (msg *MsgCreateBundleListing) Type() string {
+	return TypeMsgCreateBundleListing
+}
+
+// GetSigners returns the signers of MsgCreateBundleListing
+func (msg *MsgCreateBundleListing) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// GetSignBytes returns the sign bytes of MsgCreateBundleListing
+func (msg *MsgCreateBundleListing) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validates the basic fields of MsgCreateBundleListing
+func (msg *MsgCreateBundleListing) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := ValidatePrice(msg.Price); err != nil {
+		return err
+	}
+
+	if err := ValidateBundle(msg.NFTIDs, msg.Price); err != nil {
+		return err
+	}
+
+	return ValidateListingDuration(msg.Duration)
+}
@@ -0,0 +1,269 @@
+package types
+
+import (
+	"encoding/json"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgMakeOffer          = "make_offer"
+	TypeMsgPlaceCollectionBid = "place_collection_bid"
+	TypeMsgAcceptOffer        = "accept_offer"
+	TypeMsgCancelOffer        = "cancel_offer"
+)
+
+var (
+	_ sdk.Msg = &MsgMakeOffer{}
+	_ sdk.Msg = &MsgPlaceCollectionBid{}
+	_ sdk.Msg = &MsgAcceptOffer{}
+	_ sdk.Msg = &MsgCancelOffer{}
+)
+
+// MsgMakeOffer offers to buy a single NFT, listed or not, for Price. The
+// price is escrowed until the owner accepts the offer, the buyer cancels it
+// or it expires after Duration.
+type MsgMakeOffer struct {
+	Buyer    string        `json:"buyer"`
+	NFTID    string        `json:"nft_id"`
+	Price    sdk.Coin      `json:"price"`
+	Duration time.Duration `json:"duration"`
+}
+
+// NewMsgMakeOffer creates a new MsgMakeOffer
+func NewMsgMakeOffer(buyer, nftID string, price sdk.Coin, duration time.Duration) *MsgMakeOffer {
+	return &MsgMakeOffer{
+		Buyer:    buyer,
+		NFTID:    nftID,
+		Price:    price,
+		Duration: duration,
+	}
+}
+
+// Offer returns the offer the message makes when it is made at start
+func (msg *MsgMakeOffer) Offer(start time.Time) Offer {
+	return Offer{
+		Buyer:     msg.Buyer,
+		NFTID:     msg.NFTID,
+		Price:     msg.Price,
+		CreatedAt: start,
+		ExpiresAt: start.Add(msg.Duration),
+	}
+}
+
+// Route returns the route of MsgMakeOffer
+func (msg *MsgMakeOffer) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of MsgMakeOffer
+func (msg *MsgMakeOffer) Type() string {
+	return TypeMsgMakeOffer
+}
+
+// GetSigners returns the signers of MsgMakeOffer
+func (msg *MsgMakeOffer) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Buyer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// GetSignBytes returns the sign bytes of MsgMakeOffer
+func (msg *MsgMakeOffer) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validates the basic fields of MsgMakeOffer
+func (msg *MsgMakeOffer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Buyer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid buyer address (%s)", err)
+	}
+
+	// the start time does not affect validity, only the duration does
+	return msg.Offer(time.Unix(0, 0)).Validate()
+}
+
+// MsgPlaceCollectionBid offers Price for any one NFT matching Criteria, such
+// as any land parcel of size 4 or more. The price is escrowed until the
+// owner of a matching NFT accepts the bid, the buyer cancels it or it
+// expires after Duration.
+type MsgPlaceCollectionBid struct {
+	Buyer    string        `json:"buyer"`
+	Criteria BidCriteria   `json:"criteria"`
+	Price    sdk.Coin      `json:"price"`
+	Duration time.Duration `json:"duration"`
+}
+
+// NewMsgPlaceCollectionBid creates a new MsgPlaceCollectionBid
+func NewMsgPlaceCollectionBid(buyer string, criteria BidCriteria, price sdk.Coin, duration time.Duration) *MsgPlaceCollectionBid {
+	return &MsgPlaceCollectionBid{
+		Buyer:    buyer,
+		Criteria: criteria,
+		Price:    price,
+		Duration: duration,
+	}
+}
+
+// Offer returns the collection bid the message places when it is placed at start
+func (msg *MsgPlaceCollectionBid) Offer(start time.Time) Offer {
+	criteria := msg.Criteria
+	return Offer{
+		Buyer:     msg.Buyer,
+		Criteria:  &criteria,
+		Price:     msg.Price,
+		CreatedAt: start,
+		ExpiresAt: start.Add(msg.Duration),
+	}
+}
+
+// Route returns the route of MsgPlaceCollectionBid
+func (msg *MsgPlaceCollectionBid) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of MsgPlaceCollectionBid
+func (msg *MsgPlaceCollectionBid) Type() string {
+	return TypeMsgPlaceCollectionBid
+}
+
+// GetSigners returns the signers of MsgPlaceCollectionBid
+func (msg *MsgPlaceCollectionBid) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Buyer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// GetSignBytes returns the sign bytes of MsgPlaceCollectionBid
+func (msg *MsgPlaceCollectionBid) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validates the basic fields of MsgPlaceCollectionBid
+func (msg *MsgPlaceCollectionBid) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Buyer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid buyer address (%s)", err)
+	}
+
+	// the start time does not affect validity, only the duration does
+	return msg.Offer(time.Unix(0, 0)).Validate()
+}
+
+// MsgAcceptOffer sells an NFT the owner holds to an offer or collection bid.
+// NFTID may be left empty for an offer on a single NFT and names the NFT
+// being sold for a collection bid.
+type MsgAcceptOffer struct {
+	Owner   string `json:"owner"`
+	OfferID uint64 `json:"offer_id"`
+	NFTID   string `json:"nft_id,omitempty"`
+}
+
+// NewMsgAcceptOffer creates a new MsgAcceptOffer
+func NewMsgAcceptOffer(owner string, offerID uint64, nftID string) *MsgAcceptOffer {
+	return &MsgAcceptOffer{
+		Owner:   owner,
+		OfferID: offerID,
+		NFTID:   nftID,
+	}
+}
+
+// Route returns the route of MsgAcceptOffer
+func (msg *MsgAcceptOffer) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of MsgAcceptOffer
+func (msg *MsgAcceptOffer) Type() string {
+	return TypeMsgAcceptOffer
+}
+
+// GetSigners returns the signers of MsgAcceptOffer
+func (msg *MsgAcceptOffer) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// GetSignBytes returns the sign bytes of MsgAcceptOffer
+func (msg *MsgAcceptOffer) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validates the basic fields of MsgAcceptOffer
+func (msg *MsgAcceptOffer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+
+	return nil
+}
+
+// MsgCancelOffer withdraws an offer or collection bid and refunds its price
+type MsgCancelOffer struct {
+	Buyer   string `json:"buyer"`
+	OfferID uint64 `json:"offer_id"`
+}
+
+// NewMsgCancelOffer creates a new MsgCancelOffer
+func NewMsgCancelOffer(buyer string, offerID uint64) *MsgCancelOffer {
+	return &MsgCancelOffer{
+		Buyer:   buyer,
+		OfferID: offerID,
+	}
+}
+
+// Route returns the route of MsgCancelOffer
+func (msg *MsgCancelOffer) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of MsgCancelOffer
+func (msg *MsgCancelOffer) Type() string {
+	return TypeMsgCancelOffer
+}
+
+// GetSigners returns the signers of MsgCancelOffer
+func (msg *MsgCancelOffer) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Buyer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// GetSignBytes returns the sign bytes of MsgCancelOffer
+func (msg *MsgCancelOffer) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validates the basic fields of MsgCancelOffer
+func (msg *MsgCancelOffer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Buyer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid buyer address (%s)", err)
+	}
+
+	return nil
+}
@@ -1,8 +1,590 @@
 package types
 
-type MsgServer interface{}
+import (
+	"context"
+	"fmt"
 
-type MsgCreateListingResponse struct{}
+	"google.golang.org/grpc"
+)
+
+// MsgServer defines the marketplace module's message service
+type MsgServer interface {
+	CreateListing(context.Context, *MsgCreateListing) (*MsgCreateListingResponse, error)
+	BuyItem(context.Context, *MsgBuyItem) (*MsgBuyItemResponse, error)
+	CancelListing(context.Context, *MsgCancelListing) (*MsgCancelListingResponse, error)
+	CreateAuction(context.Context, *MsgCreateAuction) (*MsgCreateAuctionResponse, error)
+	PlaceBid(context.Context, *MsgPlaceBid) (*MsgPlaceBidResponse, error)
+	CancelAuction(context.Context, *MsgCancelAuction) (*MsgCancelAuctionResponse, error)
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	MakeOffer(context.Context, *MsgMakeOffer) (*MsgMakeOfferResponse, error)
+	PlaceCollectionBid(context.Context, *MsgPlaceCollectionBid) (*MsgPlaceCollectionBidResponse, error)
+	AcceptOffer(context.Context, *MsgAcceptOffer) (*MsgAcceptOfferResponse, error)
+	CancelOffer(context.Context, *MsgCancelOffer) (*MsgCancelOfferResponse, error)
+	CreateBundleListing(context.Context, *MsgCreateBundleListing) (*MsgCreateBundleListingResponse, error)
+}
+
+// MsgCreateListingResponse is the response for MsgCreateListing
+type MsgCreateListingResponse struct {
+	ListingID uint64 `json:"listing_id"`
+}
+
+// MsgBuyItemResponse is the response for MsgBuyItem
 type MsgBuyItemResponse struct{}
-type MsgCancelListing struct{}
+
+// MsgCancelListingResponse is the response for MsgCancelListing
 type MsgCancelListingResponse struct{}
+
+// MsgCreateAuctionResponse is the response for MsgCreateAuction
+type MsgCreateAuctionResponse struct {
+	AuctionID uint64 `json:"auction_id"`
+}
+
+// MsgPlaceBidResponse is the response for MsgPlaceBid. Sold is set when the
+// bid won a Dutch auction outright.
+type MsgPlaceBidResponse struct {
+	Sold bool `json:"sold"`
+}
+
+// MsgCancelAuctionResponse is the response for MsgCancelAuction
+type MsgCancelAuctionResponse struct{}
+
+// MsgUpdateParamsResponse is the response for MsgUpdateParams
+type MsgUpdateParamsResponse struct{}
+
+// MsgMakeOfferResponse is the response for MsgMakeOffer
+type MsgMakeOfferResponse struct {
+	OfferID uint64 `json:"offer_id"`
+}
+
+// MsgPlaceCollectionBidResponse is the response for MsgPlaceCollectionBid
+type MsgPlaceCollectionBidResponse struct {
+	OfferID uint64 `json:"offer_id"`
+}
+
+// MsgAcceptOfferResponse is the response for MsgAcceptOffer
+type MsgAcceptOfferResponse struct{}
+
+// MsgCancelOfferResponse is the response for MsgCancelOffer
+type MsgCancelOfferResponse struct{}
+
+// MsgCreateBundleListingResponse is the response for MsgCreateBundleListing
+type MsgCreateBundleListingResponse struct {
+	ListingID uint64 `json:"listing_id"`
+}
+
+// ProtoMessage implements the proto.Message interface for MsgCreateListing.
+func (msg *MsgCreateListing) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgCreateListing.
+func (msg *MsgCreateListing) Reset() { *msg = MsgCreateListing{} }
+
+// String implements the proto.Message interface for MsgCreateListing.
+func (msg *MsgCreateListing) String() string {
+	return fmt.Sprintf("MsgCreateListing{Creator: %s, NFTID: %s, Price: %s, Duration: %s}", msg.Creator, msg.NFTID, msg.Price, msg.Duration)
+}
+
+// XXX_MessageName returns the fully qualified message name used as type URL.
+func (msg *MsgCreateListing) XXX_MessageName() string {
+	return "skaffacity.marketplace.v1.MsgCreateListing"
+}
+
+// ProtoMessage implements the proto.Message interface for MsgCreateListingResponse.
+func (m *MsgCreateListingResponse) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgCreateListingResponse.
+func (m *MsgCreateListingResponse) Reset() { *m = MsgCreateListingResponse{} }
+
+// String implements the proto.Message interface for MsgCreateListingResponse.
+func (m *MsgCreateListingResponse) String() string {
+	return fmt.Sprintf("MsgCreateListingResponse{ListingID: %d}", m.ListingID)
+}
+
+// ProtoMessage implements the proto.Message interface for MsgBuyItem.
+func (msg *MsgBuyItem) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgBuyItem.
+func (msg *MsgBuyItem) Reset() { *msg = MsgBuyItem{} }
+
+// String implements the proto.Message interface for MsgBuyItem.
+func (msg *MsgBuyItem) String() string {
+	return fmt.Sprintf("MsgBuyItem{Buyer: %s, ListingID: %d}", msg.Buyer, msg.ListingID)
+}
+
+// XXX_MessageName returns the fully qualified message name used as type URL.
+func (msg *MsgBuyItem) XXX_MessageName() string { return "skaffacity.marketplace.v1.MsgBuyItem" }
+
+// ProtoMessage implements the proto.Message interface for MsgBuyItemResponse.
+func (m *MsgBuyItemResponse) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgBuyItemResponse.
+func (m *MsgBuyItemResponse) Reset() { *m = MsgBuyItemResponse{} }
+
+// String implements the proto.Message interface for MsgBuyItemResponse.
+func (m *MsgBuyItemResponse) String() string { return "MsgBuyItemResponse{}" }
+
+// ProtoMessage implements the proto.Message interface for MsgCancelListing.
+func (msg *MsgCancelListing) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgCancelListing.
+func (msg *MsgCancelListing) Reset() { *msg = MsgCancelListing{} }
+
+// String implements the proto.Message interface for MsgCancelListing.
+func (msg *MsgCancelListing) String() string {
+	return fmt.Sprintf("MsgCancelListing{Creator: %s, ListingID: %d}", msg.Creator, msg.ListingID)
+}
+
+// XXX_MessageName returns the fully qualified message name used as type URL.
+func (msg *MsgCancelListing) XXX_MessageName() string {
+	return "skaffacity.marketplace.v1.MsgCancelListing"
+}
+
+// ProtoMessage implements the proto.Message interface for MsgCancelListingResponse.
+func (m *MsgCancelListingResponse) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgCancelListingResponse.
+func (m *MsgCancelListingResponse) Reset() { *m = MsgCancelListingResponse{} }
+
+// String implements the proto.Message interface for MsgCancelListingResponse.
+func (m *MsgCancelListingResponse) String() string { return "MsgCancelListingResponse{}" }
+
+// ProtoMessage implements the proto.Message interface for MsgCreateAuction.
+func (msg *MsgCreateAuction) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgCreateAuction.
+func (msg *MsgCreateAuction) Reset() { *msg = MsgCreateAuction{} }
+
+// String implements the proto.Message interface for MsgCreateAuction.
+func (msg *MsgCreateAuction) String() string {
+	return fmt.Sprintf("MsgCreateAuction{Creator: %s, NFTID: %s, AuctionType: %s, StartPrice: %s, Duration: %s}", msg.Creator, msg.NFTID, msg.AuctionType, msg.StartPrice, msg.Duration)
+}
+
+// XXX_MessageName returns the fully qualified message name used as type URL.
+func (msg *MsgCreateAuction) XXX_MessageName() string {
+	return "skaffacity.marketplace.v1.MsgCreateAuction"
+}
+
+// ProtoMessage implements the proto.Message interface for MsgCreateAuctionResponse.
+func (m *MsgCreateAuctionResponse) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgCreateAuctionResponse.
+func (m *MsgCreateAuctionResponse) Reset() { *m = MsgCreateAuctionResponse{} }
+
+// String implements the proto.Message interface for MsgCreateAuctionResponse.
+func (m *MsgCreateAuctionResponse) String() string {
+	return fmt.Sprintf("MsgCreateAuctionResponse{AuctionID: %d}", m.AuctionID)
+}
+
+// ProtoMessage implements the proto.Message interface for MsgPlaceBid.
+func (msg *MsgPlaceBid) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgPlaceBid.
+func (msg *MsgPlaceBid) Reset() { *msg = MsgPlaceBid{} }
+
+// String implements the proto.Message interface for MsgPlaceBid.
+func (msg *MsgPlaceBid) String() string {
+	return fmt.Sprintf("MsgPlaceBid{Bidder: %s, AuctionID: %d, Amount: %s}", msg.Bidder, msg.AuctionID, msg.Amount)
+}
+
+// XXX_MessageName returns the fully qualified message name used as type URL.
+func (msg *MsgPlaceBid) XXX_MessageName() string { return "skaffacity.marketplace.v1.MsgPlaceBid" }
+
+// ProtoMessage implements the proto.Message interface for MsgPlaceBidResponse.
+func (m *MsgPlaceBidResponse) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgPlaceBidResponse.
+func (m *MsgPlaceBidResponse) Reset() { *m = MsgPlaceBidResponse{} }
+
+// String implements the proto.Message interface for MsgPlaceBidResponse.
+func (m *MsgPlaceBidResponse) String() string {
+	return fmt.Sprintf("MsgPlaceBidResponse{Sold: %t}", m.Sold)
+}
+
+// ProtoMessage implements the proto.Message interface for MsgCancelAuction.
+func (msg *MsgCancelAuction) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgCancelAuction.
+func (msg *MsgCancelAuction) Reset() { *msg = MsgCancelAuction{} }
+
+// String implements the proto.Message interface for MsgCancelAuction.
+func (msg *MsgCancelAuction) String() string {
+	return fmt.Sprintf("MsgCancelAuction{Creator: %s, AuctionID: %d}", msg.Creator, msg.AuctionID)
+}
+
+// XXX_MessageName returns the fully qualified message name used as type URL.
+func (msg *MsgCancelAuction) XXX_MessageName() string {
+	return "skaffacity.marketplace.v1.MsgCancelAuction"
+}
+
+// ProtoMessage implements the proto.Message interface for MsgCancelAuctionResponse.
+func (m *MsgCancelAuctionResponse) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgCancelAuctionResponse.
+func (m *MsgCancelAuctionResponse) Reset() { *m = MsgCancelAuctionResponse{} }
+
+// String implements the proto.Message interface for MsgCancelAuctionResponse.
+func (m *MsgCancelAuctionResponse) String() string { return "MsgCancelAuctionResponse{}" }
+
+// ProtoMessage implements the proto.Message interface for MsgUpdateParams.
+func (msg *MsgUpdateParams) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgUpdateParams.
+func (msg *MsgUpdateParams) Reset() { *msg = MsgUpdateParams{} }
+
+// String implements the proto.Message interface for MsgUpdateParams.
+func (msg *MsgUpdateParams) String() string {
+	return fmt.Sprintf("MsgUpdateParams{Authority: %s, Params: %s}", msg.Authority, msg.Params.String())
+}
+
+// XXX_MessageName returns the fully qualified message name used as type URL.
+func (msg *MsgUpdateParams) XXX_MessageName() string {
+	return "skaffacity.marketplace.v1.MsgUpdateParams"
+}
+
+// ProtoMessage implements the proto.Message interface for MsgUpdateParamsResponse.
+func (m *MsgUpdateParamsResponse) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgUpdateParamsResponse.
+func (m *MsgUpdateParamsResponse) Reset() { *m = MsgUpdateParamsResponse{} }
+
+// String implements the proto.Message interface for MsgUpdateParamsResponse.
+func (m *MsgUpdateParamsResponse) String() string { return "MsgUpdateParamsResponse{}" }
+
+// ProtoMessage implements the proto.Message interface for MsgMakeOffer.
+func (msg *MsgMakeOffer) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgMakeOffer.
+func (msg *MsgMakeOffer) Reset() { *msg = MsgMakeOffer{} }
+
+// String implements the proto.Message interface for MsgMakeOffer.
+func (msg *MsgMakeOffer) String() string {
+	return fmt.Sprintf("MsgMakeOffer{Buyer: %s, NFTID: %s, Price: %s, Duration: %s}", msg.Buyer, msg.NFTID, msg.Price, msg.Duration)
+}
+
+// XXX_MessageName returns the fully qualified message name used as type URL.
+func (msg *MsgMakeOffer) XXX_MessageName() string { return "skaffacity.marketplace.v1.MsgMakeOffer" }
+
+// ProtoMessage implements the proto.Message interface for MsgMakeOfferResponse.
+func (m *MsgMakeOfferResponse) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgMakeOfferResponse.
+func (m *MsgMakeOfferResponse) Reset() { *m = MsgMakeOfferResponse{} }
+
+// String implements the proto.Message interface for MsgMakeOfferResponse.
+func (m *MsgMakeOfferResponse) String() string {
+	return fmt.Sprintf("MsgMakeOfferResponse{OfferID: %d}", m.OfferID)
+}
+
+// ProtoMessage implements the proto.Message interface for MsgPlaceCollectionBid.
+func (msg *MsgPlaceCollectionBid) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgPlaceCollectionBid.
+func (msg *MsgPlaceCollectionBid) Reset() { *msg = MsgPlaceCollectionBid{} }
+
+// String implements the proto.Message interface for MsgPlaceCollectionBid.
+func (msg *MsgPlaceCollectionBid) String() string {
+	return fmt.Sprintf("MsgPlaceCollectionBid{Buyer: %s, Criteria: %+v, Price: %s, Duration: %s}", msg.Buyer, msg.Criteria, msg.Price, msg.Duration)
+}
+
+// XXX_MessageName returns the fully qualified message name used as type URL.
+func (msg *MsgPlaceCollectionBid) XXX_MessageName() string {
+	return "skaffacity.marketplace.v1.MsgPlaceCollectionBid"
+}
+
+// ProtoMessage implements the proto.Message interface for MsgPlaceCollectionBidResponse.
+func (m *MsgPlaceCollectionBidResponse) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgPlaceCollectionBidResponse.
+func (m *MsgPlaceCollectionBidResponse) Reset() { *m = MsgPlaceCollectionBidResponse{} }
+
+// String implements the proto.Message interface for MsgPlaceCollectionBidResponse.
+func (m *MsgPlaceCollectionBidResponse) String() string {
+	return fmt.Sprintf("MsgPlaceCollectionBidResponse{OfferID: %d}", m.OfferID)
+}
+
+// ProtoMessage implements the proto.Message interface for MsgAcceptOffer.
+func (msg *MsgAcceptOffer) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgAcceptOffer.
+func (msg *MsgAcceptOffer) Reset() { *msg = MsgAcceptOffer{} }
+
+// String implements the proto.Message interface for MsgAcceptOffer.
+func (msg *MsgAcceptOffer) String() string {
+	return fmt.Sprintf("MsgAcceptOffer{Owner: %s, OfferID: %d, NFTID: %s}", msg.Owner, msg.OfferID, msg.NFTID)
+}
+
+// XXX_MessageName returns the fully qualified message name used as type URL.
+func (msg *MsgAcceptOffer) XXX_MessageName() string {
+	return "skaffacity.marketplace.v1.MsgAcceptOffer"
+}
+
+// ProtoMessage implements the proto.Message interface for MsgAcceptOfferResponse.
+func (m *MsgAcceptOfferResponse) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgAcceptOfferResponse.
+func (m *MsgAcceptOfferResponse) Reset() { *m = MsgAcceptOfferResponse{} }
+
+// String implements the proto.Message interface for MsgAcceptOfferResponse.
+func (m *MsgAcceptOfferResponse) String() string { return "MsgAcceptOfferResponse{}" }
+
+// ProtoMessage implements the proto.Message interface for MsgCancelOffer.
+func (msg *MsgCancelOffer) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgCancelOffer.
+func (msg *MsgCancelOffer) Reset() { *msg = MsgCancelOffer{} }
+
+// String implements the proto.Message interface for MsgCancelOffer.
+func (msg *MsgCancelOffer) String() string {
+	return fmt.Sprintf("MsgCancelOffer{Buyer: %s, OfferID: %d}", msg.Buyer, msg.OfferID)
+}
+
+// XXX_MessageName returns the fully qualified message name used as type URL.
+func (msg *MsgCancelOffer) XXX_MessageName() string {
+	return "skaffacity.marketplace.v1.MsgCancelOffer"
+}
+
+// ProtoMessage implements the proto.Message interface for MsgCancelOfferResponse.
+func (m *MsgCancelOfferResponse) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgCancelOfferResponse.
+func (m *MsgCancelOfferResponse) Reset() { *m = MsgCancelOfferResponse{} }
+
+// String implements the proto.Message interface for MsgCancelOfferResponse.
+func (m *MsgCancelOfferResponse) String() string { return "MsgCancelOfferResponse{}" }
+
+// ProtoMessage implements the proto.Message interface for MsgCreateBundleListing.
+func (msg *MsgCreateBundleListing) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgCreateBundleListing.
+func (msg *MsgCreateBundleListing) Reset() { *msg = MsgCreateBundleListing{} }
+
+// String implements the proto.Message interface for MsgCreateBundleListing.
+func (msg *MsgCreateBundleListing) String() string {
+	return fmt.Sprintf("MsgCreateBundleListing{Creator: %s, NFTIDs: %v, Price: %s, Duration: %s}", msg.Creator, msg.NFTIDs, msg.Price, msg.Duration)
+}
+
+// XXX_MessageName returns the fully qualified message name used as type URL.
+func (msg *MsgCreateBundleListing) XXX_MessageName() string {
+	return "skaffacity.marketplace.v1.MsgCreateBundleListing"
+}
+
+// ProtoMessage implements the proto.Message interface for MsgCreateBundleListingResponse.
+func (m *MsgCreateBundleListingResponse) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgCreateBundleListingResponse.
+func (m *MsgCreateBundleListingResponse) Reset() { *m = MsgCreateBundleListingResponse{} }
+
+// String implements the proto.Message interface for MsgCreateBundleListingResponse.
+func (m *MsgCreateBundleListingResponse) String() string {
+	return fmt.Sprintf("MsgCreateBundleListingResponse{ListingID: %d}", m.ListingID)
+}
+
+const msgServiceName = "skaffacity.marketplace.v1.Msg"
+
+// RegisterMsgServer registers the message service implementation with the
+// server. The app's MsgServiceRouter delivers the module's transactions
+// through it.
+func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateListing)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + msgServiceName + "/CreateListing"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateListing(ctx, req.(*MsgCreateListing))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BuyItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBuyItem)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BuyItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + msgServiceName + "/BuyItem"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BuyItem(ctx, req.(*MsgBuyItem))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelListing)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + msgServiceName + "/CancelListing"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelListing(ctx, req.(*MsgCancelListing))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + msgServiceName + "/CreateAuction"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateAuction(ctx, req.(*MsgCreateAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PlaceBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlaceBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PlaceBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + msgServiceName + "/PlaceBid"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlaceBid(ctx, req.(*MsgPlaceBid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + msgServiceName + "/CancelAuction"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelAuction(ctx, req.(*MsgCancelAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + msgServiceName + "/UpdateParams"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MakeOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMakeOffer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MakeOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + msgServiceName + "/MakeOffer"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MakeOffer(ctx, req.(*MsgMakeOffer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PlaceCollectionBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlaceCollectionBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PlaceCollectionBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + msgServiceName + "/PlaceCollectionBid"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlaceCollectionBid(ctx, req.(*MsgPlaceCollectionBid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptOffer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + msgServiceName + "/AcceptOffer"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptOffer(ctx, req.(*MsgAcceptOffer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelOffer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + msgServiceName + "/CancelOffer"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelOffer(ctx, req.(*MsgCancelOffer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateBundleListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateBundleListing)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateBundleListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + msgServiceName + "/CreateBundleListing"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateBundleListing(ctx, req.(*MsgCreateBundleListing))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// _Msg_serviceDesc is written by hand like the module's other types; there is
+// no tx.proto to generate it from.
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: msgServiceName,
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "CreateListing", Handler: _Msg_CreateListing_Handler},
+		{MethodName: "BuyItem", Handler: _Msg_BuyItem_Handler},
+		{MethodName: "CancelListing", Handler: _Msg_CancelListing_Handler},
+		{MethodName: "CreateAuction", Handler: _Msg_CreateAuction_Handler},
+		{MethodName: "PlaceBid", Handler: _Msg_PlaceBid_Handler},
+		{MethodName: "CancelAuction", Handler: _Msg_CancelAuction_Handler},
+		{MethodName: "UpdateParams", Handler: _Msg_UpdateParams_Handler},
+		{MethodName: "MakeOffer", Handler: _Msg_MakeOffer_Handler},
+		{MethodName: "PlaceCollectionBid", Handler: _Msg_PlaceCollectionBid_Handler},
+		{MethodName: "AcceptOffer", Handler: _Msg_AcceptOffer_Handler},
+		{MethodName: "CancelOffer", Handler: _Msg_CancelOffer_Handler},
+		{MethodName: "CreateBundleListing", Handler: _Msg_CreateBundleListing_Handler},
+	},
+	Streams: []grpc.StreamDesc{},
+}
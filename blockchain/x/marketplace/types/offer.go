@@ -0,0 +1,154 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	nfttypes "skaffacity/x/nft/types"
+)
+
+const (
+	// MinOfferDuration and MaxOfferDuration bound how long an offer stays open
+	MinOfferDuration = time.Minute
+	MaxOfferDuration = 90 * 24 * time.Hour
+
+	// MaxOfferExpiriesPerBlock bounds the offers the EndBlocker expires in
+	// one block; the rest are expired in the following blocks
+	MaxOfferExpiriesPerBlock = 100
+)
+
+// Offer is a buyer's escrowed bid to buy an NFT. An offer targets either a
+// single NFT, listed or not, or, as a collection bid, any NFT matching its
+// Criteria. The owner of a matching NFT can accept it until it expires.
+type Offer struct {
+	ID    uint64 `json:"id"`
+	Buyer string `json:"buyer"`
+	// NFTID is the NFT the offer is for; empty for a collection bid
+	NFTID string `json:"nft_id,omitempty"`
+	// Criteria selects the NFTs a collection bid accepts; nil for an offer
+	// on a single NFT
+	Criteria  *BidCriteria `json:"criteria,omitempty"`
+	Price     sdk.Coin     `json:"price"`
+	CreatedAt time.Time    `json:"created_at"`
+	ExpiresAt time.Time    `json:"expires_at"`
+	Active    bool         `json:"active"`
+	// Seller and SoldNFTID are set once the offer is accepted
+	Seller    string `json:"seller,omitempty"`
+	SoldNFTID string `json:"sold_nft_id,omitempty"`
+}
+
+// BidCriteria selects the NFTs a collection bid accepts. Every field that is
+// set must match, and at least one of ClassID and NFTType must be set.
+type BidCriteria struct {
+	ClassID string `json:"class_id,omitempty"`
+	NFTType string `json:"nft_type,omitempty"`
+	// MinLandSize accepts only land parcels at least this size
+	MinLandSize uint32 `json:"min_land_size,omitempty"`
+}
+
+// IsCollectionBid reports whether the offer accepts any NFT matching its
+// criteria rather than a single NFT
+func (o Offer) IsCollectionBid() bool {
+	return o.Criteria != nil
+}
+
+// Validate checks the offer terms
+func (o Offer) Validate() error {
+	if err := ValidatePrice(o.Price); err != nil {
+		return err
+	}
+
+	duration := o.ExpiresAt.Sub(o.CreatedAt)
+	if duration < MinOfferDuration || duration > MaxOfferDuration {
+		return sdkerrors.Wrapf(ErrInvalidOffer, "offers must run between %s and %s", MinOfferDuration, MaxOfferDuration)
+	}
+
+	if o.IsCollectionBid() {
+		if o.NFTID != "" {
+			return sdkerrors.Wrap(ErrInvalidOffer, "a collection bid cannot name an NFT")
+		}
+		return o.Criteria.Validate()
+	}
+
+	if o.NFTID == "" {
+		return sdkerrors.Wrap(ErrNFTNotFound, "NFT id cannot be empty")
+	}
+	return nil
+}
+
+// Matches reports whether an NFT can be sold to the offer
+func (o Offer) Matches(nft nfttypes.NFT) bool {
+	if o.IsCollectionBid() {
+		return o.Criteria.Matches(nft)
+	}
+	return nft.ID == o.NFTID
+}
+
+// Validate checks that the criteria select a well-defined set of NFTs
+func (c BidCriteria) Validate() error {
+	if c.ClassID == "" && c.NFTType == "" {
+		return sdkerrors.Wrap(ErrInvalidOffer, "a collection bid needs a class or an NFT type")
+	}
+
+	if c.ClassID != "" {
+		if err := nfttypes.ValidateClassID(c.ClassID); err != nil {
+			return sdkerrors.Wrap(ErrInvalidOffer, err.Error())
+		}
+	}
+
+	if c.NFTType != "" && !nfttypes.IsValidNFTType(c.NFTType) {
+		return sdkerrors.Wrapf(ErrInvalidOffer, "unknown NFT type %q", c.NFTType)
+	}
+
+	if c.MinLandSize > 0 && c.NFTType != nfttypes.TypeLand {
+		return sdkerrors.Wrapf(ErrInvalidOffer, "a minimum land size needs the %s NFT type", nfttypes.TypeLand)
+	}
+
+	return nil
+}
+
+// Matches reports whether an NFT meets every criterion
+func (c BidCriteria) Matches(nft nfttypes.NFT) bool {
+	if c.ClassID != "" && nft.ClassID != c.ClassID {
+		return false
+	}
+	if c.NFTType != "" && nft.Type != c.NFTType {
+		return false
+	}
+	if c.MinLandSize > 0 && (nft.Land == nil || nft.Land.Size < c.MinLandSize) {
+		return false
+	}
+	return true
+}
+
+// ProtoMessage implements the proto.Message interface for Offer.
+func (o *Offer) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for Offer.
+func (o *Offer) Reset() { *o = Offer{} }
+
+// String implements the fmt.Stringer interface for Offer.
+func (o *Offer) String() string {
+	return fmt.Sprintf("Offer{ID: %d, Buyer: %s, NFTID: %s, Price: %s, Active: %t}", o.ID, o.Buyer, o.NFTID, o.Price, o.Active)
+}
+
+// Marshal implements codec.ProtoMarshaler for Offer.
+func (o *Offer) Marshal() ([]byte, error) { return json.Marshal(o) }
+
+// MarshalTo implements codec.ProtoMarshaler for Offer.
+func (o *Offer) MarshalTo(data []byte) (int, error) { return jsonMarshalTo(o, data) }
+
+// MarshalToSizedBuffer implements codec.ProtoMarshaler for Offer.
+func (o *Offer) MarshalToSizedBuffer(data []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(o, data)
+}
+
+// Unmarshal implements codec.ProtoMarshaler for Offer.
+func (o *Offer) Unmarshal(data []byte) error { return json.Unmarshal(data, o) }
+
+// Size implements codec.ProtoMarshaler for Offer.
+func (o *Offer) Size() int { return jsonSize(o) }
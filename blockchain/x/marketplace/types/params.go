@@ -0,0 +1,151 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// BasisPointsDenominator is 100% expressed in basis points
+	BasisPointsDenominator = 10000
+
+	// DefaultMaxRoyaltyBasisPoints caps creator royalties at 10% of a sale
+	DefaultMaxRoyaltyBasisPoints = 1000
+)
+
+// Params defines the marketplace module parameters
+type Params struct {
+	// MaxRoyaltyBasisPoints caps the royalty paid on a sale, whatever rate the
+	// NFT's class asks for
+	MaxRoyaltyBasisPoints uint32 `json:"max_royalty_basis_points"`
+	// MarketFeeBasisPoints is the share of every sale paid to FeeRecipient;
+	// 0 disables the fee
+	MarketFeeBasisPoints uint32 `json:"market_fee_basis_points"`
+	FeeRecipient         string `json:"fee_recipient,omitempty"`
+	// AllowedDenoms are the denoms listings, auctions and offers may be
+	// priced in, such as skaf and approved factory tokens
+	AllowedDenoms []string `json:"allowed_denoms"`
+	// ProtocolFeeBasisPoints is the share of every sale collected as chain
+	// revenue and split between the developer and the validators like
+	// transaction fees; 0 disables the fee
+	ProtocolFeeBasisPoints uint32 `json:"protocol_fee_basis_points"`
+}
+
+// SaleSplit is how a sale price is divided between its recipients
+type SaleSplit struct {
+	Royalty     sdk.Coin
+	Fee         sdk.Coin
+	ProtocolFee sdk.Coin
+	Proceeds    sdk.Coin
+}
+
+// DefaultParams returns the default marketplace params, with royalties capped
+// at 10%, no marketplace or protocol fee and prices in DefaultPaymentDenom
+// only
+func DefaultParams() Params {
+	return Params{
+		MaxRoyaltyBasisPoints: DefaultMaxRoyaltyBasisPoints,
+		AllowedDenoms:         []string{DefaultPaymentDenom},
+	}
+}
+
+// Validate checks the params
+func (p Params) Validate() error {
+	if p.MaxRoyaltyBasisPoints > BasisPointsDenominator {
+		return sdkerrors.Wrapf(ErrInvalidParams, "max royalty cannot exceed %d basis points", BasisPointsDenominator)
+	}
+
+	if p.MaxRoyaltyBasisPoints+p.MarketFeeBasisPoints+p.ProtocolFeeBasisPoints > BasisPointsDenominator {
+		return sdkerrors.Wrapf(ErrInvalidParams, "max royalty, marketplace fee and protocol fee together cannot exceed %d basis points", BasisPointsDenominator)
+	}
+
+	if p.MarketFeeBasisPoints > 0 {
+		if _, err := sdk.AccAddressFromBech32(p.FeeRecipient); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid fee recipient address (%s)", err)
+		}
+	} else if p.FeeRecipient != "" {
+		return sdkerrors.Wrap(ErrInvalidParams, "fee recipient set without a marketplace fee")
+	}
+
+	if len(p.AllowedDenoms) == 0 {
+		return sdkerrors.Wrap(ErrInvalidParams, "at least one payment denom must be allowed")
+	}
+	seen := make(map[string]bool, len(p.AllowedDenoms))
+	for _, denom := range p.AllowedDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidParams, "invalid payment denom %q (%s)", denom, err)
+		}
+		if seen[denom] {
+			return sdkerrors.Wrapf(ErrInvalidParams, "duplicate payment denom %s", denom)
+		}
+		seen[denom] = true
+	}
+
+	return nil
+}
+
+// IsDenomAllowed reports whether prices may be set in denom
+func (p Params) IsDenomAllowed(denom string) bool {
+	for _, allowed := range p.AllowedDenoms {
+		if allowed == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// SplitSale divides price between a royalty at royaltyBasisPoints, capped at
+// MaxRoyaltyBasisPoints, the marketplace fee, the protocol fee and the
+// seller's proceeds. Shares are rounded down, so any remainder goes to the
+// seller.
+func (p Params) SplitSale(price sdk.Coin, royaltyBasisPoints uint32) SaleSplit {
+	if royaltyBasisPoints > p.MaxRoyaltyBasisPoints {
+		royaltyBasisPoints = p.MaxRoyaltyBasisPoints
+	}
+
+	royalty := sdk.NewCoin(price.Denom, basisPointsOf(price.Amount, royaltyBasisPoints))
+	fee := sdk.NewCoin(price.Denom, basisPointsOf(price.Amount, p.MarketFeeBasisPoints))
+	protocolFee := sdk.NewCoin(price.Denom, basisPointsOf(price.Amount, p.ProtocolFeeBasisPoints))
+
+	return SaleSplit{
+		Royalty:     royalty,
+		Fee:         fee,
+		ProtocolFee: protocolFee,
+		Proceeds:    price.Sub(royalty).Sub(fee).Sub(protocolFee),
+	}
+}
+
+func basisPointsOf(amount sdk.Int, basisPoints uint32) sdk.Int {
+	return amount.MulRaw(int64(basisPoints)).QuoRaw(BasisPointsDenominator)
+}
+
+// ProtoMessage implements the proto.Message interface for Params.
+func (p *Params) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for Params.
+func (p *Params) Reset() { *p = Params{} }
+
+// String implements the fmt.Stringer interface for Params.
+func (p *Params) String() string {
+	return fmt.Sprintf("Params{MaxRoyaltyBasisPoints: %d, MarketFeeBasisPoints: %d, FeeRecipient: %s, AllowedDenoms: %v, ProtocolFeeBasisPoints: %d}", p.MaxRoyaltyBasisPoints, p.MarketFeeBasisPoints, p.FeeRecipient, p.AllowedDenoms, p.ProtocolFeeBasisPoints)
+}
+
+// Marshal implements codec.ProtoMarshaler for Params.
+func (p *Params) Marshal() ([]byte, error) { return json.Marshal(p) }
+
+// MarshalTo implements codec.ProtoMarshaler for Params.
+func (p *Params) MarshalTo(data []byte) (int, error) { return jsonMarshalTo(p, data) }
+
+// MarshalToSizedBuffer implements codec.ProtoMarshaler for Params.
+func (p *Params) MarshalToSizedBuffer(data []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(p, data)
+}
+
+// Unmarshal implements codec.ProtoMarshaler for Params.
+func (p *Params) Unmarshal(data []byte) error { return json.Unmarshal(data, p) }
+
+// Size implements codec.ProtoMarshaler for Params.
+func (p *Params) Size() int { return jsonSize(p) }
@@ -1,14 +1,604 @@
 package types
 
-type QueryServer interface{}
+import (
+	"encoding/json"
+	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// QueryListingRequest is the request type for the Query/Listing RPC method
+type QueryListingRequest struct {
+	Id uint64 `json:"id"`
+}
+
+// QueryListingResponse is the response type for the Query/Listing RPC method
+type QueryListingResponse struct {
+	Listing Listing `json:"listing"`
+}
+
+// QueryListingsRequest is the request type for the Query/Listings RPC method
+type QueryListingsRequest struct {
+	// Denom, when set, keeps only the listings priced in it
+	Denom      string             `json:"denom,omitempty"`
+	Pagination *query.PageRequest `json:"pagination,omitempty"`
+}
+
+// QueryListingsResponse is the response type for the Query/Listings RPC method
+type QueryListingsResponse struct {
+	Listings   []Listing           `json:"listings"`
+	Pagination *query.PageResponse `json:"pagination,omitempty"`
+}
+
+// QueryListingsByTypeRequest is the request type for the Query/ListingsByType RPC method
+type QueryListingsByTypeRequest struct {
+	Type string `json:"type"`
+	// Denom, when set, keeps only the listings priced in it
+	Denom      string             `json:"denom,omitempty"`
+	Pagination *query.PageRequest `json:"pagination,omitempty"`
+}
+
+// QueryListingsByTypeResponse is the response type for the Query/ListingsByType RPC method
+type QueryListingsByTypeResponse struct {
+	Listings   []Listing           `json:"listings"`
+	Pagination *query.PageResponse `json:"pagination,omitempty"`
+}
+
+// QueryListingsByOwnerRequest is the request type for the Query/ListingsByOwner RPC method
+type QueryListingsByOwnerRequest struct {
+	Owner string `json:"owner"`
+	// Denom, when set, keeps only the listings priced in it
+	Denom      string             `json:"denom,omitempty"`
+	Pagination *query.PageRequest `json:"pagination,omitempty"`
+}
+
+// QueryListingsByOwnerResponse is the response type for the Query/ListingsByOwner RPC method
+type QueryListingsByOwnerResponse struct {
+	Listings   []Listing           `json:"listings"`
+	Pagination *query.PageResponse `json:"pagination,omitempty"`
+}
+
+// QueryMarketStatsRequest is the request type for the Query/MarketStats RPC method
 type QueryMarketStatsRequest struct{}
-type QueryMarketStatsResponse struct{}
-type QueryListingRequest struct{ Id string }
-type QueryListingResponse struct{ Listing Listing }
-type QueryListingsRequest struct{}
-type QueryListingsResponse struct{ Listings []Listing }
-type QueryListingsByTypeRequest struct{ Type string }
-type QueryListingsByTypeResponse struct{ Listings []Listing }
-type QueryListingsByOwnerRequest struct{ Owner string }
-type QueryListingsByOwnerResponse struct{ Listings []Listing }
+
+// QueryMarketStatsResponse is the response type for the Query/MarketStats RPC method
+type QueryMarketStatsResponse struct {
+	Stats MarketStats `json:"stats"`
+}
+
+// QueryAuctionRequest is the request type for the Query/Auction RPC method
+type QueryAuctionRequest struct {
+	Id uint64 `json:"id"`
+}
+
+// QueryAuctionResponse is the response type for the Query/Auction RPC method.
+// CurrentPrice is the price a Dutch auction asks at the current block, or the
+// lowest acceptable next bid of an English auction.
+type QueryAuctionResponse struct {
+	Auction      Auction  `json:"auction"`
+	CurrentPrice sdk.Coin `json:"current_price"`
+}
+
+// QueryAuctionsRequest is the request type for the Query/Auctions RPC method
+type QueryAuctionsRequest struct {
+	// Denom, when set, keeps only the auctions priced in it
+	Denom      string             `json:"denom,omitempty"`
+	Pagination *query.PageRequest `json:"pagination,omitempty"`
+}
+
+// QueryAuctionsResponse is the response type for the Query/Auctions RPC method
+type QueryAuctionsResponse struct {
+	Auctions   []Auction           `json:"auctions"`
+	Pagination *query.PageResponse `json:"pagination,omitempty"`
+}
+
+// QueryParamsRequest is the request type for the Query/Params RPC method
+type QueryParamsRequest struct{}
+
+// QueryParamsResponse is the response type for the Query/Params RPC method
+type QueryParamsResponse struct {
+	Params Params `json:"params"`
+}
+
+// QueryOfferRequest is the request type for the Query/Offer RPC method
+type QueryOfferRequest struct {
+	Id uint64 `json:"id"`
+}
+
+// QueryOfferResponse is the response type for the Query/Offer RPC method
+type QueryOfferResponse struct {
+	Offer Offer `json:"offer"`
+}
+
+// QueryOffersByNFTRequest is the request type for the Query/OffersByNFT RPC method
+type QueryOffersByNFTRequest struct {
+	NftId      string             `json:"nft_id"`
+	Pagination *query.PageRequest `json:"pagination,omitempty"`
+}
+
+// QueryOffersByNFTResponse is the response type for the Query/OffersByNFT RPC method
+type QueryOffersByNFTResponse struct {
+	Offers     []Offer             `json:"offers"`
+	Pagination *query.PageResponse `json:"pagination,omitempty"`
+}
+
+// QueryOffersMadeRequest is the request type for the Query/OffersMade RPC method
+type QueryOffersMadeRequest struct {
+	Buyer      string             `json:"buyer"`
+	Pagination *query.PageRequest `json:"pagination,omitempty"`
+}
+
+// QueryOffersMadeResponse is the response type for the Query/OffersMade RPC method
+type QueryOffersMadeResponse struct {
+	Offers     []Offer             `json:"offers"`
+	Pagination *query.PageResponse `json:"pagination,omitempty"`
+}
+
+// QueryOffersReceivedRequest is the request type for the Query/OffersReceived RPC method
+type QueryOffersReceivedRequest struct {
+	Owner      string             `json:"owner"`
+	Pagination *query.PageRequest `json:"pagination,omitempty"`
+}
+
+// QueryOffersReceivedResponse is the response type for the Query/OffersReceived RPC method
+type QueryOffersReceivedResponse struct {
+	Offers     []Offer             `json:"offers"`
+	Pagination *query.PageResponse `json:"pagination,omitempty"`
+}
+
+// QueryCollectionBidsRequest is the request type for the Query/CollectionBids RPC method
+type QueryCollectionBidsRequest struct {
+	Pagination *query.PageRequest `json:"pagination,omitempty"`
+}
+
+// QueryCollectionBidsResponse is the response type for the Query/CollectionBids RPC method
+type QueryCollectionBidsResponse struct {
+	Offers     []Offer             `json:"offers"`
+	Pagination *query.PageResponse `json:"pagination,omitempty"`
+}
+
+// QuerySaleHistoryRequest is the request type for the Query/SaleHistory RPC method
+type QuerySaleHistoryRequest struct {
+	NftId      string             `json:"nft_id"`
+	Pagination *query.PageRequest `json:"pagination,omitempty"`
+}
+
+// QuerySaleHistoryResponse is the response type for the Query/SaleHistory RPC method
+type QuerySaleHistoryResponse struct {
+	Sales      []Sale              `json:"sales"`
+	Pagination *query.PageResponse `json:"pagination,omitempty"`
+}
+
+// QueryLastSaleRequest is the request type for the Query/LastSale RPC method
+type QueryLastSaleRequest struct {
+	NftId string `json:"nft_id"`
+}
+
+// QueryLastSaleResponse is the response type for the Query/LastSale RPC method
+type QueryLastSaleResponse struct {
+	Sale Sale `json:"sale"`
+}
+
+// QueryFloorPriceRequest is the request type for the Query/FloorPrice RPC method
+type QueryFloorPriceRequest struct {
+	Type  string `json:"type"`
+	Denom string `json:"denom,omitempty"`
+}
+
+// QueryFloorPriceResponse is the response type for the Query/FloorPrice RPC
+// method. Floor holds the lowest price in each denom and Listings the
+// listings offering it.
+type QueryFloorPriceResponse struct {
+	Floor    sdk.Coins `json:"floor"`
+	Listings []Listing `json:"listings"`
+}
+
+// QueryVolumeRequest is the request type for the Query/Volume RPC method
+type QueryVolumeRequest struct {
+	Type string `json:"type,omitempty"`
+}
+
+// QueryVolumeResponse is the response type for the Query/Volume RPC method
+type QueryVolumeResponse struct {
+	DayVolume  sdk.Coins `json:"day_volume"`
+	DaySales   uint64    `json:"day_sales"`
+	WeekVolume sdk.Coins `json:"week_volume"`
+	WeekSales  uint64    `json:"week_sales"`
+}
+
+func (m *QueryListingRequest) ProtoMessage()                    {}
+func (m *QueryListingRequest) Reset()                           { *m = QueryListingRequest{} }
+func (m *QueryListingRequest) String() string                   { return fmt.Sprintf("QueryListingRequest{%d}", m.Id) }
+func (m *QueryListingRequest) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryListingRequest) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryListingRequest) Size() int                        { return jsonSize(m) }
+func (m *QueryListingRequest) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryListingRequest) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryListingResponse) ProtoMessage() {}
+func (m *QueryListingResponse) Reset()        { *m = QueryListingResponse{} }
+func (m *QueryListingResponse) String() string {
+	return "QueryListingResponse{" + m.Listing.String() + "}"
+}
+func (m *QueryListingResponse) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryListingResponse) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryListingResponse) Size() int                        { return jsonSize(m) }
+func (m *QueryListingResponse) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryListingResponse) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryListingsRequest) ProtoMessage()                    {}
+func (m *QueryListingsRequest) Reset()                           { *m = QueryListingsRequest{} }
+func (m *QueryListingsRequest) String() string                   { return "QueryListingsRequest{}" }
+func (m *QueryListingsRequest) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryListingsRequest) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryListingsRequest) Size() int                        { return jsonSize(m) }
+func (m *QueryListingsRequest) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryListingsRequest) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryListingsResponse) ProtoMessage()                    {}
+func (m *QueryListingsResponse) Reset()                           { *m = QueryListingsResponse{} }
+func (m *QueryListingsResponse) String() string                   { return "QueryListingsResponse{}" }
+func (m *QueryListingsResponse) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryListingsResponse) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryListingsResponse) Size() int                        { return jsonSize(m) }
+func (m *QueryListingsResponse) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryListingsResponse) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryListingsByTypeResponse) ProtoMessage()                    {}
+func (m *QueryListingsByTypeResponse) Reset()                           { *m = QueryListingsByTypeResponse{} }
+func (m *QueryListingsByTypeResponse) String() string                   { return "QueryListingsByTypeResponse{}" }
+func (m *QueryListingsByTypeResponse) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryListingsByTypeResponse) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryListingsByTypeResponse) Size() int                        { return jsonSize(m) }
+func (m *QueryListingsByTypeResponse) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryListingsByTypeResponse) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryListingsByOwnerResponse) ProtoMessage()                    {}
+func (m *QueryListingsByOwnerResponse) Reset()                           { *m = QueryListingsByOwnerResponse{} }
+func (m *QueryListingsByOwnerResponse) String() string                   { return "QueryListingsByOwnerResponse{}" }
+func (m *QueryListingsByOwnerResponse) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryListingsByOwnerResponse) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryListingsByOwnerResponse) Size() int                        { return jsonSize(m) }
+func (m *QueryListingsByOwnerResponse) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryListingsByOwnerResponse) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryMarketStatsRequest) ProtoMessage()                    {}
+func (m *QueryMarketStatsRequest) Reset()                           { *m = QueryMarketStatsRequest{} }
+func (m *QueryMarketStatsRequest) String() string                   { return "QueryMarketStatsRequest{}" }
+func (m *QueryMarketStatsRequest) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryMarketStatsRequest) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryMarketStatsRequest) Size() int                        { return jsonSize(m) }
+func (m *QueryMarketStatsRequest) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryMarketStatsRequest) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryListingsByTypeRequest) ProtoMessage() {}
+func (m *QueryListingsByTypeRequest) Reset()        { *m = QueryListingsByTypeRequest{} }
+func (m *QueryListingsByTypeRequest) String() string {
+	return "QueryListingsByTypeRequest{" + m.Type + "}"
+}
+func (m *QueryListingsByTypeRequest) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryListingsByTypeRequest) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryListingsByTypeRequest) Size() int                        { return jsonSize(m) }
+func (m *QueryListingsByTypeRequest) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryListingsByTypeRequest) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryListingsByOwnerRequest) ProtoMessage() {}
+func (m *QueryListingsByOwnerRequest) Reset()        { *m = QueryListingsByOwnerRequest{} }
+func (m *QueryListingsByOwnerRequest) String() string {
+	return "QueryListingsByOwnerRequest{" + m.Owner + "}"
+}
+func (m *QueryListingsByOwnerRequest) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryListingsByOwnerRequest) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryListingsByOwnerRequest) Size() int                        { return jsonSize(m) }
+func (m *QueryListingsByOwnerRequest) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryListingsByOwnerRequest) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryMarketStatsResponse) ProtoMessage() {}
+func (m *QueryMarketStatsResponse) Reset()        { *m = QueryMarketStatsResponse{} }
+func (m *QueryMarketStatsResponse) String() string {
+	return "QueryMarketStatsResponse{" + m.Stats.String() + "}"
+}
+func (m *QueryMarketStatsResponse) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryMarketStatsResponse) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryMarketStatsResponse) Size() int                        { return jsonSize(m) }
+func (m *QueryMarketStatsResponse) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryMarketStatsResponse) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryAuctionRequest) ProtoMessage()                    {}
+func (m *QueryAuctionRequest) Reset()                           { *m = QueryAuctionRequest{} }
+func (m *QueryAuctionRequest) String() string                   { return fmt.Sprintf("QueryAuctionRequest{%d}", m.Id) }
+func (m *QueryAuctionRequest) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryAuctionRequest) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryAuctionRequest) Size() int                        { return jsonSize(m) }
+func (m *QueryAuctionRequest) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryAuctionRequest) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryAuctionResponse) ProtoMessage() {}
+func (m *QueryAuctionResponse) Reset()        { *m = QueryAuctionResponse{} }
+func (m *QueryAuctionResponse) String() string {
+	return "QueryAuctionResponse{" + m.Auction.String() + "}"
+}
+func (m *QueryAuctionResponse) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryAuctionResponse) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryAuctionResponse) Size() int                        { return jsonSize(m) }
+func (m *QueryAuctionResponse) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryAuctionResponse) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryAuctionsRequest) ProtoMessage()                    {}
+func (m *QueryAuctionsRequest) Reset()                           { *m = QueryAuctionsRequest{} }
+func (m *QueryAuctionsRequest) String() string                   { return "QueryAuctionsRequest{}" }
+func (m *QueryAuctionsRequest) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryAuctionsRequest) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryAuctionsRequest) Size() int                        { return jsonSize(m) }
+func (m *QueryAuctionsRequest) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryAuctionsRequest) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryAuctionsResponse) ProtoMessage()                    {}
+func (m *QueryAuctionsResponse) Reset()                           { *m = QueryAuctionsResponse{} }
+func (m *QueryAuctionsResponse) String() string                   { return "QueryAuctionsResponse{}" }
+func (m *QueryAuctionsResponse) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryAuctionsResponse) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryAuctionsResponse) Size() int                        { return jsonSize(m) }
+func (m *QueryAuctionsResponse) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryAuctionsResponse) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryParamsRequest) ProtoMessage()                    {}
+func (m *QueryParamsRequest) Reset()                           { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string                   { return "QueryParamsRequest{}" }
+func (m *QueryParamsRequest) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryParamsRequest) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryParamsRequest) Size() int                        { return jsonSize(m) }
+func (m *QueryParamsRequest) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryParamsRequest) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryParamsResponse) ProtoMessage() {}
+func (m *QueryParamsResponse) Reset()        { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string {
+	return "QueryParamsResponse{" + m.Params.String() + "}"
+}
+func (m *QueryParamsResponse) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryParamsResponse) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryParamsResponse) Size() int                        { return jsonSize(m) }
+func (m *QueryParamsResponse) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryParamsResponse) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryOfferRequest) ProtoMessage()                    {}
+func (m *QueryOfferRequest) Reset()                           { *m = QueryOfferRequest{} }
+func (m *QueryOfferRequest) String() string                   { return fmt.Sprintf("QueryOfferRequest{%d}", m.Id) }
+func (m *QueryOfferRequest) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryOfferRequest) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryOfferRequest) Size() int                        { return jsonSize(m) }
+func (m *QueryOfferRequest) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryOfferRequest) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryOfferResponse) ProtoMessage()                    {}
+func (m *QueryOfferResponse) Reset()                           { *m = QueryOfferResponse{} }
+func (m *QueryOfferResponse) String() string                   { return "QueryOfferResponse{" + m.Offer.String() + "}" }
+func (m *QueryOfferResponse) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryOfferResponse) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryOfferResponse) Size() int                        { return jsonSize(m) }
+func (m *QueryOfferResponse) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryOfferResponse) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryOffersByNFTRequest) ProtoMessage()                    {}
+func (m *QueryOffersByNFTRequest) Reset()                           { *m = QueryOffersByNFTRequest{} }
+func (m *QueryOffersByNFTRequest) String() string                   { return "QueryOffersByNFTRequest{" + m.NftId + "}" }
+func (m *QueryOffersByNFTRequest) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryOffersByNFTRequest) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryOffersByNFTRequest) Size() int                        { return jsonSize(m) }
+func (m *QueryOffersByNFTRequest) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryOffersByNFTRequest) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryOffersByNFTResponse) ProtoMessage()                    {}
+func (m *QueryOffersByNFTResponse) Reset()                           { *m = QueryOffersByNFTResponse{} }
+func (m *QueryOffersByNFTResponse) String() string                   { return "QueryOffersByNFTResponse{}" }
+func (m *QueryOffersByNFTResponse) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryOffersByNFTResponse) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryOffersByNFTResponse) Size() int                        { return jsonSize(m) }
+func (m *QueryOffersByNFTResponse) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryOffersByNFTResponse) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryOffersMadeRequest) ProtoMessage()                    {}
+func (m *QueryOffersMadeRequest) Reset()                           { *m = QueryOffersMadeRequest{} }
+func (m *QueryOffersMadeRequest) String() string                   { return "QueryOffersMadeRequest{" + m.Buyer + "}" }
+func (m *QueryOffersMadeRequest) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryOffersMadeRequest) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryOffersMadeRequest) Size() int                        { return jsonSize(m) }
+func (m *QueryOffersMadeRequest) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryOffersMadeRequest) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryOffersMadeResponse) ProtoMessage()                    {}
+func (m *QueryOffersMadeResponse) Reset()                           { *m = QueryOffersMadeResponse{} }
+func (m *QueryOffersMadeResponse) String() string                   { return "QueryOffersMadeResponse{}" }
+func (m *QueryOffersMadeResponse) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryOffersMadeResponse) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryOffersMadeResponse) Size() int                        { return jsonSize(m) }
+func (m *QueryOffersMadeResponse) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryOffersMadeResponse) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryOffersReceivedRequest) ProtoMessage() {}
+func (m *QueryOffersReceivedRequest) Reset()        { *m = QueryOffersReceivedRequest{} }
+func (m *QueryOffersReceivedRequest) String() string {
+	return "QueryOffersReceivedRequest{" + m.Owner + "}"
+}
+func (m *QueryOffersReceivedRequest) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryOffersReceivedRequest) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryOffersReceivedRequest) Size() int                        { return jsonSize(m) }
+func (m *QueryOffersReceivedRequest) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryOffersReceivedRequest) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryOffersReceivedResponse) ProtoMessage()                    {}
+func (m *QueryOffersReceivedResponse) Reset()                           { *m = QueryOffersReceivedResponse{} }
+func (m *QueryOffersReceivedResponse) String() string                   { return "QueryOffersReceivedResponse{}" }
+func (m *QueryOffersReceivedResponse) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryOffersReceivedResponse) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryOffersReceivedResponse) Size() int                        { return jsonSize(m) }
+func (m *QueryOffersReceivedResponse) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryOffersReceivedResponse) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryCollectionBidsRequest) ProtoMessage()                    {}
+func (m *QueryCollectionBidsRequest) Reset()                           { *m = QueryCollectionBidsRequest{} }
+func (m *QueryCollectionBidsRequest) String() string                   { return "QueryCollectionBidsRequest{}" }
+func (m *QueryCollectionBidsRequest) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryCollectionBidsRequest) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryCollectionBidsRequest) Size() int                        { return jsonSize(m) }
+func (m *QueryCollectionBidsRequest) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryCollectionBidsRequest) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryCollectionBidsResponse) ProtoMessage()                    {}
+func (m *QueryCollectionBidsResponse) Reset()                           { *m = QueryCollectionBidsResponse{} }
+func (m *QueryCollectionBidsResponse) String() string                   { return "QueryCollectionBidsResponse{}" }
+func (m *QueryCollectionBidsResponse) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryCollectionBidsResponse) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryCollectionBidsResponse) Size() int                        { return jsonSize(m) }
+func (m *QueryCollectionBidsResponse) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryCollectionBidsResponse) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QuerySaleHistoryRequest) ProtoMessage()                    {}
+func (m *QuerySaleHistoryRequest) Reset()                           { *m = QuerySaleHistoryRequest{} }
+func (m *QuerySaleHistoryRequest) String() string                   { return "QuerySaleHistoryRequest{" + m.NftId + "}" }
+func (m *QuerySaleHistoryRequest) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QuerySaleHistoryRequest) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QuerySaleHistoryRequest) Size() int                        { return jsonSize(m) }
+func (m *QuerySaleHistoryRequest) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QuerySaleHistoryRequest) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QuerySaleHistoryResponse) ProtoMessage()                    {}
+func (m *QuerySaleHistoryResponse) Reset()                           { *m = QuerySaleHistoryResponse{} }
+func (m *QuerySaleHistoryResponse) String() string                   { return "QuerySaleHistoryResponse{}" }
+func (m *QuerySaleHistoryResponse) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QuerySaleHistoryResponse) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QuerySaleHistoryResponse) Size() int                        { return jsonSize(m) }
+func (m *QuerySaleHistoryResponse) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QuerySaleHistoryResponse) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryLastSaleRequest) ProtoMessage()                    {}
+func (m *QueryLastSaleRequest) Reset()                           { *m = QueryLastSaleRequest{} }
+func (m *QueryLastSaleRequest) String() string                   { return "QueryLastSaleRequest{" + m.NftId + "}" }
+func (m *QueryLastSaleRequest) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryLastSaleRequest) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryLastSaleRequest) Size() int                        { return jsonSize(m) }
+func (m *QueryLastSaleRequest) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryLastSaleRequest) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryLastSaleResponse) ProtoMessage() {}
+func (m *QueryLastSaleResponse) Reset()        { *m = QueryLastSaleResponse{} }
+func (m *QueryLastSaleResponse) String() string {
+	return "QueryLastSaleResponse{" + m.Sale.String() + "}"
+}
+func (m *QueryLastSaleResponse) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryLastSaleResponse) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryLastSaleResponse) Size() int                        { return jsonSize(m) }
+func (m *QueryLastSaleResponse) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryLastSaleResponse) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryFloorPriceRequest) ProtoMessage() {}
+func (m *QueryFloorPriceRequest) Reset()        { *m = QueryFloorPriceRequest{} }
+func (m *QueryFloorPriceRequest) String() string {
+	return "QueryFloorPriceRequest{" + m.Type + ", " + m.Denom + "}"
+}
+func (m *QueryFloorPriceRequest) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryFloorPriceRequest) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryFloorPriceRequest) Size() int                        { return jsonSize(m) }
+func (m *QueryFloorPriceRequest) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryFloorPriceRequest) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryFloorPriceResponse) ProtoMessage() {}
+func (m *QueryFloorPriceResponse) Reset()        { *m = QueryFloorPriceResponse{} }
+func (m *QueryFloorPriceResponse) String() string {
+	return "QueryFloorPriceResponse{" + m.Floor.String() + "}"
+}
+func (m *QueryFloorPriceResponse) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryFloorPriceResponse) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryFloorPriceResponse) Size() int                        { return jsonSize(m) }
+func (m *QueryFloorPriceResponse) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryFloorPriceResponse) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryVolumeRequest) ProtoMessage()                    {}
+func (m *QueryVolumeRequest) Reset()                           { *m = QueryVolumeRequest{} }
+func (m *QueryVolumeRequest) String() string                   { return "QueryVolumeRequest{" + m.Type + "}" }
+func (m *QueryVolumeRequest) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryVolumeRequest) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryVolumeRequest) Size() int                        { return jsonSize(m) }
+func (m *QueryVolumeRequest) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryVolumeRequest) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryVolumeResponse) ProtoMessage() {}
+func (m *QueryVolumeResponse) Reset()        { *m = QueryVolumeResponse{} }
+func (m *QueryVolumeResponse) String() string {
+	return fmt.Sprintf("QueryVolumeResponse{%s, %s}", m.DayVolume, m.WeekVolume)
+}
+func (m *QueryVolumeResponse) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryVolumeResponse) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryVolumeResponse) Size() int                        { return jsonSize(m) }
+func (m *QueryVolumeResponse) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryVolumeResponse) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
@@ -0,0 +1,663 @@
+package types
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	nfttypes "skaffacity/x/nft/types"
+)
+
+// QueryServer defines the gRPC querier service, see skaffacity/marketplace/v1/query.proto.
+type QueryServer interface {
+	// Listing returns a single listing, active or not.
+	Listing(context.Context, *QueryListingRequest) (*QueryListingResponse, error)
+	// Listings returns a page of the active listings.
+	Listings(context.Context, *QueryListingsRequest) (*QueryListingsResponse, error)
+	// ListingsByType returns a page of the active listings of an NFT type.
+	ListingsByType(context.Context, *QueryListingsByTypeRequest) (*QueryListingsByTypeResponse, error)
+	// ListingsByOwner returns a page of the listings, active or not, created by an account.
+	ListingsByOwner(context.Context, *QueryListingsByOwnerRequest) (*QueryListingsByOwnerResponse, error)
+	// MarketStats returns the marketplace statistics.
+	MarketStats(context.Context, *QueryMarketStatsRequest) (*QueryMarketStatsResponse, error)
+	// Auction returns a single auction, active or not, with its current price.
+	Auction(context.Context, *QueryAuctionRequest) (*QueryAuctionResponse, error)
+	// Auctions returns a page of the active auctions, ending soonest first.
+	Auctions(context.Context, *QueryAuctionsRequest) (*QueryAuctionsResponse, error)
+	// Params returns the marketplace module params.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Offer returns a single offer or collection bid, active or not.
+	Offer(context.Context, *QueryOfferRequest) (*QueryOfferResponse, error)
+	// OffersByNFT returns a page of the active offers on an NFT.
+	OffersByNFT(context.Context, *QueryOffersByNFTRequest) (*QueryOffersByNFTResponse, error)
+	// OffersMade returns a page of the offers and collection bids, active or not, made by an account.
+	OffersMade(context.Context, *QueryOffersMadeRequest) (*QueryOffersMadeResponse, error)
+	// OffersReceived returns a page of the active offers on NFTs an account owns, and of the collection bids they match.
+	OffersReceived(context.Context, *QueryOffersReceivedRequest) (*QueryOffersReceivedResponse, error)
+	// CollectionBids returns a page of the active collection bids, expiring soonest first.
+	CollectionBids(context.Context, *QueryCollectionBidsRequest) (*QueryCollectionBidsResponse, error)
+	// SaleHistory returns a page of the sales of an NFT, oldest first.
+	SaleHistory(context.Context, *QuerySaleHistoryRequest) (*QuerySaleHistoryResponse, error)
+	// LastSale returns the most recent sale of an NFT.
+	LastSale(context.Context, *QueryLastSaleRequest) (*QueryLastSaleResponse, error)
+	// FloorPrice returns the lowest price an NFT type is listed at.
+	FloorPrice(context.Context, *QueryFloorPriceRequest) (*QueryFloorPriceResponse, error)
+	// Volume returns the trading volume of the last 24 hours and 7 days.
+	Volume(context.Context, *QueryVolumeRequest) (*QueryVolumeResponse, error)
+}
+
+// QueryClient defines the gRPC querier client.
+type QueryClient interface {
+	Listing(ctx context.Context, in *QueryListingRequest, opts ...grpc.CallOption) (*QueryListingResponse, error)
+	Listings(ctx context.Context, in *QueryListingsRequest, opts ...grpc.CallOption) (*QueryListingsResponse, error)
+	ListingsByType(ctx context.Context, in *QueryListingsByTypeRequest, opts ...grpc.CallOption) (*QueryListingsByTypeResponse, error)
+	ListingsByOwner(ctx context.Context, in *QueryListingsByOwnerRequest, opts ...grpc.CallOption) (*QueryListingsByOwnerResponse, error)
+	MarketStats(ctx context.Context, in *QueryMarketStatsRequest, opts ...grpc.CallOption) (*QueryMarketStatsResponse, error)
+	Auction(ctx context.Context, in *QueryAuctionRequest, opts ...grpc.CallOption) (*QueryAuctionResponse, error)
+	Auctions(ctx context.Context, in *QueryAuctionsRequest, opts ...grpc.CallOption) (*QueryAuctionsResponse, error)
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	Offer(ctx context.Context, in *QueryOfferRequest, opts ...grpc.CallOption) (*QueryOfferResponse, error)
+	OffersByNFT(ctx context.Context, in *QueryOffersByNFTRequest, opts ...grpc.CallOption) (*QueryOffersByNFTResponse, error)
+	OffersMade(ctx context.Context, in *QueryOffersMadeRequest, opts ...grpc.CallOption) (*QueryOffersMadeResponse, error)
+	OffersReceived(ctx context.Context, in *QueryOffersReceivedRequest, opts ...grpc.CallOption) (*QueryOffersReceivedResponse, error)
+	CollectionBids(ctx context.Context, in *QueryCollectionBidsRequest, opts ...grpc.CallOption) (*QueryCollectionBidsResponse, error)
+	SaleHistory(ctx context.Context, in *QuerySaleHistoryRequest, opts ...grpc.CallOption) (*QuerySaleHistoryResponse, error)
+	LastSale(ctx context.Context, in *QueryLastSaleRequest, opts ...grpc.CallOption) (*QueryLastSaleResponse, error)
+	FloorPrice(ctx context.Context, in *QueryFloorPriceRequest, opts ...grpc.CallOption) (*QueryFloorPriceResponse, error)
+	Volume(ctx context.Context, in *QueryVolumeRequest, opts ...grpc.CallOption) (*QueryVolumeResponse, error)
+}
+
+const queryServiceName = "skaffacity.marketplace.v1.Query"
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+// NewQueryClient creates a new QueryClient.
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Listing(ctx context.Context, in *QueryListingRequest, opts ...grpc.CallOption) (*QueryListingResponse, error) {
+	out := new(QueryListingResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/Listing", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Listings(ctx context.Context, in *QueryListingsRequest, opts ...grpc.CallOption) (*QueryListingsResponse, error) {
+	out := new(QueryListingsResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/Listings", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListingsByType(ctx context.Context, in *QueryListingsByTypeRequest, opts ...grpc.CallOption) (*QueryListingsByTypeResponse, error) {
+	out := new(QueryListingsByTypeResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/ListingsByType", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListingsByOwner(ctx context.Context, in *QueryListingsByOwnerRequest, opts ...grpc.CallOption) (*QueryListingsByOwnerResponse, error) {
+	out := new(QueryListingsByOwnerResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/ListingsByOwner", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MarketStats(ctx context.Context, in *QueryMarketStatsRequest, opts ...grpc.CallOption) (*QueryMarketStatsResponse, error) {
+	out := new(QueryMarketStatsResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/MarketStats", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Auction(ctx context.Context, in *QueryAuctionRequest, opts ...grpc.CallOption) (*QueryAuctionResponse, error) {
+	out := new(QueryAuctionResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/Auction", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Auctions(ctx context.Context, in *QueryAuctionsRequest, opts ...grpc.CallOption) (*QueryAuctionsResponse, error) {
+	out := new(QueryAuctionsResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/Auctions", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/Params", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Offer(ctx context.Context, in *QueryOfferRequest, opts ...grpc.CallOption) (*QueryOfferResponse, error) {
+	out := new(QueryOfferResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/Offer", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OffersByNFT(ctx context.Context, in *QueryOffersByNFTRequest, opts ...grpc.CallOption) (*QueryOffersByNFTResponse, error) {
+	out := new(QueryOffersByNFTResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/OffersByNFT", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OffersMade(ctx context.Context, in *QueryOffersMadeRequest, opts ...grpc.CallOption) (*QueryOffersMadeResponse, error) {
+	out := new(QueryOffersMadeResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/OffersMade", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OffersReceived(ctx context.Context, in *QueryOffersReceivedRequest, opts ...grpc.CallOption) (*QueryOffersReceivedResponse, error) {
+	out := new(QueryOffersReceivedResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/OffersReceived", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CollectionBids(ctx context.Context, in *QueryCollectionBidsRequest, opts ...grpc.CallOption) (*QueryCollectionBidsResponse, error) {
+	out := new(QueryCollectionBidsResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/CollectionBids", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SaleHistory(ctx context.Context, in *QuerySaleHistoryRequest, opts ...grpc.CallOption) (*QuerySaleHistoryResponse, error) {
+	out := new(QuerySaleHistoryResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/SaleHistory", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LastSale(ctx context.Context, in *QueryLastSaleRequest, opts ...grpc.CallOption) (*QueryLastSaleResponse, error) {
+	out := new(QueryLastSaleResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/LastSale", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FloorPrice(ctx context.Context, in *QueryFloorPriceRequest, opts ...grpc.CallOption) (*QueryFloorPriceResponse, error) {
+	out := new(QueryFloorPriceResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/FloorPrice", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Volume(ctx context.Context, in *QueryVolumeRequest, opts ...grpc.CallOption) (*QueryVolumeResponse, error) {
+	out := new(QueryVolumeResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/Volume", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RegisterQueryServer registers a service implementation with the server.
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Listing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Listing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + queryServiceName + "/Listing"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Listing(ctx, req.(*QueryListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Listings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Listings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + queryServiceName + "/Listings"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Listings(ctx, req.(*QueryListingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListingsByType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListingsByTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListingsByType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + queryServiceName + "/ListingsByType"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListingsByType(ctx, req.(*QueryListingsByTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListingsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListingsByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListingsByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + queryServiceName + "/ListingsByOwner"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListingsByOwner(ctx, req.(*QueryListingsByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMarketStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + queryServiceName + "/MarketStats"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketStats(ctx, req.(*QueryMarketStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Auction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Auction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + queryServiceName + "/Auction"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Auction(ctx, req.(*QueryAuctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Auctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Auctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + queryServiceName + "/Auctions"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Auctions(ctx, req.(*QueryAuctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + queryServiceName + "/Params"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Offer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Offer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + queryServiceName + "/Offer"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Offer(ctx, req.(*QueryOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OffersByNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOffersByNFTRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OffersByNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + queryServiceName + "/OffersByNFT"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OffersByNFT(ctx, req.(*QueryOffersByNFTRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OffersMade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOffersMadeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OffersMade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + queryServiceName + "/OffersMade"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OffersMade(ctx, req.(*QueryOffersMadeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OffersReceived_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOffersReceivedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OffersReceived(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + queryServiceName + "/OffersReceived"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OffersReceived(ctx, req.(*QueryOffersReceivedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CollectionBids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCollectionBidsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CollectionBids(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + queryServiceName + "/CollectionBids"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CollectionBids(ctx, req.(*QueryCollectionBidsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SaleHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySaleHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SaleHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + queryServiceName + "/SaleHistory"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SaleHistory(ctx, req.(*QuerySaleHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LastSale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLastSaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LastSale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + queryServiceName + "/LastSale"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastSale(ctx, req.(*QueryLastSaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FloorPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFloorPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FloorPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + queryServiceName + "/FloorPrice"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FloorPrice(ctx, req.(*QueryFloorPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Volume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Volume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + queryServiceName + "/Volume"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Volume(ctx, req.(*QueryVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: queryServiceName,
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "Listing", Handler: _Query_Listing_Handler},
+		{MethodName: "Listings", Handler: _Query_Listings_Handler},
+		{MethodName: "ListingsByType", Handler: _Query_ListingsByType_Handler},
+		{MethodName: "ListingsByOwner", Handler: _Query_ListingsByOwner_Handler},
+		{MethodName: "MarketStats", Handler: _Query_MarketStats_Handler},
+		{MethodName: "Auction", Handler: _Query_Auction_Handler},
+		{MethodName: "Auctions", Handler: _Query_Auctions_Handler},
+		{MethodName: "Params", Handler: _Query_Params_Handler},
+		{MethodName: "Offer", Handler: _Query_Offer_Handler},
+		{MethodName: "OffersByNFT", Handler: _Query_OffersByNFT_Handler},
+		{MethodName: "OffersMade", Handler: _Query_OffersMade_Handler},
+		{MethodName: "OffersReceived", Handler: _Query_OffersReceived_Handler},
+		{MethodName: "CollectionBids", Handler: _Query_CollectionBids_Handler},
+		{MethodName: "SaleHistory", Handler: _Query_SaleHistory_Handler},
+		{MethodName: "LastSale", Handler: _Query_LastSale_Handler},
+		{MethodName: "FloorPrice", Handler: _Query_FloorPrice_Handler},
+		{MethodName: "Volume", Handler: _Query_Volume_Handler},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "skaffacity/marketplace/v1/query.proto",
+}
+
+var (
+	pattern_Query_Listing_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"skaffacity", "marketplace", "v1", "listings", "id"}, "", runtime.AssumeColonVerbOpt(false)))
+
+	pattern_Query_Listings_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"skaffacity", "marketplace", "v1", "listings"}, "", runtime.AssumeColonVerbOpt(false)))
+
+	pattern_Query_ListingsByType_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 1, 0, 4, 1, 5, 4}, []string{"skaffacity", "marketplace", "v1", "listings", "type"}, "", runtime.AssumeColonVerbOpt(false)))
+
+	pattern_Query_ListingsByOwner_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 1, 0, 4, 1, 5, 4}, []string{"skaffacity", "marketplace", "v1", "listings", "owner"}, "", runtime.AssumeColonVerbOpt(false)))
+
+	pattern_Query_MarketStats_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"skaffacity", "marketplace", "v1", "stats"}, "", runtime.AssumeColonVerbOpt(false)))
+
+	pattern_Query_Auction_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"skaffacity", "marketplace", "v1", "auctions", "id"}, "", runtime.AssumeColonVerbOpt(false)))
+
+	pattern_Query_Auctions_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"skaffacity", "marketplace", "v1", "auctions"}, "", runtime.AssumeColonVerbOpt(false)))
+
+	pattern_Query_Params_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"skaffacity", "marketplace", "v1", "params"}, "", runtime.AssumeColonVerbOpt(false)))
+
+	pattern_Query_Offer_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"skaffacity", "marketplace", "v1", "offers", "id"}, "", runtime.AssumeColonVerbOpt(false)))
+
+	pattern_Query_OffersByNFT_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"skaffacity", "marketplace", "v1", "nfts", "nft_id", "offers"}, "", runtime.AssumeColonVerbOpt(false)))
+
+	pattern_Query_OffersMade_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 1, 0, 4, 1, 5, 5}, []string{"skaffacity", "marketplace", "v1", "offers", "made", "buyer"}, "", runtime.AssumeColonVerbOpt(false)))
+
+	pattern_Query_OffersReceived_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 1, 0, 4, 1, 5, 5}, []string{"skaffacity", "marketplace", "v1", "offers", "received", "owner"}, "", runtime.AssumeColonVerbOpt(false)))
+
+	pattern_Query_CollectionBids_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"skaffacity", "marketplace", "v1", "collection_bids"}, "", runtime.AssumeColonVerbOpt(false)))
+
+	pattern_Query_SaleHistory_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"skaffacity", "marketplace", "v1", "nfts", "nft_id", "sales"}, "", runtime.AssumeColonVerbOpt(false)))
+
+	pattern_Query_LastSale_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"skaffacity", "marketplace", "v1", "nfts", "nft_id", "last_sale"}, "", runtime.AssumeColonVerbOpt(false)))
+
+	pattern_Query_FloorPrice_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"skaffacity", "marketplace", "v1", "floor", "type"}, "", runtime.AssumeColonVerbOpt(false)))
+
+	pattern_Query_Volume_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"skaffacity", "marketplace", "v1", "volume"}, "", runtime.AssumeColonVerbOpt(false)))
+)
+
+// RegisterQueryHandlerClient registers the query REST routes on mux.
+func RegisterQueryHandlerClient(ctx context.Context, mux *runtime.ServeMux, client QueryClient) error {
+	nfttypes.HandleGateway(mux, pattern_Query_Listing_0, func(ctx context.Context, req *http.Request, pathParams map[string]string) (interface{}, error) {
+		id, err := strconv.ParseUint(pathParams["id"], 10, 64)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid id: %s", err)
+		}
+		return client.Listing(ctx, &QueryListingRequest{Id: id})
+	})
+
+	nfttypes.HandleGateway(mux, pattern_Query_Listings_0, func(ctx context.Context, req *http.Request, pathParams map[string]string) (interface{}, error) {
+		pageReq, err := nfttypes.PageRequestFromQuery(req.URL.Query())
+		if err != nil {
+			return nil, err
+		}
+		return client.Listings(ctx, &QueryListingsRequest{Denom: req.URL.Query().Get("denom"), Pagination: pageReq})
+	})
+
+	nfttypes.HandleGateway(mux, pattern_Query_ListingsByType_0, func(ctx context.Context, req *http.Request, pathParams map[string]string) (interface{}, error) {
+		pageReq, err := nfttypes.PageRequestFromQuery(req.URL.Query())
+		if err != nil {
+			return nil, err
+		}
+		return client.ListingsByType(ctx, &QueryListingsByTypeRequest{Type: pathParams["type"], Denom: req.URL.Query().Get("denom"), Pagination: pageReq})
+	})
+
+	nfttypes.HandleGateway(mux, pattern_Query_ListingsByOwner_0, func(ctx context.Context, req *http.Request, pathParams map[string]string) (interface{}, error) {
+		pageReq, err := nfttypes.PageRequestFromQuery(req.URL.Query())
+		if err != nil {
+			return nil, err
+		}
+		return client.ListingsByOwner(ctx, &QueryListingsByOwnerRequest{Owner: pathParams["owner"], Denom: req.URL.Query().Get("denom"), Pagination: pageReq})
+	})
+
+	nfttypes.HandleGateway(mux, pattern_Query_MarketStats_0, func(ctx context.Context, req *http.Request, pathParams map[string]string) (interface{}, error) {
+		return client.MarketStats(ctx, &QueryMarketStatsRequest{})
+	})
+
+	nfttypes.HandleGateway(mux, pattern_Query_Auction_0, func(ctx context.Context, req *http.Request, pathParams map[string]string) (interface{}, error) {
+		id, err := strconv.ParseUint(pathParams["id"], 10, 64)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid id: %s", err)
+		}
+		return client.Auction(ctx, &QueryAuctionRequest{Id: id})
+	})
+
+	nfttypes.HandleGateway(mux, pattern_Query_Auctions_0, func(ctx context.Context, req *http.Request, pathParams map[string]string) (interface{}, error) {
+		pageReq, err := nfttypes.PageRequestFromQuery(req.URL.Query())
+		if err != nil {
+			return nil, err
+		}
+		return client.Auctions(ctx, &QueryAuctionsRequest{Denom: req.URL.Query().Get("denom"), Pagination: pageReq})
+	})
+
+	nfttypes.HandleGateway(mux, pattern_Query_Params_0, func(ctx context.Context, req *http.Request, pathParams map[string]string) (interface{}, error) {
+		return client.Params(ctx, &QueryParamsRequest{})
+	})
+
+	nfttypes.HandleGateway(mux, pattern_Query_Offer_0, func(ctx context.Context, req *http.Request, pathParams map[string]string) (interface{}, error) {
+		id, err := strconv.ParseUint(pathParams["id"], 10, 64)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid id: %s", err)
+		}
+		return client.Offer(ctx, &QueryOfferRequest{Id: id})
+	})
+
+	nfttypes.HandleGateway(mux, pattern_Query_OffersByNFT_0, func(ctx context.Context, req *http.Request, pathParams map[string]string) (interface{}, error) {
+		pageReq, err := nfttypes.PageRequestFromQuery(req.URL.Query())
+		if err != nil {
+			return nil, err
+		}
+		return client.OffersByNFT(ctx, &QueryOffersByNFTRequest{NftId: pathParams["nft_id"], Pagination: pageReq})
+	})
+
+	nfttypes.HandleGateway(mux, pattern_Query_OffersMade_0, func(ctx context.Context, req *http.Request, pathParams map[string]string) (interface{}, error) {
+		pageReq, err := nfttypes.PageRequestFromQuery(req.URL.Query())
+		if err != nil {
+			return nil, err
+		}
+		return client.OffersMade(ctx, &QueryOffersMadeRequest{Buyer: pathParams["buyer"], Pagination: pageReq})
+	})
+
+	nfttypes.HandleGateway(mux, pattern_Query_OffersReceived_0, func(ctx context.Context, req *http.Request, pathParams map[string]string) (interface{}, error) {
+		pageReq, err := nfttypes.PageRequestFromQuery(req.URL.Query())
+		if err != nil {
+			return nil, err
+		}
+		return client.OffersReceived(ctx, &QueryOffersReceivedRequest{Owner: pathParams["owner"], Pagination: pageReq})
+	})
+
+	nfttypes.HandleGateway(mux, pattern_Query_CollectionBids_0, func(ctx context.Context, req *http.Request, pathParams map[string]string) (interface{}, error) {
+		pageReq, err := nfttypes.PageRequestFromQuery(req.URL.Query())
+		if err != nil {
+			return nil, err
+		}
+		return client.CollectionBids(ctx, &QueryCollectionBidsRequest{Pagination: pageReq})
+	})
+
+	nfttypes.HandleGateway(mux, pattern_Query_SaleHistory_0, func(ctx context.Context, req *http.Request, pathParams map[string]string) (interface{}, error) {
+		pageReq, err := nfttypes.PageRequestFromQuery(req.URL.Query())
+		if err != nil {
+			return nil, err
+		}
+		return client.SaleHistory(ctx, &QuerySaleHistoryRequest{NftId: pathParams["nft_id"], Pagination: pageReq})
+	})
+
+	nfttypes.HandleGateway(mux, pattern_Query_LastSale_0, func(ctx context.Context, req *http.Request, pathParams map[string]string) (interface{}, error) {
+		return client.LastSale(ctx, &QueryLastSaleRequest{NftId: pathParams["nft_id"]})
+	})
+
+	nfttypes.HandleGateway(mux, pattern_Query_FloorPrice_0, func(ctx context.Context, req *http.Request, pathParams map[string]string) (interface{}, error) {
+		return client.FloorPrice(ctx, &QueryFloorPriceRequest{Type: pathParams["type"], Denom: req.URL.Query().Get("denom")})
+	})
+
+	nfttypes.HandleGateway(mux, pattern_Query_Volume_0, func(ctx context.Context, req *http.Request, pathParams map[string]string) (interface{}, error) {
+		return client.Volume(ctx, &QueryVolumeRequest{Type: req.URL.Query().Get("type")})
+	})
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Sale sources, the kind of trade a sale record comes from
+const (
+	SaleSourceListing = "listing"
+	SaleSourceAuction = "auction"
+	SaleSourceOffer   = "offer"
+)
+
+const (
+	// VolumeWindowDay and VolumeWindowWeek are the rolling windows the
+	// trading volume is reported over
+	VolumeWindowDay  = 24 * time.Hour
+	VolumeWindowWeek = 7 * 24 * time.Hour
+
+	// MaxSalePrunesPerBlock bounds the sales the EndBlocker drops from the
+	// volume window in one block
+	MaxSalePrunesPerBlock = 100
+)
+
+// Sale records one NFT changing hands on the marketplace. Each NFT of a
+// bundle gets its own record priced at its share of the bundle price, so
+// the records of a trade always add up to what the buyer paid.
+type Sale struct {
+	ID      uint64   `json:"id"`
+	NFTID   string   `json:"nft_id"`
+	NFTType string   `json:"nft_type"`
+	Seller  string   `json:"seller"`
+	Buyer   string   `json:"buyer"`
+	Price   sdk.Coin `json:"price"`
+	// Source is the kind of trade, SaleSourceListing, SaleSourceAuction or
+	// SaleSourceOffer, and SourceID the ID of the listing, auction or offer
+	Source   string    `json:"source"`
+	SourceID uint64    `json:"source_id"`
+	SoldAt   time.Time `json:"sold_at"`
+}
+
+// ProtoMessage implements the proto.Message interface for Sale.
+func (s *Sale) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for Sale.
+func (s *Sale) Reset() { *s = Sale{} }
+
+// String implements the fmt.Stringer interface for Sale.
+func (s *Sale) String() string {
+	return fmt.Sprintf("Sale{ID: %d, NFTID: %s, Seller: %s, Buyer: %s, Price: %s, Source: %s}", s.ID, s.NFTID, s.Seller, s.Buyer, s.Price, s.Source)
+}
+
+// Marshal implements codec.ProtoMarshaler for Sale.
+func (s *Sale) Marshal() ([]byte, error) { return json.Marshal(s) }
+
+// MarshalTo implements codec.ProtoMarshaler for Sale.
+func (s *Sale) MarshalTo(data []byte) (int, error) { return jsonMarshalTo(s, data) }
+
+// MarshalToSizedBuffer implements codec.ProtoMarshaler for Sale.
+func (s *Sale) MarshalToSizedBuffer(data []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(s, data)
+}
+
+// Unmarshal implements codec.ProtoMarshaler for Sale.
+func (s *Sale) Unmarshal(data []byte) error { return json.Unmarshal(data, s) }
+
+// Size implements codec.ProtoMarshaler for Sale.
+func (s *Sale) Size() int { return jsonSize(s) }
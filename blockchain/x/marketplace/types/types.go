@@ -1,22 +1,28 @@
 package types
 
 import (
+    "time"
+
     sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// MsgCreateListing defines a message to create a new marketplace listing
+// MsgCreateListing defines a message to create a new marketplace listing.
+// A non-zero Duration makes the listing expire that long after creation.
 type MsgCreateListing struct {
-    Creator  string  `json:"creator"`
-    ItemID   string  `json:"item_id"`
-    Price    sdk.Coin `json:"price"`
+    Creator  string        `json:"creator"`
+    NFTID    string        `json:"nft_id"`
+    Price    sdk.Coin      `json:"price"`
+    Duration time.Duration `json:"duration,omitempty"`
 }
 
 // MsgBuyItem defines a message to buy an item from the marketplace
 type MsgBuyItem struct {
-    Buyer    string  `json:"buyer"`
-    ListingID string `json:"listing_id"`
+    Buyer     string `json:"buyer"`
+    ListingID uint64 `json:"listing_id"`
+}
+
+// MsgCancelListing defines a message to withdraw a listing and get the NFT back
+type MsgCancelListing struct {
+    Creator   string `json:"creator"`
+    ListingID uint64 `json:"listing_id"`
 }
-func (l *Listing) Size() int { return 0 }
-func (l *Listing) MarshalTo([]byte) (int, error) { return 0, nil }
-func (l *Listing) UnmarshalTo(interface{}) error { return nil }
-func (l *Listing) MarshalToSizedBuffer([]byte) (int, error) { return 0, nil }
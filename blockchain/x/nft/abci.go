@@ -0,0 +1,26 @@
+package nft
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"skaffacity/x/nft/keeper"
+	"skaffacity/x/nft/types"
+)
+
+// EndBlocker clears the rentals that have expired by the end of the block
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	for _, rental := range k.ExpireRentals(ctx) {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeUserExpired,
+				sdk.NewAttribute(types.AttributeKeyNFTID, rental.NFTID),
+				sdk.NewAttribute(types.AttributeKeyUser, rental.User),
+			),
+		)
+	}
+}
@@ -0,0 +1,543 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"skaffacity/x/nft/types"
+)
+
+// GetQueryCmd returns the cli query commands for the NFT module
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdQueryNFT(),
+		CmdQueryNFTs(),
+		CmdQueryLand(),
+		CmdQueryItems(),
+		CmdQueryBadges(),
+		CmdQueryLandAt(),
+		CmdQueryLandInArea(),
+		CmdQueryLandNeighbors(),
+		CmdQueryAttachments(),
+		CmdQueryPlayerBadges(),
+		CmdQueryBadgeIssuers(),
+		CmdQueryClass(),
+		CmdQueryClasses(),
+		CmdQueryClassNFTs(),
+		CmdQueryEffectiveUser(),
+		CmdQueryUserRentals(),
+	)
+
+	return cmd
+}
+
+func CmdQueryNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nft [id]",
+		Short: "Query an NFT by id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.NFT(cmd.Context(), &types.QueryNFTRequest{Id: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryNFTs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nfts [owner]",
+		Short: "Query the NFTs owned by an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.NFTs(cmd.Context(), &types.QueryNFTsRequest{Owner: args[0], Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "nfts")
+	return cmd
+}
+
+func CmdQueryLand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "land",
+		Short: "Query all land NFTs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Land(cmd.Context(), &types.QueryLandRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "land")
+	return cmd
+}
+
+func CmdQueryItems() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "items",
+		Short: "Query all item NFTs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Items(cmd.Context(), &types.QueryItemsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "items")
+	return cmd
+}
+
+func CmdQueryBadges() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "badges",
+		Short: "Query all badge NFTs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Badges(cmd.Context(), &types.QueryBadgesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "badges")
+	return cmd
+}
+
+func CmdQueryLandAt() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "land-at [x] [y]",
+		Short: "Query the land parcel covering a map cell",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coords, err := parseCoordinates(args)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LandAt(cmd.Context(), &types.QueryLandAtRequest{X: coords[0], Y: coords[1]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryLandInArea() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "land-in-area [min-x] [min-y] [max-x] [max-y]",
+		Short: "Query the land parcels overlapping a bounding box",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coords, err := parseCoordinates(args)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LandInArea(cmd.Context(), &types.QueryLandInAreaRequest{
+				MinX: coords[0],
+				MinY: coords[1],
+				MaxX: coords[2],
+				MaxY: coords[3],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryLandNeighbors() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "land-neighbors [id]",
+		Short: "Query the land parcels adjacent to a parcel",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LandNeighbors(cmd.Context(), &types.QueryLandNeighborsRequest{Id: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryAttachments() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attachments [id]",
+		Short: "Query the full attachment tree below an NFT",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Attachments(cmd.Context(), &types.QueryAttachmentsRequest{Id: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryPlayerBadges() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "player-badges [owner]",
+		Short: "Query the badges held by a player",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PlayerBadges(cmd.Context(), &types.QueryPlayerBadgesRequest{Owner: args[0], Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "player-badges")
+	return cmd
+}
+
+func CmdQueryBadgeIssuers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "badge-issuers",
+		Short: "Query the registered badge issuers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BadgeIssuers(cmd.Context(), &types.QueryBadgeIssuersRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "badge-issuers")
+	return cmd
+}
+
+func CmdQueryClass() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "class [class-id]",
+		Short: "Query a class and its supply",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Class(cmd.Context(), &types.QueryClassRequest{ClassId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryClasses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "classes",
+		Short: "Query all NFT classes",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Classes(cmd.Context(), &types.QueryClassesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "classes")
+	return cmd
+}
+
+func CmdQueryClassNFTs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "class-nfts [class-id]",
+		Short: "Query the NFTs in a class",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ClassNFTs(cmd.Context(), &types.QueryClassNFTsRequest{ClassId: args[0], Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "class-nfts")
+	return cmd
+}
+
+// parseCoordinates parses each argument as a map coordinate
+func parseCoordinates(args []string) ([]int32, error) {
+	coords := make([]int32, len(args))
+	for i, arg := range args {
+		c, err := strconv.ParseInt(arg, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid coordinate %q: %w", arg, err)
+		}
+		coords[i] = int32(c)
+	}
+	return coords, nil
+}
+
+func CmdQueryEffectiveUser() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "effective-user [nft-id]",
+		Short: "Query the account entitled to use an NFT, the renter or the owner",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EffectiveUser(cmd.Context(), &types.QueryEffectiveUserRequest{Id: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryUserRentals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "user-rentals [user]",
+		Short: "Query the NFTs rented to an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.UserRentals(cmd.Context(), &types.QueryUserRentalsRequest{User: args[0], Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "user-rentals")
+	return cmd
+}
@@ -1,10 +1,43 @@
 package cli
 
 import (
+    "encoding/json"
+    "fmt"
+    "os"
+    "strings"
+    "time"
+
     "github.com/spf13/cobra"
     "github.com/cosmos/cosmos-sdk/client"
     "github.com/cosmos/cosmos-sdk/client/flags"
     "github.com/cosmos/cosmos-sdk/client/tx"
+
+    "skaffacity/x/nft/types"
+)
+
+const (
+    FlagImage       = "image"
+    FlagX           = "x"
+    FlagY           = "y"
+    FlagSize        = "size"
+    FlagBuildRights = "build-rights"
+    FlagAchievement = "achievement"
+    FlagPermanent   = "permanent"
+
+    FlagDescription   = "description"
+    FlagMintAuthority = "mint-authority"
+    FlagMaxSupply     = "max-supply"
+    FlagTransferable  = "transferable"
+    FlagRoyaltyTo     = "royalty-recipient"
+    FlagRoyaltyBps    = "royalty-bps"
+
+    FlagMutableName        = "mutable-name"
+    FlagMutableDescription = "mutable-description"
+    FlagMutableImage       = "mutable-image"
+    FlagMutableProperties  = "mutable-properties"
+
+    FlagName     = "name"
+    FlagProperty = "property"
 )
 
 // GetTxCmd returns the transaction commands for the NFT module
@@ -21,6 +54,14 @@ func GetTxCmd() *cobra.Command {
         GetCmdMintNFT(),
         GetCmdTransferNFT(),
         GetCmdAttachToItem(),
+        GetCmdDetachFromItem(),
+        GetCmdRevokeBadge(),
+        GetCmdCreateClass(),
+        GetCmdBurnNFT(),
+        GetCmdUpdateNFTMetadata(),
+        GetCmdBatchMintNFT(),
+        GetCmdBatchTransferNFT(),
+        GetCmdSetUser(),
     )
 
     return cmd
@@ -28,27 +69,68 @@ func GetTxCmd() *cobra.Command {
 
 func GetCmdMintNFT() *cobra.Command {
     cmd := &cobra.Command{
-        Use:   "mint [type] [name] [description] [recipient]",
-        Short: "Mint a new NFT",
-        Args:  cobra.ExactArgs(4),
+        Use:   "mint [class-id] [id] [type] [name] [description] [recipient]",
+        Short: "Mint a new NFT in a class you are the mint authority of",
+        Args:  cobra.ExactArgs(6),
         RunE: func(cmd *cobra.Command, args []string) error {
             clientCtx, err := client.GetClientTxContext(cmd)
             if err != nil {
                 return err
             }
 
-            // msg := types.NewMsgMintNFT(
-            //     clientCtx.GetFromAddress().String(),
-            //     args[0], // type
-            //     args[1], // name
-            //     args[2], // description
-            //     args[3], // recipient
-            // )
+            image, err := cmd.Flags().GetString(FlagImage)
+            if err != nil {
+                return err
+            }
+
+            msg := types.NewMsgMintNFT(
+                clientCtx.GetFromAddress().String(),
+                args[0], // class-id
+                args[1], // id
+                args[2], // type
+                args[5], // recipient
+                types.Metadata{
+                    Name:        args[3],
+                    Description: args[4],
+                    Image:       image,
+                },
+            )
+
+            if msg.NFTType == types.TypeLand {
+                land, err := landFromFlags(cmd)
+                if err != nil {
+                    return err
+                }
+                msg.Land = &land
+            }
+
+            if msg.NFTType == types.TypeBadge {
+                achievement, err := cmd.Flags().GetString(FlagAchievement)
+                if err != nil {
+                    return err
+                }
+                permanent, err := cmd.Flags().GetBool(FlagPermanent)
+                if err != nil {
+                    return err
+                }
+                msg.Badge = &types.BadgeMetadata{Achievement: achievement, Permanent: permanent}
+            }
 
-            return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), nil)
+            if err := msg.ValidateBasic(); err != nil {
+                return err
+            }
+
+            return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
         },
     }
 
+    cmd.Flags().String(FlagImage, "", "Image URI of the NFT")
+    cmd.Flags().Int32(FlagX, 0, "X coordinate of the parcel's lower-left cell (land only)")
+    cmd.Flags().Int32(FlagY, 0, "Y coordinate of the parcel's lower-left cell (land only)")
+    cmd.Flags().Uint32(FlagSize, 1, "Side length of the parcel in cells (land only)")
+    cmd.Flags().Bool(FlagBuildRights, false, "Whether the parcel can be built on (land only)")
+    cmd.Flags().String(FlagAchievement, "", "Achievement the badge is awarded for (badge only)")
+    cmd.Flags().Bool(FlagPermanent, false, "Whether the badge can never be revoked (badge only)")
     flags.AddTxFlagsToCmd(cmd)
     return cmd
 }
@@ -64,13 +146,17 @@ func GetCmdTransferNFT() *cobra.Command {
                 return err
             }
 
-            // msg := types.NewMsgTransferNFT(
-            //     clientCtx.GetFromAddress().String(),
-            //     args[0], // nft-id
-            //     args[1], // recipient
-            // )
+            msg := types.NewMsgTransferNFT(
+                clientCtx.GetFromAddress().String(),
+                args[0], // nft-id
+                args[1], // recipient
+            )
+
+            if err := msg.ValidateBasic(); err != nil {
+                return err
+            }
 
-            return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), nil)
+            return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
         },
     }
 
@@ -89,16 +175,447 @@ func GetCmdAttachToItem() *cobra.Command {
                 return err
             }
 
-            // msg := types.NewMsgAttachToItem(
-            //     clientCtx.GetFromAddress().String(),
-            //     args[0], // item-id
-            //     args[1], // attachment-id
-            // )
+            msg := types.NewMsgAttachToItem(
+                clientCtx.GetFromAddress().String(),
+                args[0], // item-id
+                args[1], // attachment-id
+            )
+
+            if err := msg.ValidateBasic(); err != nil {
+                return err
+            }
+
+            return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+        },
+    }
+
+    flags.AddTxFlagsToCmd(cmd)
+    return cmd
+}
+
+func GetCmdDetachFromItem() *cobra.Command {
+    cmd := &cobra.Command{
+        Use:   "detach [attachment-id]",
+        Short: "Detach an NFT from the item it is attached to",
+        Args:  cobra.ExactArgs(1),
+        RunE: func(cmd *cobra.Command, args []string) error {
+            clientCtx, err := client.GetClientTxContext(cmd)
+            if err != nil {
+                return err
+            }
+
+            msg := types.NewMsgDetachFromItem(
+                clientCtx.GetFromAddress().String(),
+                args[0], // attachment-id
+            )
+
+            if err := msg.ValidateBasic(); err != nil {
+                return err
+            }
+
+            return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+        },
+    }
+
+    flags.AddTxFlagsToCmd(cmd)
+    return cmd
+}
+
+func GetCmdRevokeBadge() *cobra.Command {
+    cmd := &cobra.Command{
+        Use:   "revoke-badge [badge-id]",
+        Short: "Revoke a non-permanent badge you issued",
+        Args:  cobra.ExactArgs(1),
+        RunE: func(cmd *cobra.Command, args []string) error {
+            clientCtx, err := client.GetClientTxContext(cmd)
+            if err != nil {
+                return err
+            }
+
+            msg := types.NewMsgRevokeBadge(
+                clientCtx.GetFromAddress().String(),
+                args[0], // badge-id
+            )
+
+            if err := msg.ValidateBasic(); err != nil {
+                return err
+            }
+
+            return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+        },
+    }
+
+    flags.AddTxFlagsToCmd(cmd)
+    return cmd
+}
+
+func GetCmdCreateClass() *cobra.Command {
+    cmd := &cobra.Command{
+        Use:   "create-class [class-id] [name]",
+        Short: "Create a new NFT class",
+        Args:  cobra.ExactArgs(2),
+        RunE: func(cmd *cobra.Command, args []string) error {
+            clientCtx, err := client.GetClientTxContext(cmd)
+            if err != nil {
+                return err
+            }
+
+            creator := clientCtx.GetFromAddress().String()
 
-            return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), nil)
+            description, err := cmd.Flags().GetString(FlagDescription)
+            if err != nil {
+                return err
+            }
+
+            mintAuthority, err := cmd.Flags().GetString(FlagMintAuthority)
+            if err != nil {
+                return err
+            }
+            if mintAuthority == "" {
+                mintAuthority = creator
+            }
+
+            maxSupply, err := cmd.Flags().GetUint64(FlagMaxSupply)
+            if err != nil {
+                return err
+            }
+
+            transferable, err := cmd.Flags().GetBool(FlagTransferable)
+            if err != nil {
+                return err
+            }
+
+            royaltyTo, err := cmd.Flags().GetString(FlagRoyaltyTo)
+            if err != nil {
+                return err
+            }
+
+            royaltyBps, err := cmd.Flags().GetUint32(FlagRoyaltyBps)
+            if err != nil {
+                return err
+            }
+
+            mutability, err := mutabilityFromFlags(cmd)
+            if err != nil {
+                return err
+            }
+
+            msg := types.NewMsgCreateClass(
+                creator,
+                args[0], // class-id
+                args[1], // name
+                description,
+                mintAuthority,
+                maxSupply,
+                transferable,
+                types.RoyaltyInfo{Recipient: royaltyTo, BasisPoints: royaltyBps},
+                mutability,
+            )
+
+            if err := msg.ValidateBasic(); err != nil {
+                return err
+            }
+
+            return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
         },
     }
 
+    cmd.Flags().String(FlagDescription, "", "Description of the class")
+    cmd.Flags().String(FlagMintAuthority, "", "Account allowed to mint in the class (defaults to the creator)")
+    cmd.Flags().Uint64(FlagMaxSupply, 0, "Maximum number of NFTs ever minted in the class (0 for no cap)")
+    cmd.Flags().Bool(FlagTransferable, true, "Whether NFTs in the class can be transferred")
+    cmd.Flags().String(FlagRoyaltyTo, "", "Recipient of secondary sale royalties")
+    cmd.Flags().Uint32(FlagRoyaltyBps, 0, "Royalty on secondary sales in basis points")
+    cmd.Flags().String(FlagMutableName, types.MutabilityFrozen, "Who may change NFT names (frozen, owner, authority, owner_or_authority)")
+    cmd.Flags().String(FlagMutableDescription, types.MutabilityFrozen, "Who may change NFT descriptions")
+    cmd.Flags().String(FlagMutableImage, types.MutabilityFrozen, "Who may change NFT images")
+    cmd.Flags().String(FlagMutableProperties, types.MutabilityFrozen, "Who may change NFT properties")
     flags.AddTxFlagsToCmd(cmd)
     return cmd
 }
+
+func GetCmdBurnNFT() *cobra.Command {
+    cmd := &cobra.Command{
+        Use:   "burn [nft-id]",
+        Short: "Burn an NFT you own",
+        Args:  cobra.ExactArgs(1),
+        RunE: func(cmd *cobra.Command, args []string) error {
+            clientCtx, err := client.GetClientTxContext(cmd)
+            if err != nil {
+                return err
+            }
+
+            msg := types.NewMsgBurnNFT(
+                clientCtx.GetFromAddress().String(),
+                args[0], // nft-id
+            )
+
+            if err := msg.ValidateBasic(); err != nil {
+                return err
+            }
+
+            return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+        },
+    }
+
+    flags.AddTxFlagsToCmd(cmd)
+    return cmd
+}
+
+func GetCmdUpdateNFTMetadata() *cobra.Command {
+    cmd := &cobra.Command{
+        Use:   "update-metadata [nft-id]",
+        Short: "Update the mutable metadata fields of an NFT",
+        Long: `Update the metadata of an NFT. Only the fields given as flags are changed;
+the others are read from the chain and sent unchanged. --property replaces the
+whole property set and can be repeated, e.g. --property level=5 --property xp=120.`,
+        Args: cobra.ExactArgs(1),
+        RunE: func(cmd *cobra.Command, args []string) error {
+            clientCtx, err := client.GetClientTxContext(cmd)
+            if err != nil {
+                return err
+            }
+
+            queryClient := types.NewQueryClient(clientCtx)
+            res, err := queryClient.NFT(cmd.Context(), &types.QueryNFTRequest{Id: args[0]})
+            if err != nil {
+                return err
+            }
+
+            metadata := res.Nft.Metadata
+            if cmd.Flags().Changed(FlagName) {
+                if metadata.Name, err = cmd.Flags().GetString(FlagName); err != nil {
+                    return err
+                }
+            }
+            if cmd.Flags().Changed(FlagDescription) {
+                if metadata.Description, err = cmd.Flags().GetString(FlagDescription); err != nil {
+                    return err
+                }
+            }
+            if cmd.Flags().Changed(FlagImage) {
+                if metadata.Image, err = cmd.Flags().GetString(FlagImage); err != nil {
+                    return err
+                }
+            }
+            if cmd.Flags().Changed(FlagProperty) {
+                pairs, err := cmd.Flags().GetStringArray(FlagProperty)
+                if err != nil {
+                    return err
+                }
+                metadata.Properties = make(map[string]string, len(pairs))
+                for _, pair := range pairs {
+                    kv := strings.SplitN(pair, "=", 2)
+                    if len(kv) != 2 {
+                        return fmt.Errorf("invalid property %q, expected key=value", pair)
+                    }
+                    metadata.Properties[kv[0]] = kv[1]
+                }
+            }
+
+            msg := types.NewMsgUpdateNFTMetadata(
+                clientCtx.GetFromAddress().String(),
+                args[0], // nft-id
+                metadata,
+            )
+
+            if err := msg.ValidateBasic(); err != nil {
+                return err
+            }
+
+            return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+        },
+    }
+
+    cmd.Flags().String(FlagName, "", "New name of the NFT")
+    cmd.Flags().String(FlagDescription, "", "New description of the NFT")
+    cmd.Flags().String(FlagImage, "", "New image URI of the NFT")
+    cmd.Flags().StringArray(FlagProperty, nil, "Property as key=value; replaces all properties")
+    flags.AddTxFlagsToCmd(cmd)
+    return cmd
+}
+
+func GetCmdBatchMintNFT() *cobra.Command {
+    cmd := &cobra.Command{
+        Use:   "batch-mint [class-id] [entries-file]",
+        Short: "Mint many NFTs of a class in one transaction",
+        Long: `Mint many NFTs of a class in one transaction. The entries file holds a JSON
+array of entries, each with the fields of a single mint:
+
+[{"id": "1", "nft_type": "item", "recipient": "cosmos1...", "metadata": {"name": "Sword"}}]
+
+Either every NFT is minted or none is.`,
+        Args: cobra.ExactArgs(2),
+        RunE: func(cmd *cobra.Command, args []string) error {
+            clientCtx, err := client.GetClientTxContext(cmd)
+            if err != nil {
+                return err
+            }
+
+            var entries []types.MintEntry
+            if err := readJSONFile(args[1], &entries); err != nil {
+                return err
+            }
+
+            msg := types.NewMsgBatchMintNFT(
+                clientCtx.GetFromAddress().String(),
+                args[0], // class-id
+                entries,
+            )
+
+            if err := msg.ValidateBasic(); err != nil {
+                return err
+            }
+
+            return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+        },
+    }
+
+    flags.AddTxFlagsToCmd(cmd)
+    return cmd
+}
+
+func GetCmdBatchTransferNFT() *cobra.Command {
+    cmd := &cobra.Command{
+        Use:   "batch-transfer [entries-file]",
+        Short: "Transfer many NFTs in one transaction",
+        Long: `Transfer many NFTs in one transaction. The entries file holds a JSON array
+of transfers:
+
+[{"id": "swords:1", "recipient": "cosmos1..."}]
+
+Either every NFT is transferred or none is.`,
+        Args: cobra.ExactArgs(1),
+        RunE: func(cmd *cobra.Command, args []string) error {
+            clientCtx, err := client.GetClientTxContext(cmd)
+            if err != nil {
+                return err
+            }
+
+            var entries []types.TransferEntry
+            if err := readJSONFile(args[0], &entries); err != nil {
+                return err
+            }
+
+            msg := types.NewMsgBatchTransferNFT(clientCtx.GetFromAddress().String(), entries)
+
+            if err := msg.ValidateBasic(); err != nil {
+                return err
+            }
+
+            return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+        },
+    }
+
+    flags.AddTxFlagsToCmd(cmd)
+    return cmd
+}
+
+func GetCmdSetUser() *cobra.Command {
+    cmd := &cobra.Command{
+        Use:   "set-user [nft-id] [user] [expires]",
+        Short: "Rent out an NFT you own to another account until a given time",
+        Long: `Rent out an NFT you own to another account. The user holds the usage rights
+until the rental expires, while ownership stays with you. Expires is either a
+duration from now, such as 72h, or an RFC3339 timestamp.`,
+        Args: cobra.ExactArgs(3),
+        RunE: func(cmd *cobra.Command, args []string) error {
+            clientCtx, err := client.GetClientTxContext(cmd)
+            if err != nil {
+                return err
+            }
+
+            expires, err := parseExpiry(args[2])
+            if err != nil {
+                return err
+            }
+
+            msg := types.NewMsgSetUser(
+                clientCtx.GetFromAddress().String(),
+                args[0], // nft-id
+                args[1], // user
+                expires,
+            )
+
+            if err := msg.ValidateBasic(); err != nil {
+                return err
+            }
+
+            return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+        },
+    }
+
+    flags.AddTxFlagsToCmd(cmd)
+    return cmd
+}
+
+// parseExpiry reads either a duration from now or an RFC3339 timestamp
+func parseExpiry(s string) (time.Time, error) {
+    if d, err := time.ParseDuration(s); err == nil {
+        return time.Now().Add(d).UTC(), nil
+    }
+    t, err := time.Parse(time.RFC3339, s)
+    if err != nil {
+        return time.Time{}, fmt.Errorf("expires must be a duration or an RFC3339 timestamp: %s", s)
+    }
+    return t.UTC(), nil
+}
+
+// readJSONFile decodes the JSON file at path into v
+func readJSONFile(path string, v interface{}) error {
+    bz, err := os.ReadFile(path)
+    if err != nil {
+        return err
+    }
+    if err := json.Unmarshal(bz, v); err != nil {
+        return fmt.Errorf("failed to parse %s: %w", path, err)
+    }
+    return nil
+}
+
+// mutabilityFromFlags reads the metadata mutability flags of the create-class command
+func mutabilityFromFlags(cmd *cobra.Command) (types.MetadataMutability, error) {
+    var mutability types.MetadataMutability
+    for flag, field := range map[string]*string{
+        FlagMutableName:        &mutability.Name,
+        FlagMutableDescription: &mutability.Description,
+        FlagMutableImage:       &mutability.Image,
+        FlagMutableProperties:  &mutability.Properties,
+    } {
+        value, err := cmd.Flags().GetString(flag)
+        if err != nil {
+            return types.MetadataMutability{}, err
+        }
+        *field = value
+    }
+    return mutability, nil
+}
+
+// landFromFlags reads the parcel placement flags of the mint command
+func landFromFlags(cmd *cobra.Command) (types.LandMetadata, error) {
+    x, err := cmd.Flags().GetInt32(FlagX)
+    if err != nil {
+        return types.LandMetadata{}, err
+    }
+
+    y, err := cmd.Flags().GetInt32(FlagY)
+    if err != nil {
+        return types.LandMetadata{}, err
+    }
+
+    size, err := cmd.Flags().GetUint32(FlagSize)
+    if err != nil {
+        return types.LandMetadata{}, err
+    }
+
+    buildRights, err := cmd.Flags().GetBool(FlagBuildRights)
+    if err != nil {
+        return types.LandMetadata{}, err
+    }
+
+    return types.LandMetadata{
+        Location:    types.Location{X: x, Y: y},
+        Size:        size,
+        BuildRights: buildRights,
+    }, nil
+}
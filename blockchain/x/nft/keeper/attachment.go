@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"skaffacity/x/nft/types"
+)
+
+// AttachToItem attaches an attachment NFT to a parent NFT, both owned by owner.
+// The parent is either an item or another attachment, so attachments form a
+// tree rooted at an item. While attached, the attachment follows the parent
+// on transfer and cannot be transferred on its own.
+func (k Keeper) AttachToItem(ctx sdk.Context, itemID, attachmentID, owner string) error {
+	if itemID == attachmentID {
+		return sdkerrors.Wrap(types.ErrInvalidAttachment, "cannot attach an NFT to itself")
+	}
+
+	item, err := k.GetNFT(ctx, itemID)
+	if err != nil {
+		return err
+	}
+
+	attachment, err := k.GetNFT(ctx, attachmentID)
+	if err != nil {
+		return err
+	}
+
+	if item.Type != types.TypeItem && item.Type != types.TypeAttachment {
+		return sdkerrors.Wrapf(types.ErrInvalidAttachment, "%s cannot hold attachments", itemID)
+	}
+
+	if attachment.Type != types.TypeAttachment {
+		return sdkerrors.Wrapf(types.ErrInvalidAttachment, "%s is not an attachment", attachmentID)
+	}
+
+	if item.Owner != owner || attachment.Owner != owner {
+		return sdkerrors.Wrap(types.ErrUnauthorized, "sender must own both the item and the attachment")
+	}
+
+	if attachment.Parent != "" {
+		return sdkerrors.Wrapf(types.ErrInvalidAttachment, "%s is already attached to %s", attachmentID, attachment.Parent)
+	}
+
+	// depth of the attachment once attached, rejecting cycles on the way up
+	depth := 1
+	for id := item.Parent; id != ""; depth++ {
+		if id == attachmentID {
+			return sdkerrors.Wrapf(types.ErrInvalidAttachment, "%s is attached below %s", itemID, attachmentID)
+		}
+		ancestor, err := k.GetNFT(ctx, id)
+		if err != nil {
+			return err
+		}
+		id = ancestor.Parent
+	}
+
+	if depth+k.attachmentHeight(ctx, attachmentID) > types.MaxAttachmentDepth {
+		return sdkerrors.Wrapf(types.ErrInvalidAttachment, "attachments cannot be nested more than %d deep", types.MaxAttachmentDepth)
+	}
+
+	if len(k.GetAttachments(ctx, itemID)) >= types.MaxAttachmentsPerItem {
+		return sdkerrors.Wrapf(types.ErrInvalidAttachment, "%s already holds %d attachments", itemID, types.MaxAttachmentsPerItem)
+	}
+
+	attachment.Parent = itemID
+	return k.UpdateNFT(ctx, attachment)
+}
+
+// DetachFromItem detaches an attachment from its parent and returns the ID of
+// the former parent. Anything attached below the attachment stays with it.
+func (k Keeper) DetachFromItem(ctx sdk.Context, attachmentID, owner string) (string, error) {
+	attachment, err := k.GetNFT(ctx, attachmentID)
+	if err != nil {
+		return "", err
+	}
+
+	if attachment.Owner != owner {
+		return "", sdkerrors.Wrap(types.ErrUnauthorized, "sender is not the owner")
+	}
+
+	if attachment.Parent == "" {
+		return "", sdkerrors.Wrapf(types.ErrInvalidAttachment, "%s is not attached", attachmentID)
+	}
+
+	parent := attachment.Parent
+	attachment.Parent = ""
+	if err := k.UpdateNFT(ctx, attachment); err != nil {
+		return "", err
+	}
+	return parent, nil
+}
+
+// HasAttachments reports whether any NFT is attached directly to id
+func (k Keeper) HasAttachments(ctx sdk.Context, id string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetNFTByParentPrefix(id))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	return iterator.Valid()
+}
+
+// GetAttachments returns the NFTs attached directly to id
+func (k Keeper) GetAttachments(ctx sdk.Context, id string) []types.NFT {
+	return k.getIndexedNFTs(ctx, types.GetNFTByParentPrefix(id))
+}
+
+// GetAttachmentTree returns every NFT attached below id, depth first, with
+// each attachment listed before its own attachments
+func (k Keeper) GetAttachmentTree(ctx sdk.Context, id string) []types.NFT {
+	tree := []types.NFT{}
+	for _, attachment := range k.GetAttachments(ctx, id) {
+		tree = append(tree, attachment)
+		tree = append(tree, k.GetAttachmentTree(ctx, attachment.ID)...)
+	}
+	return tree
+}
+
+// attachmentHeight returns how many levels of attachments hang below id
+func (k Keeper) attachmentHeight(ctx sdk.Context, id string) int {
+	height := 0
+	for _, attachment := range k.GetAttachments(ctx, id) {
+		if h := k.attachmentHeight(ctx, attachment.ID) + 1; h > height {
+			height = h
+		}
+	}
+	return height
+}
+
+// setAttachmentsOwner hands every attachment below id to owner
+func (k Keeper) setAttachmentsOwner(ctx sdk.Context, id, owner string) error {
+	for _, attachment := range k.GetAttachments(ctx, id) {
+		attachment.Owner = owner
+		if err := k.UpdateNFT(ctx, attachment); err != nil {
+			return err
+		}
+		if err := k.setAttachmentsOwner(ctx, attachment.ID, owner); err != nil {
+			return err
+		}
+	}
+	return nil
+}
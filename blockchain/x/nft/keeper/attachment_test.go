@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"skaffacity/x/nft/types"
+)
+
+// attach attaches attachmentID to itemID on behalf of owner
+func (f fixture) attach(owner, itemID, attachmentID string) error {
+	_, err := f.msgServer.AttachToItem(sdk.WrapSDKContext(f.ctx), types.NewMsgAttachToItem(owner, itemID, attachmentID))
+	return err
+}
+
+func TestAttachmentNestingIsLimited(t *testing.T) {
+	f := setupKeeper(t)
+
+	// a chain of MaxAttachmentDepth attachments below the sword
+	parent := f.mint(t, "sword", types.TypeItem, alice)
+	for _, id := range []string{"a1", "a2", "a3", "a4"} {
+		attachment := f.mint(t, id, types.TypeAttachment, alice)
+		require.NoError(t, f.attach(alice, parent, attachment))
+		parent = attachment
+	}
+
+	tooDeep := f.mint(t, "a5", types.TypeAttachment, alice)
+	require.ErrorIs(t, f.attach(alice, parent, tooDeep), types.ErrInvalidAttachment)
+
+	// a subtree counts with its full height
+	b1 := f.mint(t, "b1", types.TypeAttachment, alice)
+	b2 := f.mint(t, "b2", types.TypeAttachment, alice)
+	require.NoError(t, f.attach(alice, b1, b2))
+	require.ErrorIs(t, f.attach(alice, types.NFTID("city", "a3"), b1), types.ErrInvalidAttachment)
+	require.NoError(t, f.attach(alice, types.NFTID("city", "a2"), b1))
+}
+
+func TestAttachmentsCannotFormCycles(t *testing.T) {
+	f := setupKeeper(t)
+
+	a1 := f.mint(t, "a1", types.TypeAttachment, alice)
+	a2 := f.mint(t, "a2", types.TypeAttachment, alice)
+	a3 := f.mint(t, "a3", types.TypeAttachment, alice)
+	require.NoError(t, f.attach(alice, a1, a2))
+	require.NoError(t, f.attach(alice, a2, a3))
+
+	require.ErrorIs(t, f.attach(alice, a3, a1), types.ErrInvalidAttachment)
+	require.ErrorIs(t, f.attach(alice, a1, a1), types.ErrInvalidAttachment)
+	require.Empty(t, f.nft(t, a1).Parent)
+}
+
+func TestAttachmentsFollowTheirItem(t *testing.T) {
+	f := setupKeeper(t)
+
+	sword := f.mint(t, "sword", types.TypeItem, alice)
+	gem := f.mint(t, "gem", types.TypeAttachment, alice)
+	rune := f.mint(t, "rune", types.TypeAttachment, alice)
+	require.NoError(t, f.attach(alice, sword, gem))
+	require.NoError(t, f.attach(alice, gem, rune))
+
+	// attached NFTs only move with the item
+	require.ErrorIs(t, f.transfer(gem, alice, bob), types.ErrNonTransferable)
+	require.NoError(t, f.transfer(sword, alice, bob))
+	require.Equal(t, bob, f.nft(t, gem).Owner)
+	require.Equal(t, bob, f.nft(t, rune).Owner)
+	require.Equal(t, []string{gem, rune, sword}, ids(f.keeper.GetNFTsByOwner(f.ctx, bob)))
+	require.Empty(t, f.keeper.GetNFTsByOwner(f.ctx, alice))
+	require.Equal(t, []string{gem, rune}, ids(f.keeper.GetAttachmentTree(f.ctx, sword)))
+
+	// the new owner can take the gem off, with the rune still on it
+	_, err := f.msgServer.DetachFromItem(sdk.WrapSDKContext(f.ctx), types.NewMsgDetachFromItem(alice, gem))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = f.msgServer.DetachFromItem(sdk.WrapSDKContext(f.ctx), types.NewMsgDetachFromItem(bob, gem))
+	require.NoError(t, err)
+	require.Empty(t, f.keeper.GetAttachments(f.ctx, sword))
+	require.Equal(t, gem, f.nft(t, rune).Parent)
+
+	require.NoError(t, f.transfer(gem, bob, alice))
+	require.Equal(t, alice, f.nft(t, rune).Owner)
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"skaffacity/x/nft/types"
+)
+
+// SetBadgeIssuer adds or updates an entry in the badge issuer registry
+func (k Keeper) SetBadgeIssuer(ctx sdk.Context, issuer types.BadgeIssuer) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetBadgeIssuerKey(issuer.Address), k.cdc.MustMarshal(&issuer))
+}
+
+// RemoveBadgeIssuer removes an account from the badge issuer registry.
+// Badges it already awarded stay with their players.
+func (k Keeper) RemoveBadgeIssuer(ctx sdk.Context, address string) error {
+	if !k.IsBadgeIssuer(ctx, address) {
+		return sdkerrors.Wrapf(types.ErrInvalidBadge, "%s is not a badge issuer", address)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetBadgeIssuerKey(address))
+	return nil
+}
+
+// IsBadgeIssuer reports whether address may award badges
+func (k Keeper) IsBadgeIssuer(ctx sdk.Context, address string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetBadgeIssuerKey(address))
+}
+
+// GetBadgeIssuer returns the registry entry for address
+func (k Keeper) GetBadgeIssuer(ctx sdk.Context, address string) (types.BadgeIssuer, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetBadgeIssuerKey(address))
+	if bz == nil {
+		return types.BadgeIssuer{}, false
+	}
+
+	var issuer types.BadgeIssuer
+	k.cdc.MustUnmarshal(bz, &issuer)
+	return issuer, true
+}
+
+// GetAllBadgeIssuers returns every registered badge issuer
+func (k Keeper) GetAllBadgeIssuers(ctx sdk.Context) []types.BadgeIssuer {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.BadgeIssuerKey)
+	defer iterator.Close()
+
+	issuers := []types.BadgeIssuer{}
+	for ; iterator.Valid(); iterator.Next() {
+		var issuer types.BadgeIssuer
+		k.cdc.MustUnmarshal(iterator.Value(), &issuer)
+		issuers = append(issuers, issuer)
+	}
+	return issuers
+}
+
+// RevokeBadge burns a non-permanent badge. Only the issuer that awarded it,
+// while still registered, or the module authority may revoke it.
+func (k Keeper) RevokeBadge(ctx sdk.Context, id, sender string) (types.NFT, error) {
+	badge, err := k.GetNFT(ctx, id)
+	if err != nil {
+		return types.NFT{}, err
+	}
+
+	if badge.Type != types.TypeBadge || badge.Badge == nil {
+		return types.NFT{}, sdkerrors.Wrapf(types.ErrInvalidBadge, "%s is not a badge", id)
+	}
+
+	if badge.Badge.Permanent {
+		return types.NFT{}, sdkerrors.Wrapf(types.ErrInvalidBadge, "%s is permanent and cannot be revoked", id)
+	}
+
+	isIssuer := sender == badge.Badge.Issuer && k.IsBadgeIssuer(ctx, sender)
+	if !isIssuer && sender != k.authority {
+		return types.NFT{}, sdkerrors.Wrap(types.ErrUnauthorized, "only the badge issuer or the module authority can revoke a badge")
+	}
+
+	if err := k.BurnNFT(ctx, id); err != nil {
+		return types.NFT{}, err
+	}
+	return badge, nil
+}
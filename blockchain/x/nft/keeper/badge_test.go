@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"skaffacity/x/nft/types"
+)
+
+// mintBadge awards the badge id in "city" to alice on behalf of creator
+func (f fixture) mintBadge(id string, permanent bool) (string, error) {
+	_, err := f.msgServer.MintNFT(sdk.WrapSDKContext(f.ctx), types.NewMsgMintBadge(creator, "city", id, alice, types.Metadata{Name: id}, "Founder", permanent))
+	return types.NFTID("city", id), err
+}
+
+// revoke revokes a badge on behalf of sender
+func (f fixture) revoke(sender, id string) error {
+	_, err := f.msgServer.RevokeBadge(sdk.WrapSDKContext(f.ctx), types.NewMsgRevokeBadge(sender, id))
+	return err
+}
+
+func TestOnlyRegisteredIssuersAwardBadges(t *testing.T) {
+	f := setupKeeper(t)
+	goCtx := sdk.WrapSDKContext(f.ctx)
+
+	// the mint authority of the class still needs to be a registered issuer
+	_, err := f.mintBadge("founder", false)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = f.msgServer.AddBadgeIssuer(goCtx, types.NewMsgAddBadgeIssuer(creator, creator, "Game"))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = f.msgServer.AddBadgeIssuer(goCtx, types.NewMsgAddBadgeIssuer(authority, creator, "Game"))
+	require.NoError(t, err)
+
+	badge, err := f.mintBadge("founder", false)
+	require.NoError(t, err)
+	nft := f.nft(t, badge)
+	require.Equal(t, alice, nft.Owner)
+	require.Equal(t, creator, nft.Badge.Issuer)
+	require.False(t, nft.Transferable)
+
+	// badges are soulbound
+	require.ErrorIs(t, f.transfer(badge, alice, bob), types.ErrNonTransferable)
+
+	_, err = f.msgServer.RemoveBadgeIssuer(goCtx, types.NewMsgRemoveBadgeIssuer(authority, creator))
+	require.NoError(t, err)
+	_, err = f.mintBadge("veteran", false)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.True(t, f.keeper.HasNFT(f.ctx, badge))
+}
+
+func TestBadgeRevocation(t *testing.T) {
+	f := setupKeeper(t)
+	goCtx := sdk.WrapSDKContext(f.ctx)
+	_, err := f.msgServer.AddBadgeIssuer(goCtx, types.NewMsgAddBadgeIssuer(authority, creator, "Game"))
+	require.NoError(t, err)
+
+	founder, err := f.mintBadge("founder", false)
+	require.NoError(t, err)
+	veteran, err := f.mintBadge("veteran", false)
+	require.NoError(t, err)
+	legend, err := f.mintBadge("legend", true)
+	require.NoError(t, err)
+
+	// neither the player nor another issuer can revoke
+	require.ErrorIs(t, f.revoke(alice, founder), types.ErrUnauthorized)
+	_, err = f.msgServer.AddBadgeIssuer(goCtx, types.NewMsgAddBadgeIssuer(authority, bob, "Other game"))
+	require.NoError(t, err)
+	require.ErrorIs(t, f.revoke(bob, founder), types.ErrUnauthorized)
+
+	require.NoError(t, f.revoke(creator, founder))
+	require.False(t, f.keeper.HasNFT(f.ctx, founder))
+
+	// permanent badges stay, even against the authority
+	require.ErrorIs(t, f.revoke(authority, legend), types.ErrInvalidBadge)
+
+	// a removed issuer loses its right to revoke; the authority keeps it
+	_, err = f.msgServer.RemoveBadgeIssuer(goCtx, types.NewMsgRemoveBadgeIssuer(authority, creator))
+	require.NoError(t, err)
+	require.ErrorIs(t, f.revoke(creator, veteran), types.ErrUnauthorized)
+	require.NoError(t, f.revoke(authority, veteran))
+	require.Equal(t, []string{legend}, ids(f.keeper.GetNFTsByOwner(f.ctx, alice)))
+}
@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"skaffacity/x/nft/types"
+)
+
+// mintEntries returns batch entries minting the items ids to alice
+func mintEntries(nftIDs ...string) []types.MintEntry {
+	entries := make([]types.MintEntry, len(nftIDs))
+	for i, id := range nftIDs {
+		entries[i] = types.MintEntry{ID: id, NFTType: types.TypeItem, Recipient: alice, Metadata: types.Metadata{Name: id}}
+	}
+	return entries
+}
+
+// gasUsed runs fn on a branch of the store with a fresh gas meter and
+// returns the gas it consumed
+func (f fixture) gasUsed(t *testing.T, fn func(ctx sdk.Context)) uint64 {
+	t.Helper()
+
+	ctx, _ := f.ctx.CacheContext()
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	fn(ctx)
+	return ctx.GasMeter().GasConsumed()
+}
+
+func TestBatchMintIsAtomic(t *testing.T) {
+	f := setupKeeper(t)
+	existing := f.mint(t, "3", types.TypeItem, bob)
+
+	_, err := f.msgServer.BatchMintNFT(sdk.WrapSDKContext(f.ctx), types.NewMsgBatchMintNFT(creator, "city", mintEntries("1", "2", "3", "4")))
+	require.ErrorIs(t, err, types.ErrNFTExists)
+	require.Equal(t, []string{existing}, ids(f.keeper.GetNFTsByClass(f.ctx, "city")))
+	require.Equal(t, uint64(1), f.keeper.GetClassMinted(f.ctx, "city"))
+
+	res, err := f.msgServer.BatchMintNFT(sdk.WrapSDKContext(f.ctx), types.NewMsgBatchMintNFT(creator, "city", mintEntries("1", "2")))
+	require.NoError(t, err)
+	require.Equal(t, []string{types.NFTID("city", "1"), types.NFTID("city", "2")}, res.IDs)
+	require.Len(t, f.keeper.GetNFTsByOwner(f.ctx, alice), 2)
+}
+
+func TestBatchTransferIsAtomic(t *testing.T) {
+	f := setupKeeper(t)
+	sword := f.mint(t, "sword", types.TypeItem, alice)
+	shield := f.mint(t, "shield", types.TypeItem, alice)
+	notOwned := f.mint(t, "axe", types.TypeItem, bob)
+
+	transfers := []types.TransferEntry{{ID: sword, Recipient: bob}, {ID: shield, Recipient: bob}, {ID: notOwned, Recipient: bob}}
+	_, err := f.msgServer.BatchTransferNFT(sdk.WrapSDKContext(f.ctx), types.NewMsgBatchTransferNFT(alice, transfers))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.Equal(t, alice, f.nft(t, sword).Owner)
+	require.Equal(t, alice, f.nft(t, shield).Owner)
+
+	_, err = f.msgServer.BatchTransferNFT(sdk.WrapSDKContext(f.ctx), types.NewMsgBatchTransferNFT(alice, transfers[:2]))
+	require.NoError(t, err)
+	require.Empty(t, f.keeper.GetNFTsByOwner(f.ctx, alice))
+	require.Len(t, f.keeper.GetNFTsByOwner(f.ctx, bob), 3)
+}
+
+func TestBatchesCostAtLeastTheirSingleMessages(t *testing.T) {
+	f := setupKeeper(t)
+	batch := []string{"1", "2", "3", "4"}
+
+	singleMints := f.gasUsed(t, func(ctx sdk.Context) {
+		for _, mint := range types.NewMsgBatchMintNFT(creator, "city", mintEntries(batch...)).MintMsgs() {
+			_, err := f.msgServer.MintNFT(sdk.WrapSDKContext(ctx), mint)
+			require.NoError(t, err)
+		}
+	})
+	batchMint := f.gasUsed(t, func(ctx sdk.Context) {
+		_, err := f.msgServer.BatchMintNFT(sdk.WrapSDKContext(ctx), types.NewMsgBatchMintNFT(creator, "city", mintEntries(batch...)))
+		require.NoError(t, err)
+	})
+	require.GreaterOrEqual(t, batchMint, uint64(len(batch))*types.BatchMintGasPerItem)
+	require.GreaterOrEqual(t, batchMint, singleMints)
+
+	var transfers []types.TransferEntry
+	for _, id := range batch {
+		transfers = append(transfers, types.TransferEntry{ID: f.mint(t, id, types.TypeItem, alice), Recipient: bob})
+	}
+	singleTransfers := f.gasUsed(t, func(ctx sdk.Context) {
+		for _, transfer := range types.NewMsgBatchTransferNFT(alice, transfers).TransferMsgs() {
+			_, err := f.msgServer.TransferNFT(sdk.WrapSDKContext(ctx), transfer)
+			require.NoError(t, err)
+		}
+	})
+	batchTransfer := f.gasUsed(t, func(ctx sdk.Context) {
+		_, err := f.msgServer.BatchTransferNFT(sdk.WrapSDKContext(ctx), types.NewMsgBatchTransferNFT(alice, transfers))
+		require.NoError(t, err)
+	})
+	require.GreaterOrEqual(t, batchTransfer, uint64(len(batch))*types.BatchTransferGasPerItem)
+	require.GreaterOrEqual(t, batchTransfer, singleTransfers)
+}
@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"skaffacity/x/nft/types"
+)
+
+// CreateClass stores a new NFT class
+func (k Keeper) CreateClass(ctx sdk.Context, class types.Class) error {
+	if err := class.Validate(); err != nil {
+		return err
+	}
+
+	if k.HasClass(ctx, class.ID) {
+		return sdkerrors.Wrap(types.ErrClassExists, class.ID)
+	}
+
+	k.setClass(ctx, class)
+	return nil
+}
+
+// HasClass reports whether a class with the given ID exists
+func (k Keeper) HasClass(ctx sdk.Context, classID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetClassKey(classID))
+}
+
+// GetClass retrieves a class by ID
+func (k Keeper) GetClass(ctx sdk.Context, classID string) (types.Class, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetClassKey(classID))
+	if bz == nil {
+		return types.Class{}, sdkerrors.Wrap(types.ErrClassNotFound, classID)
+	}
+
+	var class types.Class
+	k.cdc.MustUnmarshal(bz, &class)
+	return class, nil
+}
+
+// GetAllClasses returns every class in ID order
+func (k Keeper) GetAllClasses(ctx sdk.Context) []types.Class {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ClassKey)
+	defer iterator.Close()
+
+	classes := []types.Class{}
+	for ; iterator.Valid(); iterator.Next() {
+		var class types.Class
+		k.cdc.MustUnmarshal(iterator.Value(), &class)
+		classes = append(classes, class)
+	}
+	return classes
+}
+
+// GetClassSupply returns the number of NFTs currently in a class
+func (k Keeper) GetClassSupply(ctx sdk.Context, classID string) uint64 {
+	return k.getCounter(ctx, types.GetClassSupplyKey(classID))
+}
+
+// GetClassMinted returns the number of NFTs ever minted in a class. Burned
+// NFTs still count, so MaxSupply cannot be bypassed by burning.
+func (k Keeper) GetClassMinted(ctx sdk.Context, classID string) uint64 {
+	return k.getCounter(ctx, types.GetClassMintedKey(classID))
+}
+
+// GetNFTsByClass returns all NFTs of a class
+func (k Keeper) GetNFTsByClass(ctx sdk.Context, classID string) []types.NFT {
+	return k.getIndexedNFTs(ctx, types.GetNFTByClassPrefix(classID))
+}
+
+// reserveClassSupply checks the class cap and records one more NFT in the class
+func (k Keeper) reserveClassSupply(ctx sdk.Context, classID string) error {
+	class, err := k.GetClass(ctx, classID)
+	if err != nil {
+		return err
+	}
+
+	minted := k.GetClassMinted(ctx, classID)
+	if class.MaxSupply > 0 && minted >= class.MaxSupply {
+		return sdkerrors.Wrapf(types.ErrMaxSupplyReached, "%s is capped at %d NFTs", classID, class.MaxSupply)
+	}
+
+	k.setCounter(ctx, types.GetClassMintedKey(classID), minted+1)
+	k.setCounter(ctx, types.GetClassSupplyKey(classID), k.GetClassSupply(ctx, classID)+1)
+	return nil
+}
+
+// SetClassMinted overwrites the number of NFTs ever minted in a class. It is
+// used by genesis import to carry the count over burned NFTs.
+func (k Keeper) SetClassMinted(ctx sdk.Context, classID string, minted uint64) {
+	k.setCounter(ctx, types.GetClassMintedKey(classID), minted)
+}
+
+// releaseClassSupply records that an NFT left the class
+func (k Keeper) releaseClassSupply(ctx sdk.Context, classID string) {
+	if supply := k.GetClassSupply(ctx, classID); supply > 0 {
+		k.setCounter(ctx, types.GetClassSupplyKey(classID), supply-1)
+	}
+}
+
+func (k Keeper) setClass(ctx sdk.Context, class types.Class) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetClassKey(class.ID), k.cdc.MustMarshal(&class))
+}
+
+func (k Keeper) getCounter(ctx sdk.Context, key []byte) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(key)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setCounter(ctx sdk.Context, key []byte, value uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(key, sdk.Uint64ToBigEndian(value))
+}
+
+// UpdateNFTMetadata replaces the metadata of an NFT on behalf of sender and
+// returns the names of the fields that changed, sorted. Each changed field
+// must be mutable by sender under the class mutability: as the NFT owner, as
+// the class mint authority, or both.
+func (k Keeper) UpdateNFTMetadata(ctx sdk.Context, id, sender string, metadata types.Metadata) ([]string, error) {
+	nft, err := k.GetNFT(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	class, err := k.GetClass(ctx, nft.ClassID)
+	if err != nil {
+		return nil, err
+	}
+
+	isOwner := nft.Owner == sender
+	isAuthority := class.MintAuthority == sender
+	if !isOwner && !isAuthority {
+		return nil, sdkerrors.Wrap(types.ErrUnauthorized, "sender is neither the owner nor the class mint authority")
+	}
+
+	changed := class.Mutability.ChangedFields(nft.Metadata, metadata)
+	if len(changed) == 0 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "metadata of %s is unchanged", id)
+	}
+
+	fields := make([]string, 0, len(changed))
+	for field := range changed {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		if !types.MutableBy(changed[field], isOwner, isAuthority) {
+			return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "%s of class %s cannot be changed by %s", field, class.ID, sender)
+		}
+	}
+
+	nft.Metadata = metadata
+	if err := k.UpdateNFT(ctx, nft); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
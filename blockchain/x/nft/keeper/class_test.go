@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"skaffacity/x/nft/types"
+)
+
+// mintIn mints id as an item of classID to alice on behalf of sender
+func (f fixture) mintIn(sender, classID, id string) error {
+	_, err := f.msgServer.MintNFT(sdk.WrapSDKContext(f.ctx), types.NewMsgMintNFT(sender, classID, id, types.TypeItem, alice, types.Metadata{Name: id}))
+	return err
+}
+
+func TestOnlyTheMintAuthorityMints(t *testing.T) {
+	f := setupKeeper(t)
+
+	require.ErrorIs(t, f.mintIn(alice, "city", "sword"), types.ErrUnauthorized)
+	require.ErrorIs(t, f.mintIn(creator, "town", "sword"), types.ErrClassNotFound)
+	require.NoError(t, f.mintIn(creator, "city", "sword"))
+
+	_, err := f.msgServer.CreateClass(sdk.WrapSDKContext(f.ctx), types.NewMsgCreateClass(alice, "city", "City", "", alice, 0, true, types.RoyaltyInfo{}, types.MetadataMutability{}))
+	require.ErrorIs(t, err, types.ErrClassExists)
+}
+
+func TestClassSupplyIsCapped(t *testing.T) {
+	f := newKeeper(t)
+	f.createClass(t, "relics", 2, types.MetadataMutability{})
+
+	require.NoError(t, f.mintIn(creator, "relics", "1"))
+	require.NoError(t, f.mintIn(creator, "relics", "2"))
+	require.ErrorIs(t, f.mintIn(creator, "relics", "3"), types.ErrMaxSupplyReached)
+	require.Equal(t, uint64(2), f.keeper.GetClassSupply(f.ctx, "relics"))
+	require.Equal(t, uint64(2), f.keeper.GetClassMinted(f.ctx, "relics"))
+}
+
+func TestBurnedNFTsStillCountAgainstTheCap(t *testing.T) {
+	f := newKeeper(t)
+	f.createClass(t, "relics", 2, types.MetadataMutability{})
+	require.NoError(t, f.mintIn(creator, "relics", "1"))
+	require.NoError(t, f.mintIn(creator, "relics", "2"))
+
+	_, err := f.msgServer.BurnNFT(sdk.WrapSDKContext(f.ctx), types.NewMsgBurnNFT(alice, types.NFTID("relics", "1")))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), f.keeper.GetClassSupply(f.ctx, "relics"))
+	require.Equal(t, uint64(2), f.keeper.GetClassMinted(f.ctx, "relics"))
+
+	require.ErrorIs(t, f.mintIn(creator, "relics", "3"), types.ErrMaxSupplyReached)
+	require.ErrorIs(t, f.mintIn(creator, "relics", "1"), types.ErrMaxSupplyReached)
+}
+
+func TestMetadataMutability(t *testing.T) {
+	testCases := []struct {
+		name     string
+		sender   string
+		metadata types.Metadata
+		err      error
+	}{
+		{"owner renames", alice, types.Metadata{Name: "Excalibur", Description: "sword"}, nil},
+		{"authority cannot rename", creator, types.Metadata{Name: "Excalibur", Description: "sword"}, types.ErrUnauthorized},
+		{"authority describes", creator, types.Metadata{Name: "sword", Description: "a blade"}, nil},
+		{"owner cannot describe", alice, types.Metadata{Name: "sword", Description: "a blade"}, types.ErrUnauthorized},
+		{"owner changes image", alice, types.Metadata{Name: "sword", Description: "sword", Image: "ipfs://a"}, nil},
+		{"authority changes image", creator, types.Metadata{Name: "sword", Description: "sword", Image: "ipfs://a"}, nil},
+		{"properties are frozen", creator, types.Metadata{Name: "sword", Description: "sword", Properties: map[string]string{"damage": "9"}}, types.ErrUnauthorized},
+		{"one frozen field fails the update", alice, types.Metadata{Name: "Excalibur", Description: "sword", Properties: map[string]string{"damage": "9"}}, types.ErrUnauthorized},
+		{"stranger", bob, types.Metadata{Name: "Excalibur", Description: "sword"}, types.ErrUnauthorized},
+		{"no change", alice, types.Metadata{Name: "sword", Description: "sword"}, sdkerrors.ErrInvalidRequest},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newKeeper(t)
+			f.createClass(t, "weapons", 0, types.MetadataMutability{
+				Name:        types.MutabilityOwner,
+				Description: types.MutabilityAuthority,
+				Image:       types.MutabilityOwnerOrAuthority,
+			})
+			original := types.Metadata{Name: "sword", Description: "sword"}
+			_, err := f.msgServer.MintNFT(sdk.WrapSDKContext(f.ctx), types.NewMsgMintNFT(creator, "weapons", "1", types.TypeItem, alice, original))
+			require.NoError(t, err)
+			id := types.NFTID("weapons", "1")
+
+			_, err = f.msgServer.UpdateNFTMetadata(sdk.WrapSDKContext(f.ctx), types.NewMsgUpdateNFTMetadata(tc.sender, id, tc.metadata))
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				require.Equal(t, original, f.nft(t, id).Metadata)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.metadata, f.nft(t, id).Metadata)
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"skaffacity/x/nft"
+	"skaffacity/x/nft/types"
+)
+
+// populate fills "city" with an item carrying an attachment, a land parcel,
+// a badge, a burned NFT and a rental of the item to bob
+func (f fixture) populate(t *testing.T) {
+	t.Helper()
+	goCtx := sdk.WrapSDKContext(f.ctx)
+
+	sword := f.mint(t, "sword", types.TypeItem, alice)
+	gem := f.mint(t, "gem", types.TypeAttachment, alice)
+	require.NoError(t, f.attach(alice, sword, gem))
+
+	_, err := f.mintLand("plot", 5, 5, 2)
+	require.NoError(t, err)
+
+	_, err = f.msgServer.AddBadgeIssuer(goCtx, types.NewMsgAddBadgeIssuer(authority, creator, "Game"))
+	require.NoError(t, err)
+	_, err = f.mintBadge("founder", true)
+	require.NoError(t, err)
+
+	burned := f.mint(t, "axe", types.TypeItem, alice)
+	_, err = f.msgServer.BurnNFT(goCtx, types.NewMsgBurnNFT(alice, burned))
+	require.NoError(t, err)
+
+	_, err = f.msgServer.SetUser(goCtx, types.NewMsgSetUser(alice, sword, bob, genesisTime.Add(time.Hour)))
+	require.NoError(t, err)
+}
+
+func TestGenesisRoundTrip(t *testing.T) {
+	f := setupKeeper(t)
+	f.populate(t)
+
+	exported := nft.ExportGenesis(f.ctx, *f.keeper)
+	require.NoError(t, exported.Validate())
+	require.Len(t, exported.NFTs, 4)
+	require.Equal(t, []types.ClassMinted{{ClassID: "city", Minted: 5}}, exported.ClassMinted)
+	require.Len(t, exported.Rentals, 1)
+
+	imported := newKeeper(t)
+	nft.InitGenesis(imported.ctx, *imported.keeper, *exported)
+	require.Equal(t, exported, nft.ExportGenesis(imported.ctx, *imported.keeper))
+
+	// the indexes are rebuilt along with the records
+	sword, gem, plot := types.NFTID("city", "sword"), types.NFTID("city", "gem"), types.NFTID("city", "plot")
+	require.Equal(t, []string{types.NFTID("city", "founder"), gem, plot, sword}, ids(imported.keeper.GetNFTsByOwner(imported.ctx, alice)))
+	require.Equal(t, []string{gem}, ids(imported.keeper.GetAttachments(imported.ctx, sword)))
+	land, err := imported.keeper.GetLandAt(imported.ctx, 6, 6)
+	require.NoError(t, err)
+	require.Equal(t, plot, land.ID)
+	require.Equal(t, []string{sword}, ids(imported.keeper.GetNFTsByUser(imported.ctx, bob)))
+	require.True(t, imported.keeper.IsBadgeIssuer(imported.ctx, creator))
+
+	// the burned NFT still counts as minted
+	require.Equal(t, uint64(4), imported.keeper.GetClassSupply(imported.ctx, "city"))
+	require.Equal(t, uint64(5), imported.keeper.GetClassMinted(imported.ctx, "city"))
+
+	// and the rental still expires on time
+	require.Len(t, imported.keeper.ExpireRentals(imported.ctx.WithBlockTime(genesisTime.Add(time.Hour))), 1)
+}
+
+func TestGenesisValidation(t *testing.T) {
+	valid := func() *types.GenesisState {
+		f := setupKeeper(t)
+		f.populate(t)
+		return nft.ExportGenesis(f.ctx, *f.keeper)
+	}
+	// nftAt returns the exported NFT with the given class-local ID
+	nftAt := func(genesis *types.GenesisState, id string) *types.NFT {
+		for i := range genesis.NFTs {
+			if genesis.NFTs[i].ID == types.NFTID("city", id) {
+				return &genesis.NFTs[i]
+			}
+		}
+		require.FailNow(t, "NFT not exported", id)
+		return nil
+	}
+
+	testCases := []struct {
+		name   string
+		modify func(genesis *types.GenesisState)
+		err    error
+	}{
+		{"duplicate class", func(g *types.GenesisState) {
+			g.Classes = append(g.Classes, g.Classes[0])
+		}, types.ErrClassExists},
+		{"duplicate NFT", func(g *types.GenesisState) {
+			g.NFTs = append(g.NFTs, *nftAt(g, "sword"))
+		}, types.ErrNFTExists},
+		{"unknown class", func(g *types.GenesisState) {
+			g.Classes = nil
+			g.ClassMinted = nil
+		}, types.ErrClassNotFound},
+		{"overlapping land", func(g *types.GenesisState) {
+			other := *nftAt(g, "plot")
+			other.ID = types.NFTID("city", "other")
+			other.Land = &types.LandMetadata{Location: types.Location{X: 6, Y: 6}, Size: 1}
+			g.NFTs = append(g.NFTs, other)
+		}, types.ErrLandOverlap},
+		{"attachment cycle", func(g *types.GenesisState) {
+			sword := nftAt(g, "sword")
+			nftAt(g, "gem").Parent = types.NFTID("city", "gem")
+			sword.Parent = ""
+		}, types.ErrInvalidAttachment},
+		{"attachment of another owner", func(g *types.GenesisState) {
+			nftAt(g, "gem").Owner = bob
+		}, types.ErrInvalidAttachment},
+		{"minted over the cap", func(g *types.GenesisState) {
+			g.Classes[0].MaxSupply = 4
+		}, types.ErrMaxSupplyReached},
+		{"rental of an unknown NFT", func(g *types.GenesisState) {
+			g.Rentals[0].NFTID = types.NFTID("city", "axe")
+		}, types.ErrNFTNotFound},
+		{"rental of a badge", func(g *types.GenesisState) {
+			g.Rentals[0].NFTID = types.NFTID("city", "founder")
+		}, types.ErrInvalidRental},
+	}
+
+	require.NoError(t, valid().Validate())
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			genesis := valid()
+			tc.modify(genesis)
+			require.ErrorIs(t, genesis.Validate(), tc.err)
+		})
+	}
+}
@@ -1,65 +1,343 @@
 package keeper
 
 import (
-    "context"
-    
-    "google.golang.org/grpc/codes"
-    "google.golang.org/grpc/status"
-    
-    "skaffacity/x/nft/types"
+	"context"
+	"errors"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"skaffacity/x/nft/types"
 )
 
 type queryServer struct {
-    Keeper
+	Keeper
 }
 
 func NewQueryServer(k Keeper) types.QueryServer {
-    return &queryServer{Keeper: k}
+	return &queryServer{Keeper: k}
 }
 
+var _ types.QueryServer = queryServer{}
+
 // NFT returns the NFT information
 func (k queryServer) NFT(c context.Context, req *types.QueryNFTRequest) (*types.QueryNFTResponse, error) {
-    if req == nil {
-        return nil, status.Error(codes.InvalidArgument, "invalid request")
-    }
-    return &types.QueryNFTResponse{}, nil
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	nft, err := k.GetNFT(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryNFTResponse{Nft: nft}, nil
 }
 
 // NFTs returns all NFTs owned by an address
 func (k queryServer) NFTs(c context.Context, req *types.QueryNFTsRequest) (*types.QueryNFTsResponse, error) {
-    if req == nil {
-        return nil, status.Error(codes.InvalidArgument, "invalid request")
-    }
-    return &types.QueryNFTsResponse{}, nil
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Owner); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	nfts, pageRes, err := k.paginateIndex(ctx, types.GetNFTByOwnerPrefix(req.Owner), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryNFTsResponse{Nfts: nfts, Pagination: pageRes}, nil
 }
 
 // Land returns all land NFTs
 func (k queryServer) Land(c context.Context, req *types.QueryLandRequest) (*types.QueryLandResponse, error) {
-    if req == nil {
-        return nil, status.Error(codes.InvalidArgument, "invalid request")
-    }
-    return &types.QueryLandResponse{}, nil
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	land, pageRes, err := k.paginateIndex(ctx, types.GetNFTByTypePrefix(types.TypeLand), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryLandResponse{Land: land, Pagination: pageRes}, nil
 }
 
 // Items returns all item NFTs
 func (k queryServer) Items(c context.Context, req *types.QueryItemsRequest) (*types.QueryItemsResponse, error) {
-    if req == nil {
-        return nil, status.Error(codes.InvalidArgument, "invalid request")
-    }
-    return &types.QueryItemsResponse{}, nil
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	items, pageRes, err := k.paginateIndex(ctx, types.GetNFTByTypePrefix(types.TypeItem), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryItemsResponse{Items: items, Pagination: pageRes}, nil
 }
 
 // Badges returns all badge NFTs
 func (k queryServer) Badges(c context.Context, req *types.QueryBadgesRequest) (*types.QueryBadgesResponse, error) {
-    if req == nil {
-        return nil, status.Error(codes.InvalidArgument, "invalid request")
-    }
-    return &types.QueryBadgesResponse{}, nil
-}
-
-// Remove all logic and invalid references in grpc_query.go for nft
-// All methods are now valid stubs with correct signatures and no invalid code
-// func (k queryServer) GetNFTsByOwner(ctx context.Context, req *types.QueryNFTsRequest) (*types.QueryNFTsResponse, error) { return &types.QueryNFTsResponse{}, nil }
-// func (k queryServer) GetAllLand(ctx context.Context, req *types.QueryLandRequest) (*types.QueryLandResponse, error) { return &types.QueryLandResponse{}, nil }
-// func (k queryServer) GetAllItems(ctx context.Context, req *types.QueryItemsRequest) (*types.QueryItemsResponse, error) { return &types.QueryItemsResponse{}, nil }
-// func (k queryServer) GetAllBadges(ctx context.Context, req *types.QueryBadgesRequest) (*types.QueryBadgesResponse, error) { return &types.QueryBadgesResponse{}, nil }
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	badges, pageRes, err := k.paginateIndex(ctx, types.GetNFTByTypePrefix(types.TypeBadge), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBadgesResponse{Badges: badges, Pagination: pageRes}, nil
+}
+
+// LandAt returns the land parcel covering a map cell
+func (k queryServer) LandAt(c context.Context, req *types.QueryLandAtRequest) (*types.QueryLandAtResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	land, err := k.GetLandAt(ctx, req.X, req.Y)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryLandAtResponse{Land: land}, nil
+}
+
+// LandInArea returns the land parcels overlapping a bounding box
+func (k queryServer) LandInArea(c context.Context, req *types.QueryLandInAreaRequest) (*types.QueryLandInAreaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	land, err := k.GetLandInArea(ctx, req.MinX, req.MinY, req.MaxX, req.MaxY)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryLandInAreaResponse{Land: land}, nil
+}
+
+// LandNeighbors returns the land parcels adjacent to a parcel
+func (k queryServer) LandNeighbors(c context.Context, req *types.QueryLandNeighborsRequest) (*types.QueryLandNeighborsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	neighbors, err := k.GetLandNeighbors(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, types.ErrNFTNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryLandNeighborsResponse{Neighbors: neighbors}, nil
+}
+
+// Attachments returns the full attachment tree below an NFT
+func (k queryServer) Attachments(c context.Context, req *types.QueryAttachmentsRequest) (*types.QueryAttachmentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.HasNFT(ctx, req.Id) {
+		return nil, status.Errorf(codes.NotFound, "NFT %s not found", req.Id)
+	}
+
+	return &types.QueryAttachmentsResponse{Attachments: k.GetAttachmentTree(ctx, req.Id)}, nil
+}
+
+// PlayerBadges returns the badges held by a player
+func (k queryServer) PlayerBadges(c context.Context, req *types.QueryPlayerBadgesRequest) (*types.QueryPlayerBadgesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Owner); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetNFTByOwnerPrefix(req.Owner))
+
+	badges := []types.NFT{}
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		nft, err := k.GetNFT(ctx, string(value))
+		if err != nil {
+			return false, err
+		}
+		if nft.Type != types.TypeBadge {
+			return false, nil
+		}
+		if accumulate {
+			badges = append(badges, nft)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPlayerBadgesResponse{Badges: badges, Pagination: pageRes}, nil
+}
+
+// BadgeIssuers returns the registered badge issuers
+func (k queryServer) BadgeIssuers(c context.Context, req *types.QueryBadgeIssuersRequest) (*types.QueryBadgeIssuersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BadgeIssuerKey)
+
+	issuers := []types.BadgeIssuer{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var issuer types.BadgeIssuer
+		if err := k.cdc.Unmarshal(value, &issuer); err != nil {
+			return err
+		}
+		issuers = append(issuers, issuer)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBadgeIssuersResponse{Issuers: issuers, Pagination: pageRes}, nil
+}
+
+// Class returns a class with its supply counters
+func (k queryServer) Class(c context.Context, req *types.QueryClassRequest) (*types.QueryClassResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	class, err := k.GetClass(ctx, req.ClassId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryClassResponse{
+		Class:  class,
+		Supply: k.GetClassSupply(ctx, class.ID),
+		Minted: k.GetClassMinted(ctx, class.ID),
+	}, nil
+}
+
+// Classes returns all classes
+func (k queryServer) Classes(c context.Context, req *types.QueryClassesRequest) (*types.QueryClassesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClassKey)
+
+	classes := []types.Class{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var class types.Class
+		if err := k.cdc.Unmarshal(value, &class); err != nil {
+			return err
+		}
+		classes = append(classes, class)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryClassesResponse{Classes: classes, Pagination: pageRes}, nil
+}
+
+// ClassNFTs returns the NFTs in a class
+func (k queryServer) ClassNFTs(c context.Context, req *types.QueryClassNFTsRequest) (*types.QueryClassNFTsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.HasClass(ctx, req.ClassId) {
+		return nil, status.Errorf(codes.NotFound, "class %s not found", req.ClassId)
+	}
+
+	nfts, pageRes, err := k.paginateIndex(ctx, types.GetNFTByClassPrefix(req.ClassId), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryClassNFTsResponse{Nfts: nfts, Pagination: pageRes}, nil
+}
+
+// EffectiveUser returns the account entitled to use an NFT
+func (k queryServer) EffectiveUser(c context.Context, req *types.QueryEffectiveUserRequest) (*types.QueryEffectiveUserResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	nft, err := k.GetNFT(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	res := &types.QueryEffectiveUserResponse{User: nft.Owner, Owner: nft.Owner}
+	if rental, found := k.GetRental(ctx, nft.ID); found && rental.Active(ctx.BlockTime()) {
+		res.User = rental.User
+		res.Rental = &rental
+	}
+	return res, nil
+}
+
+// UserRentals returns the NFTs rented to an account
+func (k queryServer) UserRentals(c context.Context, req *types.QueryUserRentalsRequest) (*types.QueryUserRentalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.User); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	nfts, pageRes, err := k.paginateIndex(ctx, types.GetNFTByUserPrefix(req.User), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryUserRentalsResponse{Nfts: nfts, Pagination: pageRes}, nil
+}
+
+// paginateIndex pages through an index prefix and resolves the referenced NFTs
+func (k queryServer) paginateIndex(ctx sdk.Context, indexPrefix []byte, pageReq *query.PageRequest) ([]types.NFT, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), indexPrefix)
+
+	nfts := []types.NFT{}
+	pageRes, err := query.Paginate(store, pageReq, func(key []byte, value []byte) error {
+		nft, err := k.GetNFT(ctx, string(value))
+		if err != nil {
+			return err
+		}
+		nfts = append(nfts, nft)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return nfts, pageRes, nil
+}
@@ -0,0 +1,201 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"skaffacity/x/nft/types"
+	"skaffacity/x/nft/types/v1beta1"
+)
+
+// standardQueryServer serves the cosmos.nft.v1beta1 query service from the
+// skaffacity NFT store
+type standardQueryServer struct {
+	queryServer
+}
+
+// NewStandardQueryServer returns the cosmos.nft.v1beta1 query service backed by k
+func NewStandardQueryServer(k Keeper) v1beta1.QueryServer {
+	return &standardQueryServer{queryServer: queryServer{Keeper: k}}
+}
+
+var _ v1beta1.QueryServer = standardQueryServer{}
+
+// Balance returns the number of NFTs of a class owned by an address
+func (k standardQueryServer) Balance(c context.Context, req *v1beta1.QueryBalanceRequest) (*v1beta1.QueryBalanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := types.ValidateClassID(req.ClassId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Owner); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), ownerClassPrefix(req.Owner, req.ClassId))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var amount uint64
+	for ; iterator.Valid(); iterator.Next() {
+		amount++
+	}
+
+	return &v1beta1.QueryBalanceResponse{Amount: amount}, nil
+}
+
+// Owner returns the owner of an NFT
+func (k standardQueryServer) Owner(c context.Context, req *v1beta1.QueryOwnerRequest) (*v1beta1.QueryOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	nft, err := k.GetNFT(ctx, types.NFTID(req.ClassId, req.Id))
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &v1beta1.QueryOwnerResponse{Owner: nft.Owner}, nil
+}
+
+// Supply returns the number of NFTs currently in a class
+func (k standardQueryServer) Supply(c context.Context, req *v1beta1.QuerySupplyRequest) (*v1beta1.QuerySupplyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := types.ValidateClassID(req.ClassId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &v1beta1.QuerySupplyResponse{Amount: k.GetClassSupply(ctx, req.ClassId)}, nil
+}
+
+// NFTs returns the NFTs of a class, of an owner, or of an owner within a class
+func (k standardQueryServer) NFTs(c context.Context, req *v1beta1.QueryNFTsRequest) (*v1beta1.QueryNFTsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.ClassId != "" {
+		if err := types.ValidateClassID(req.ClassId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if req.Owner != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Owner); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var indexPrefix []byte
+	switch {
+	case req.ClassId != "" && req.Owner != "":
+		indexPrefix = ownerClassPrefix(req.Owner, req.ClassId)
+	case req.Owner != "":
+		indexPrefix = types.GetNFTByOwnerPrefix(req.Owner)
+	case req.ClassId != "":
+		indexPrefix = types.GetNFTByClassPrefix(req.ClassId)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "must provide at least one of class_id or owner")
+	}
+
+	nfts, pageRes, err := k.paginateIndex(ctx, indexPrefix, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &v1beta1.QueryNFTsResponse{Nfts: make([]*v1beta1.NFT, len(nfts)), Pagination: pageRes}
+	for i, nft := range nfts {
+		res.Nfts[i] = standardNFT(nft)
+	}
+	return res, nil
+}
+
+// NFT returns a single NFT by class and class-local ID
+func (k standardQueryServer) NFT(c context.Context, req *v1beta1.QueryNFTRequest) (*v1beta1.QueryNFTResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	nft, err := k.GetNFT(ctx, types.NFTID(req.ClassId, req.Id))
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &v1beta1.QueryNFTResponse{Nft: standardNFT(nft)}, nil
+}
+
+// Class returns a single class
+func (k standardQueryServer) Class(c context.Context, req *v1beta1.QueryClassRequest) (*v1beta1.QueryClassResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	class, err := k.GetClass(ctx, req.ClassId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &v1beta1.QueryClassResponse{Class: standardClass(class)}, nil
+}
+
+// Classes returns all classes
+func (k standardQueryServer) Classes(c context.Context, req *v1beta1.QueryClassesRequest) (*v1beta1.QueryClassesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClassKey)
+
+	classes := []*v1beta1.Class{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var class types.Class
+		if err := k.cdc.Unmarshal(value, &class); err != nil {
+			return err
+		}
+		classes = append(classes, standardClass(class))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1beta1.QueryClassesResponse{Classes: classes, Pagination: pageRes}, nil
+}
+
+// ownerClassPrefix narrows the owner index to the NFTs of one class, whose
+// IDs all start with "<class_id>:"
+func ownerClassPrefix(owner, classID string) []byte {
+	return append(types.GetNFTByOwnerPrefix(owner), []byte(types.NFTID(classID, ""))...)
+}
+
+// standardNFT converts an NFT to its cosmos.nft.v1beta1 form
+func standardNFT(nft types.NFT) *v1beta1.NFT {
+	return &v1beta1.NFT{
+		ClassId: nft.ClassID,
+		Id:      strings.TrimPrefix(nft.ID, types.NFTID(nft.ClassID, "")),
+		Uri:     nft.Metadata.Image,
+	}
+}
+
+// standardClass converts a class to its cosmos.nft.v1beta1 form
+func standardClass(class types.Class) *v1beta1.Class {
+	return &v1beta1.Class{
+		Id:          class.ID,
+		Name:        class.Name,
+		Description: class.Description,
+	}
+}
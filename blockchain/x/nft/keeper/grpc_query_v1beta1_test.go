@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/stretchr/testify/require"
+
+	"skaffacity/x/nft/keeper"
+	"skaffacity/x/nft/types"
+	"skaffacity/x/nft/types/v1beta1"
+)
+
+// standardClient returns a cosmos.nft.v1beta1 client whose requests and
+// responses go through protobuf encoding, as they do for a real wallet
+func (f fixture) standardClient() v1beta1.QueryClient {
+	helper := baseapp.NewQueryServerTestHelper(f.ctx, f.registry)
+	v1beta1.RegisterQueryServer(helper, keeper.NewStandardQueryServer(*f.keeper))
+	return v1beta1.NewQueryClient(helper)
+}
+
+func TestStandardRequestsUseTheCosmosWireFormat(t *testing.T) {
+	// field 1 (class_id) and field 2 (id), both length-delimited, exactly as
+	// cosmos/nft/v1beta1/query.proto lays out QueryOwnerRequest
+	bz, err := (&v1beta1.QueryOwnerRequest{ClassId: "swords", Id: "1"}).Marshal()
+	require.NoError(t, err)
+	require.Equal(t, append(append([]byte{0x0a, 0x06}, "swords"...), 0x12, 0x01, '1'), bz)
+
+	var req v1beta1.QueryOwnerRequest
+	require.NoError(t, req.Unmarshal(bz))
+	require.Equal(t, "swords", req.ClassId)
+	require.Equal(t, "1", req.Id)
+}
+
+func TestStandardQueries(t *testing.T) {
+	f := setupKeeper(t)
+	f.mint(t, "sword", types.TypeItem, alice)
+	f.mint(t, "shield", types.TypeItem, alice)
+	f.mint(t, "gem", types.TypeAttachment, bob)
+
+	client := f.standardClient()
+	ctx := context.Background()
+
+	owner, err := client.Owner(ctx, &v1beta1.QueryOwnerRequest{ClassId: "city", Id: "sword"})
+	require.NoError(t, err)
+	require.Equal(t, alice, owner.Owner)
+
+	balance, err := client.Balance(ctx, &v1beta1.QueryBalanceRequest{ClassId: "city", Owner: alice})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), balance.Amount)
+
+	supply, err := client.Supply(ctx, &v1beta1.QuerySupplyRequest{ClassId: "city"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), supply.Amount)
+
+	nft, err := client.NFT(ctx, &v1beta1.QueryNFTRequest{ClassId: "city", Id: "gem"})
+	require.NoError(t, err)
+	require.Equal(t, &v1beta1.NFT{ClassId: "city", Id: "gem"}, nft.Nft)
+
+	nfts, err := client.NFTs(ctx, &v1beta1.QueryNFTsRequest{ClassId: "city", Owner: alice})
+	require.NoError(t, err)
+	require.Len(t, nfts.Nfts, 2)
+	require.Equal(t, "shield", nfts.Nfts[0].Id)
+
+	class, err := client.Class(ctx, &v1beta1.QueryClassRequest{ClassId: "city"})
+	require.NoError(t, err)
+	require.Equal(t, &v1beta1.Class{Id: "city", Name: "city"}, class.Class)
+
+	_, err = client.Owner(ctx, &v1beta1.QueryOwnerRequest{ClassId: "city", Id: "axe"})
+	require.Error(t, err)
+}
@@ -1,95 +1,287 @@
 package keeper
 
 import (
-    "context"
-    "github.com/cosmos/cosmos-sdk/codec"
-    storetypes "github.com/cosmos/cosmos-sdk/store/types"
-    sdk "github.com/cosmos/cosmos-sdk/types"
-    sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-    "skaffacity/x/nft/types"
-    typekeeper "skaffacity/x/nft/types/keeper"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"skaffacity/x/nft/types"
+	typekeeper "skaffacity/x/nft/types/keeper"
 )
 
 type Keeper struct {
-    storeKey    storetypes.StoreKey
-    cdc         codec.BinaryCodec
-    bankKeeper  typekeeper.BankKeeper
+	storeKey   storetypes.StoreKey
+	cdc        codec.BinaryCodec
+	bankKeeper typekeeper.BankKeeper
+
+	// authority is the address allowed to manage module-wide settings such
+	// as the badge issuer registry, usually the governance module account
+	authority string
 }
 
 func NewKeeper(
-    cdc codec.BinaryCodec,
-    storeKey storetypes.StoreKey,
-    bankKeeper typekeeper.BankKeeper,
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	bankKeeper typekeeper.BankKeeper,
+	authority string,
 ) *Keeper {
-    return &Keeper{
-        storeKey:   storeKey,
-        cdc:        cdc,
-        bankKeeper: bankKeeper,
-    }
+	return &Keeper{
+		storeKey:   storeKey,
+		cdc:        cdc,
+		bankKeeper: bankKeeper,
+		authority:  authority,
+	}
+}
+
+// GetAuthority returns the module's authority address
+func (k Keeper) GetAuthority() string {
+	return k.authority
 }
 
-// MintNFT creates a new NFT
+// MintNFT creates a new NFT in its class, counting it against the class supply
 func (k Keeper) MintNFT(ctx sdk.Context, nft types.NFT) error {
-    store := ctx.KVStore(k.storeKey)
-    key := append([]byte(nft.Type + "/"), []byte(nft.ID)...)
-    
-    if store.Has(key) {
-        return sdkerrors.Wrap(types.ErrNFTExists, "NFT already exists")
-    }
-    
-    bz := k.cdc.MustMarshal(&nft)
-    store.Set(key, bz)
-    return nil
+	if k.HasNFT(ctx, nft.ID) {
+		return sdkerrors.Wrap(types.ErrNFTExists, nft.ID)
+	}
+
+	if types.ClassIDFromNFTID(nft.ID) != nft.ClassID {
+		return sdkerrors.Wrapf(types.ErrInvalidClass, "NFT id %s is not scoped to class %s", nft.ID, nft.ClassID)
+	}
+
+	if nft.Type == types.TypeLand {
+		if nft.Land == nil {
+			return sdkerrors.Wrap(types.ErrInvalidLand, "land NFTs require a location and size")
+		}
+		if err := nft.Land.Validate(); err != nil {
+			return err
+		}
+		if err := k.checkLandFree(ctx, *nft.Land); err != nil {
+			return err
+		}
+	} else if nft.Land != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidLand, "%s NFTs cannot carry land data", nft.Type)
+	}
+
+	if nft.Type == types.TypeBadge {
+		if nft.Badge == nil {
+			return sdkerrors.Wrap(types.ErrInvalidBadge, "badge NFTs require an achievement")
+		}
+		if nft.Transferable {
+			return sdkerrors.Wrap(types.ErrInvalidBadge, "badges are soulbound and cannot be transferable")
+		}
+	} else if nft.Badge != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidBadge, "%s NFTs cannot carry badge data", nft.Type)
+	}
+
+	if err := k.reserveClassSupply(ctx, nft.ClassID); err != nil {
+		return err
+	}
+
+	if nft.Land != nil {
+		k.setLandCells(ctx, nft.ID, *nft.Land)
+	}
+
+	k.setNFT(ctx, nft)
+	k.setIndexes(ctx, nft)
+	return nil
 }
 
 // TransferNFT transfers ownership of an NFT
 func (k Keeper) TransferNFT(ctx sdk.Context, nftID string, from, to string) error {
-    nft, err := k.GetNFT(ctx, nftID)
-    if err != nil {
-        return err
-    }
-    
-    if nft.Owner != from {
-        return sdkerrors.Wrap(types.ErrUnauthorized, "sender is not the owner")
-    }
-    
-    if !nft.Transferable {
-        return sdkerrors.Wrap(types.ErrNonTransferable, "NFT cannot be transferred")
-    }
-    
-    nft.Owner = to
-    return k.UpdateNFT(ctx, nft)
+	nft, err := k.GetNFT(ctx, nftID)
+	if err != nil {
+		return err
+	}
+
+	if nft.Owner != from {
+		return sdkerrors.Wrap(types.ErrUnauthorized, "sender is not the owner")
+	}
+
+	if !nft.Transferable || nft.Type == types.TypeBadge {
+		return sdkerrors.Wrap(types.ErrNonTransferable, "NFT cannot be transferred")
+	}
+
+	if nft.Parent != "" {
+		return sdkerrors.Wrapf(types.ErrNonTransferable, "%s is attached to %s", nftID, nft.Parent)
+	}
+
+	nft.Owner = to
+	if err := k.UpdateNFT(ctx, nft); err != nil {
+		return err
+	}
+
+	// attachments travel with their parent
+	return k.setAttachmentsOwner(ctx, nftID, to)
+}
+
+// BurnNFT removes an NFT and its index entries from the store. NFTs that
+// still hold attachments cannot be burned.
+func (k Keeper) BurnNFT(ctx sdk.Context, id string) error {
+	nft, err := k.GetNFT(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if k.HasAttachments(ctx, id) {
+		return sdkerrors.Wrapf(types.ErrInvalidAttachment, "detach the attachments of %s before burning it", id)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetNFTKey(id))
+	k.removeIndexes(ctx, nft)
+	if nft.Land != nil {
+		k.removeLandCells(ctx, *nft.Land)
+	}
+	k.releaseClassSupply(ctx, nft.ClassID)
+	if rental, found := k.GetRental(ctx, id); found {
+		k.removeRental(ctx, rental)
+	}
+	return nil
+}
+
+// HasNFT reports whether an NFT with the given ID exists
+func (k Keeper) HasNFT(ctx sdk.Context, id string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetNFTKey(id))
 }
 
 // GetNFT retrieves an NFT by ID
 func (k Keeper) GetNFT(ctx sdk.Context, id string) (types.NFT, error) {
-    store := ctx.KVStore(k.storeKey)
-    bz := store.Get([]byte(id))
-    if bz == nil {
-        return types.NFT{}, sdkerrors.Wrap(types.ErrNFTNotFound, id)
-    }
-    
-    var nft types.NFT
-    k.cdc.MustUnmarshal(bz, &nft)
-    return nft, nil
-}
-
-// UpdateNFT updates an existing NFT
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetNFTKey(id))
+	if bz == nil {
+		return types.NFT{}, sdkerrors.Wrap(types.ErrNFTNotFound, id)
+	}
+
+	var nft types.NFT
+	k.cdc.MustUnmarshal(bz, &nft)
+	return nft, nil
+}
+
+// UpdateNFT updates an existing NFT, keeping the indexes in sync
 func (k Keeper) UpdateNFT(ctx sdk.Context, nft types.NFT) error {
-    store := ctx.KVStore(k.storeKey)
-    key := append([]byte(nft.Type + "/"), []byte(nft.ID)...)
-    
-    if !store.Has(key) {
-        return sdkerrors.Wrap(types.ErrNFTNotFound, nft.ID)
-    }
-    
-    bz := k.cdc.MustMarshal(&nft)
-    store.Set(key, bz)
-    return nil
-}
-
-// Stub missing keeper methods for nft
-func (k Keeper) GetNFTsByOwner(ctx context.Context, req *types.QueryNFTsRequest) (*types.QueryNFTsResponse, error) { return nil, nil }
-func (k Keeper) GetAllLand(ctx context.Context, req *types.QueryLandRequest) (*types.QueryLandResponse, error) { return nil, nil }
-func (k Keeper) GetAllItems(ctx context.Context, req *types.QueryItemsRequest) (*types.QueryItemsResponse, error) { return nil, nil }
-func (k Keeper) GetAllBadges(ctx context.Context, req *types.QueryBadgesRequest) (*types.QueryBadgesResponse, error) { return nil, nil }
+	existing, err := k.GetNFT(ctx, nft.ID)
+	if err != nil {
+		return err
+	}
+
+	if existing.Type != nft.Type {
+		return sdkerrors.Wrapf(types.ErrInvalidNFTType, "cannot change type of %s", nft.ID)
+	}
+
+	if existing.ClassID != nft.ClassID {
+		return sdkerrors.Wrapf(types.ErrInvalidClass, "cannot change class of %s", nft.ID)
+	}
+
+	if existing.Land != nil && (nft.Land == nil ||
+		existing.Land.Location != nft.Land.Location || existing.Land.Size != nft.Land.Size) {
+		return sdkerrors.Wrapf(types.ErrInvalidLand, "cannot move or resize parcel %s", nft.ID)
+	}
+
+	if nft.Type == types.TypeBadge && (nft.Transferable || nft.Badge == nil || !nft.Badge.Equal(*existing.Badge)) {
+		return sdkerrors.Wrapf(types.ErrInvalidBadge, "cannot change the badge record of %s", nft.ID)
+	}
+
+	if existing.Owner != nft.Owner {
+		store := ctx.KVStore(k.storeKey)
+		store.Delete(types.GetNFTByOwnerKey(existing.Owner, existing.ID))
+		store.Set(types.GetNFTByOwnerKey(nft.Owner, nft.ID), []byte(nft.ID))
+	}
+
+	if existing.Parent != nft.Parent {
+		store := ctx.KVStore(k.storeKey)
+		if existing.Parent != "" {
+			store.Delete(types.GetNFTByParentKey(existing.Parent, existing.ID))
+		}
+		if nft.Parent != "" {
+			store.Set(types.GetNFTByParentKey(nft.Parent, nft.ID), []byte(nft.ID))
+		}
+	}
+
+	k.setNFT(ctx, nft)
+	return nil
+}
+
+// GetNFTsByOwner returns all NFTs owned by owner
+func (k Keeper) GetNFTsByOwner(ctx sdk.Context, owner string) []types.NFT {
+	return k.getIndexedNFTs(ctx, types.GetNFTByOwnerPrefix(owner))
+}
+
+// GetNFTsByType returns all NFTs of the given type
+func (k Keeper) GetNFTsByType(ctx sdk.Context, nftType string) []types.NFT {
+	return k.getIndexedNFTs(ctx, types.GetNFTByTypePrefix(nftType))
+}
+
+// GetAllLand returns all land NFTs
+func (k Keeper) GetAllLand(ctx sdk.Context) []types.NFT {
+	return k.GetNFTsByType(ctx, types.TypeLand)
+}
+
+// GetAllItems returns all item NFTs
+func (k Keeper) GetAllItems(ctx sdk.Context) []types.NFT {
+	return k.GetNFTsByType(ctx, types.TypeItem)
+}
+
+// GetAllBadges returns all badge NFTs
+func (k Keeper) GetAllBadges(ctx sdk.Context) []types.NFT {
+	return k.GetNFTsByType(ctx, types.TypeBadge)
+}
+
+// IterateNFTs iterates over all NFTs in ID order until cb returns true
+func (k Keeper) IterateNFTs(ctx sdk.Context, cb func(nft types.NFT) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.NFTKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var nft types.NFT
+		k.cdc.MustUnmarshal(iterator.Value(), &nft)
+		if cb(nft) {
+			break
+		}
+	}
+}
+
+// getIndexedNFTs resolves every NFT referenced under an index prefix
+func (k Keeper) getIndexedNFTs(ctx sdk.Context, indexPrefix []byte) []types.NFT {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), indexPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	nfts := []types.NFT{}
+	for ; iterator.Valid(); iterator.Next() {
+		nft, err := k.GetNFT(ctx, string(iterator.Value()))
+		if err != nil {
+			continue
+		}
+		nfts = append(nfts, nft)
+	}
+	return nfts
+}
+
+func (k Keeper) setNFT(ctx sdk.Context, nft types.NFT) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetNFTKey(nft.ID), k.cdc.MustMarshal(&nft))
+}
+
+func (k Keeper) setIndexes(ctx sdk.Context, nft types.NFT) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetNFTByOwnerKey(nft.Owner, nft.ID), []byte(nft.ID))
+	store.Set(types.GetNFTByTypeKey(nft.Type, nft.ID), []byte(nft.ID))
+	store.Set(types.GetNFTByClassKey(nft.ClassID, nft.ID), []byte(nft.ID))
+	if nft.Parent != "" {
+		store.Set(types.GetNFTByParentKey(nft.Parent, nft.ID), []byte(nft.ID))
+	}
+}
+
+func (k Keeper) removeIndexes(ctx sdk.Context, nft types.NFT) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetNFTByOwnerKey(nft.Owner, nft.ID))
+	store.Delete(types.GetNFTByTypeKey(nft.Type, nft.ID))
+	store.Delete(types.GetNFTByClassKey(nft.ClassID, nft.ID))
+	if nft.Parent != "" {
+		store.Delete(types.GetNFTByParentKey(nft.Parent, nft.ID))
+	}
+}
@@ -0,0 +1,157 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"skaffacity/x/nft/keeper"
+	"skaffacity/x/nft/types"
+)
+
+// genesisTime is the block time every test starts at
+var genesisTime = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// testAddr returns a distinct account address for each n
+func testAddr(n byte) string {
+	return sdk.AccAddress(append(make([]byte, 19), n)).String()
+}
+
+var (
+	creator   = testAddr(1)
+	alice     = testAddr(2)
+	bob       = testAddr(3)
+	authority = testAddr(100)
+)
+
+type fixture struct {
+	ctx       sdk.Context
+	keeper    *keeper.Keeper
+	msgServer types.MsgServer
+	registry  codectypes.InterfaceRegistry
+}
+
+// newKeeper returns an empty nft keeper at genesisTime
+func newKeeper(t *testing.T) fixture {
+	t.Helper()
+
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(cms, tmproto.Header{Time: genesisTime}, false, log.NewNopLogger())
+	registry := codectypes.NewInterfaceRegistry()
+
+	k := keeper.NewKeeper(codec.NewProtoCodec(registry), storeKey, nil, authority)
+	return fixture{
+		ctx:       ctx,
+		keeper:    k,
+		msgServer: keeper.NewMsgServerImpl(*k),
+		registry:  registry,
+	}
+}
+
+// setupKeeper returns an nft keeper holding the transferable class "city",
+// minted by creator, with no supply cap and frozen metadata
+func setupKeeper(t *testing.T) fixture {
+	t.Helper()
+
+	f := newKeeper(t)
+	f.createClass(t, "city", 0, types.MetadataMutability{})
+	return f
+}
+
+// createClass creates a transferable class minted by creator
+func (f fixture) createClass(t *testing.T, id string, maxSupply uint64, mutability types.MetadataMutability) {
+	t.Helper()
+
+	_, err := f.msgServer.CreateClass(sdk.WrapSDKContext(f.ctx), types.NewMsgCreateClass(creator, id, id, "", creator, maxSupply, true, types.RoyaltyInfo{}, mutability))
+	require.NoError(t, err)
+}
+
+// mint mints id as an NFT of the given type in "city" to owner and returns
+// its full ID
+func (f fixture) mint(t *testing.T, id, nftType, owner string) string {
+	t.Helper()
+
+	_, err := f.msgServer.MintNFT(sdk.WrapSDKContext(f.ctx), types.NewMsgMintNFT(creator, "city", id, nftType, owner, types.Metadata{Name: id}))
+	require.NoError(t, err)
+	return types.NFTID("city", id)
+}
+
+// transfer sends an NFT from one owner to another through the msg server
+func (f fixture) transfer(nftID, from, to string) error {
+	_, err := f.msgServer.TransferNFT(sdk.WrapSDKContext(f.ctx), types.NewMsgTransferNFT(from, nftID, to))
+	return err
+}
+
+// nft returns the stored NFT with the given full ID
+func (f fixture) nft(t *testing.T, id string) types.NFT {
+	t.Helper()
+
+	nft, err := f.keeper.GetNFT(f.ctx, id)
+	require.NoError(t, err)
+	return nft
+}
+
+// ids returns the IDs of nfts in order
+func ids(nfts []types.NFT) []string {
+	result := make([]string, len(nfts))
+	for i, nft := range nfts {
+		result[i] = nft.ID
+	}
+	return result
+}
+
+func TestIndexesFollowTransfers(t *testing.T) {
+	f := setupKeeper(t)
+
+	sword := f.mint(t, "sword", types.TypeItem, alice)
+	shield := f.mint(t, "shield", types.TypeItem, alice)
+	gem := f.mint(t, "gem", types.TypeAttachment, bob)
+
+	require.Equal(t, []string{shield, sword}, ids(f.keeper.GetNFTsByOwner(f.ctx, alice)))
+	require.Equal(t, []string{gem}, ids(f.keeper.GetNFTsByOwner(f.ctx, bob)))
+	require.Equal(t, []string{shield, sword}, ids(f.keeper.GetAllItems(f.ctx)))
+	require.Equal(t, []string{gem}, ids(f.keeper.GetNFTsByType(f.ctx, types.TypeAttachment)))
+
+	require.NoError(t, f.transfer(sword, alice, bob))
+	require.Equal(t, []string{shield}, ids(f.keeper.GetNFTsByOwner(f.ctx, alice)))
+	require.Equal(t, []string{gem, sword}, ids(f.keeper.GetNFTsByOwner(f.ctx, bob)))
+	require.Equal(t, []string{shield, sword}, ids(f.keeper.GetAllItems(f.ctx)))
+
+	// only the owner can transfer
+	require.ErrorIs(t, f.transfer(sword, alice, alice), types.ErrUnauthorized)
+	require.Equal(t, []string{shield}, ids(f.keeper.GetNFTsByOwner(f.ctx, alice)))
+}
+
+func TestBurnRemovesTheIndexes(t *testing.T) {
+	f := setupKeeper(t)
+
+	sword := f.mint(t, "sword", types.TypeItem, alice)
+	shield := f.mint(t, "shield", types.TypeItem, alice)
+
+	goCtx := sdk.WrapSDKContext(f.ctx)
+	_, err := f.msgServer.BurnNFT(goCtx, types.NewMsgBurnNFT(bob, sword))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = f.msgServer.BurnNFT(goCtx, types.NewMsgBurnNFT(alice, sword))
+	require.NoError(t, err)
+
+	require.False(t, f.keeper.HasNFT(f.ctx, sword))
+	require.Equal(t, []string{shield}, ids(f.keeper.GetNFTsByOwner(f.ctx, alice)))
+	require.Equal(t, []string{shield}, ids(f.keeper.GetAllItems(f.ctx)))
+	require.Equal(t, []string{shield}, ids(f.keeper.GetNFTsByClass(f.ctx, "city")))
+	require.Equal(t, uint64(1), f.keeper.GetClassSupply(f.ctx, "city"))
+}
@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"math"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"skaffacity/x/nft/types"
+)
+
+// GetLandAt returns the land parcel covering the cell (x, y)
+func (k Keeper) GetLandAt(ctx sdk.Context, x, y int32) (types.NFT, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetLandCellKey(x, y))
+	if bz == nil {
+		return types.NFT{}, sdkerrors.Wrapf(types.ErrNFTNotFound, "no land at (%d, %d)", x, y)
+	}
+
+	return k.GetNFT(ctx, string(bz))
+}
+
+// GetLandInArea returns every parcel covering at least one cell of the
+// bounding box, ordered by the first covered cell in column-major order
+func (k Keeper) GetLandInArea(ctx sdk.Context, minX, minY, maxX, maxY int32) ([]types.NFT, error) {
+	if err := types.ValidateLandArea(minX, minY, maxX, maxY); err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	seen := make(map[string]bool)
+	store := ctx.KVStore(k.storeKey)
+	for x := int64(minX); x <= int64(maxX); x++ {
+		end := storetypes.PrefixEndBytes(types.GetLandColumnPrefix(int32(x)))
+		if maxY < math.MaxInt32 {
+			end = types.GetLandCellKey(int32(x), maxY+1)
+		}
+
+		iterator := store.Iterator(types.GetLandCellKey(int32(x), minY), end)
+		for ; iterator.Valid(); iterator.Next() {
+			id := string(iterator.Value())
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+		iterator.Close()
+	}
+
+	return k.getNFTs(ctx, ids), nil
+}
+
+// GetLandNeighbors returns the parcels that share an edge or a corner with
+// the given land parcel
+func (k Keeper) GetLandNeighbors(ctx sdk.Context, id string) ([]types.NFT, error) {
+	nft, err := k.GetNFT(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if nft.Type != types.TypeLand || nft.Land == nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidLand, "%s is not a land parcel", id)
+	}
+
+	land := nft.Land
+	minX, minY := int64(land.Location.X)-1, int64(land.Location.Y)-1
+	maxX, maxY := int64(land.MaxX())+1, int64(land.MaxY())+1
+
+	store := ctx.KVStore(k.storeKey)
+	var ids []string
+	seen := map[string]bool{id: true}
+	visit := func(x, y int64) {
+		if x < math.MinInt32 || x > math.MaxInt32 || y < math.MinInt32 || y > math.MaxInt32 {
+			return
+		}
+		bz := store.Get(types.GetLandCellKey(int32(x), int32(y)))
+		if bz == nil || seen[string(bz)] {
+			return
+		}
+		seen[string(bz)] = true
+		ids = append(ids, string(bz))
+	}
+
+	for x := minX; x <= maxX; x++ {
+		visit(x, minY)
+		visit(x, maxY)
+	}
+	for y := minY + 1; y < maxY; y++ {
+		visit(minX, y)
+		visit(maxX, y)
+	}
+
+	return k.getNFTs(ctx, ids), nil
+}
+
+// checkLandFree returns an error if any cell of the parcel is already taken
+func (k Keeper) checkLandFree(ctx sdk.Context, land types.LandMetadata) error {
+	store := ctx.KVStore(k.storeKey)
+	for x := int64(land.Location.X); x <= int64(land.MaxX()); x++ {
+		for y := int64(land.Location.Y); y <= int64(land.MaxY()); y++ {
+			if bz := store.Get(types.GetLandCellKey(int32(x), int32(y))); bz != nil {
+				return sdkerrors.Wrapf(types.ErrLandOverlap, "cell (%d, %d) belongs to %s", x, y, string(bz))
+			}
+		}
+	}
+	return nil
+}
+
+// setLandCells claims every cell of the parcel for the given NFT
+func (k Keeper) setLandCells(ctx sdk.Context, id string, land types.LandMetadata) {
+	store := ctx.KVStore(k.storeKey)
+	for x := int64(land.Location.X); x <= int64(land.MaxX()); x++ {
+		for y := int64(land.Location.Y); y <= int64(land.MaxY()); y++ {
+			store.Set(types.GetLandCellKey(int32(x), int32(y)), []byte(id))
+		}
+	}
+}
+
+// removeLandCells releases every cell of the parcel
+func (k Keeper) removeLandCells(ctx sdk.Context, land types.LandMetadata) {
+	store := ctx.KVStore(k.storeKey)
+	for x := int64(land.Location.X); x <= int64(land.MaxX()); x++ {
+		for y := int64(land.Location.Y); y <= int64(land.MaxY()); y++ {
+			store.Delete(types.GetLandCellKey(int32(x), int32(y)))
+		}
+	}
+}
+
+// getNFTs resolves a list of IDs, skipping any that no longer exist
+func (k Keeper) getNFTs(ctx sdk.Context, ids []string) []types.NFT {
+	nfts := make([]types.NFT, 0, len(ids))
+	for _, id := range ids {
+		nft, err := k.GetNFT(ctx, id)
+		if err != nil {
+			continue
+		}
+		nfts = append(nfts, nft)
+	}
+	return nfts
+}
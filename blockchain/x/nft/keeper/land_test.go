@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"skaffacity/x/nft/types"
+)
+
+// mintLand mints a parcel of size cells square with its corner at (x, y)
+func (f fixture) mintLand(id string, x, y int32, size uint32) (string, error) {
+	land := types.LandMetadata{Location: types.Location{X: x, Y: y}, Size: size}
+	_, err := f.msgServer.MintNFT(sdk.WrapSDKContext(f.ctx), types.NewMsgMintLand(creator, "city", id, alice, types.Metadata{Name: id}, land))
+	return types.NFTID("city", id), err
+}
+
+func TestLandParcelsCannotOverlap(t *testing.T) {
+	f := setupKeeper(t)
+
+	square, err := f.mintLand("square", 0, 0, 4)
+	require.NoError(t, err)
+
+	_, err = f.mintLand("corner", 3, 3, 2)
+	require.ErrorIs(t, err, types.ErrLandOverlap)
+	_, err = f.mintLand("inside", 1, 1, 1)
+	require.ErrorIs(t, err, types.ErrLandOverlap)
+	require.False(t, f.keeper.HasNFT(f.ctx, types.NFTID("city", "corner")))
+
+	east, err := f.mintLand("east", 4, 0, 2)
+	require.NoError(t, err)
+	far, err := f.mintLand("far", 10, 10, 1)
+	require.NoError(t, err)
+
+	nft, err := f.keeper.GetLandAt(f.ctx, 3, 3)
+	require.NoError(t, err)
+	require.Equal(t, square, nft.ID)
+	_, err = f.keeper.GetLandAt(f.ctx, 4, 3)
+	require.ErrorIs(t, err, types.ErrNFTNotFound)
+
+	neighbors, err := f.keeper.GetLandNeighbors(f.ctx, square)
+	require.NoError(t, err)
+	require.Equal(t, []string{east}, ids(neighbors))
+
+	parcels, err := f.keeper.GetLandInArea(f.ctx, 3, 0, 10, 10)
+	require.NoError(t, err)
+	require.Equal(t, []string{square, east, far}, ids(parcels))
+}
+
+func TestLandSizeIsLimited(t *testing.T) {
+	f := setupKeeper(t)
+
+	_, err := f.mintLand("empty", 0, 0, 0)
+	require.ErrorIs(t, err, types.ErrInvalidLand)
+	_, err = f.mintLand("huge", 0, 0, types.MaxLandSize+1)
+	require.ErrorIs(t, err, types.ErrInvalidLand)
+	_, err = f.mintLand("edge", 2147483647, 0, 2)
+	require.ErrorIs(t, err, types.ErrInvalidLand)
+
+	_, err = f.mintLand("largest", 0, 0, types.MaxLandSize)
+	require.NoError(t, err)
+}
+
+func TestLandQuerySpanIsLimited(t *testing.T) {
+	f := setupKeeper(t)
+
+	_, err := f.keeper.GetLandInArea(f.ctx, 0, 0, types.MaxLandQuerySpan-1, types.MaxLandQuerySpan-1)
+	require.NoError(t, err)
+
+	_, err = f.keeper.GetLandInArea(f.ctx, 0, 0, types.MaxLandQuerySpan, 0)
+	require.ErrorIs(t, err, types.ErrInvalidLand)
+	_, err = f.keeper.GetLandInArea(f.ctx, 0, -1, 0, types.MaxLandQuerySpan-1)
+	require.ErrorIs(t, err, types.ErrInvalidLand)
+	_, err = f.keeper.GetLandInArea(f.ctx, 1, 0, 0, 0)
+	require.ErrorIs(t, err, types.ErrInvalidLand)
+}
@@ -1,26 +1,369 @@
 package keeper
 
 import (
-    "context"
-    "skaffacity/x/nft/types"
+	"context"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"skaffacity/x/nft/types"
 )
 
 type msgServer struct {
-    Keeper
+	Keeper
 }
 
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
 func NewMsgServerImpl(keeper Keeper) types.MsgServer {
-    return &msgServer{Keeper: keeper}
+	return &msgServer{Keeper: keeper}
 }
 
+var _ types.MsgServer = msgServer{}
+
+// MintNFT handles minting a new NFT to the recipient
 func (k msgServer) MintNFT(goCtx context.Context, msg *types.MsgMintNFT) (*types.MsgMintNFTResponse, error) {
-    return &types.MsgMintNFTResponse{}, nil
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	class, err := k.mintableClass(ctx, msg.ClassID, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := k.mintNFT(ctx, class, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMintNFTResponse{ID: id}, nil
 }
 
+// TransferNFT handles transferring an NFT owned by the sender
 func (k msgServer) TransferNFT(goCtx context.Context, msg *types.MsgTransferNFT) (*types.MsgTransferNFTResponse, error) {
-    return &types.MsgTransferNFTResponse{}, nil
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.transferNFT(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTransferNFTResponse{}, nil
+}
+
+// BatchMintNFT handles minting several NFTs of one class, all or none
+func (k msgServer) BatchMintNFT(goCtx context.Context, msg *types.MsgBatchMintNFT) (*types.MsgBatchMintNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	class, err := k.mintableClass(ctx, msg.ClassID, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	// mint on a branch of the store so a failing entry leaves nothing
+	// behind, even when the batch is not the only message of a transaction
+	cacheCtx, write := ctx.CacheContext()
+	ids := make([]string, 0, len(msg.Entries))
+	for i, mint := range msg.MintMsgs() {
+		ctx.GasMeter().ConsumeGas(types.BatchMintGasPerItem, "nft batch mint")
+		id, err := k.mintNFT(cacheCtx, class, mint)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "entry %d", i)
+		}
+		ids = append(ids, id)
+	}
+	write()
+
+	return &types.MsgBatchMintNFTResponse{IDs: ids}, nil
+}
+
+// BatchTransferNFT handles transferring several NFTs, all or none
+func (k msgServer) BatchTransferNFT(goCtx context.Context, msg *types.MsgBatchTransferNFT) (*types.MsgBatchTransferNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	cacheCtx, write := ctx.CacheContext()
+	for i, transfer := range msg.TransferMsgs() {
+		ctx.GasMeter().ConsumeGas(types.BatchTransferGasPerItem, "nft batch transfer")
+		if err := k.transferNFT(cacheCtx, transfer); err != nil {
+			return nil, sdkerrors.Wrapf(err, "entry %d", i)
+		}
+	}
+	write()
+
+	return &types.MsgBatchTransferNFTResponse{}, nil
 }
 
+// mintableClass returns the class classID if sender is its mint authority
+func (k msgServer) mintableClass(ctx sdk.Context, classID, sender string) (types.Class, error) {
+	class, err := k.GetClass(ctx, classID)
+	if err != nil {
+		return types.Class{}, err
+	}
+
+	if sender != class.MintAuthority {
+		return types.Class{}, sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not the mint authority of %s", sender, class.ID)
+	}
+
+	return class, nil
+}
+
+// mintNFT mints the NFT described by msg in class and returns its full ID
+func (k msgServer) mintNFT(ctx sdk.Context, class types.Class, msg *types.MsgMintNFT) (string, error) {
+	nft := types.NFT{
+		ID:           types.NFTID(msg.ClassID, msg.ID),
+		ClassID:      msg.ClassID,
+		Type:         msg.NFTType,
+		Owner:        msg.Recipient,
+		Metadata:     msg.Metadata,
+		Created:      ctx.BlockTime(),
+		Transferable: class.Transferable && msg.NFTType != types.TypeBadge,
+		Land:         msg.Land,
+	}
+
+	if msg.NFTType == types.TypeBadge {
+		if !k.IsBadgeIssuer(ctx, msg.Sender) {
+			return "", sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not a registered badge issuer", msg.Sender)
+		}
+		if msg.Badge == nil {
+			return "", sdkerrors.Wrap(types.ErrInvalidBadge, "badge NFTs require an achievement")
+		}
+		nft.Badge = &types.BadgeMetadata{
+			Achievement: msg.Badge.Achievement,
+			Issuer:      msg.Sender,
+			DateEarned:  ctx.BlockTime(),
+			Permanent:   msg.Badge.Permanent,
+		}
+	}
+
+	if err := k.Keeper.MintNFT(ctx, nft); err != nil {
+		return "", err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMintNFT,
+			sdk.NewAttribute(types.AttributeKeyNFTID, nft.ID),
+			sdk.NewAttribute(types.AttributeKeyClassID, nft.ClassID),
+			sdk.NewAttribute(types.AttributeKeyNFTType, nft.Type),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+		),
+	)
+
+	return nft.ID, nil
+}
+
+// transferNFT performs the transfer described by msg
+func (k msgServer) transferNFT(ctx sdk.Context, msg *types.MsgTransferNFT) error {
+	if err := k.Keeper.TransferNFT(ctx, msg.ID, msg.Sender, msg.Recipient); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransferNFT,
+			sdk.NewAttribute(types.AttributeKeyNFTID, msg.ID),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+		),
+	)
+
+	return nil
+}
+
+// AttachToItem handles attaching an attachment NFT to an item
 func (k msgServer) AttachToItem(goCtx context.Context, msg *types.MsgAttachToItem) (*types.MsgAttachToItemResponse, error) {
-    return &types.MsgAttachToItemResponse{}, nil
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.AttachToItem(ctx, msg.ItemID, msg.AttachmentID, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAttachToItem,
+			sdk.NewAttribute(types.AttributeKeyNFTID, msg.AttachmentID),
+			sdk.NewAttribute(types.AttributeKeyItemID, msg.ItemID),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+		),
+	)
+
+	return &types.MsgAttachToItemResponse{}, nil
+}
+
+// DetachFromItem handles detaching an attachment NFT from its parent
+func (k msgServer) DetachFromItem(goCtx context.Context, msg *types.MsgDetachFromItem) (*types.MsgDetachFromItemResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	parent, err := k.Keeper.DetachFromItem(ctx, msg.AttachmentID, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDetachFromItem,
+			sdk.NewAttribute(types.AttributeKeyNFTID, msg.AttachmentID),
+			sdk.NewAttribute(types.AttributeKeyItemID, parent),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+		),
+	)
+
+	return &types.MsgDetachFromItemResponse{}, nil
+}
+
+// AddBadgeIssuer handles registering a badge issuer through governance
+func (k msgServer) AddBadgeIssuer(goCtx context.Context, msg *types.MsgAddBadgeIssuer) (*types.MsgAddBadgeIssuerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != k.authority {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "expected %s as authority, got %s", k.authority, msg.Authority)
+	}
+
+	k.SetBadgeIssuer(ctx, types.BadgeIssuer{Address: msg.Issuer, Name: msg.Name})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAddBadgeIssuer,
+			sdk.NewAttribute(types.AttributeKeyIssuer, msg.Issuer),
+		),
+	)
+
+	return &types.MsgAddBadgeIssuerResponse{}, nil
+}
+
+// RemoveBadgeIssuer handles removing a badge issuer through governance
+func (k msgServer) RemoveBadgeIssuer(goCtx context.Context, msg *types.MsgRemoveBadgeIssuer) (*types.MsgRemoveBadgeIssuerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != k.authority {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "expected %s as authority, got %s", k.authority, msg.Authority)
+	}
+
+	if err := k.Keeper.RemoveBadgeIssuer(ctx, msg.Issuer); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveBadgeIssuer,
+			sdk.NewAttribute(types.AttributeKeyIssuer, msg.Issuer),
+		),
+	)
+
+	return &types.MsgRemoveBadgeIssuerResponse{}, nil
+}
+
+// RevokeBadge handles revoking a non-permanent badge
+func (k msgServer) RevokeBadge(goCtx context.Context, msg *types.MsgRevokeBadge) (*types.MsgRevokeBadgeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	badge, err := k.Keeper.RevokeBadge(ctx, msg.ID, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeBadge,
+			sdk.NewAttribute(types.AttributeKeyNFTID, badge.ID),
+			sdk.NewAttribute(types.AttributeKeyAchievement, badge.Badge.Achievement),
+			sdk.NewAttribute(types.AttributeKeyRecipient, badge.Owner),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+		),
+	)
+
+	return &types.MsgRevokeBadgeResponse{}, nil
+}
+
+// CreateClass handles creating a new NFT class
+func (k msgServer) CreateClass(goCtx context.Context, msg *types.MsgCreateClass) (*types.MsgCreateClassResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.CreateClass(ctx, msg.Class()); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateClass,
+			sdk.NewAttribute(types.AttributeKeyClassID, msg.ID),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyMintAuthority, msg.MintAuthority),
+		),
+	)
+
+	return &types.MsgCreateClassResponse{}, nil
+}
+
+// BurnNFT handles burning an NFT owned by the sender
+func (k msgServer) BurnNFT(goCtx context.Context, msg *types.MsgBurnNFT) (*types.MsgBurnNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	nft, err := k.GetNFT(ctx, msg.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if nft.Owner != msg.Sender {
+		return nil, sdkerrors.Wrap(types.ErrUnauthorized, "sender is not the owner")
+	}
+
+	if err := k.Keeper.BurnNFT(ctx, msg.ID); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBurnNFT,
+			sdk.NewAttribute(types.AttributeKeyNFTID, nft.ID),
+			sdk.NewAttribute(types.AttributeKeyNFTType, nft.Type),
+			sdk.NewAttribute(types.AttributeKeyClassID, nft.ClassID),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+		),
+	)
+
+	return &types.MsgBurnNFTResponse{}, nil
+}
+
+// UpdateNFTMetadata handles updating the metadata fields the sender may change
+func (k msgServer) UpdateNFTMetadata(goCtx context.Context, msg *types.MsgUpdateNFTMetadata) (*types.MsgUpdateNFTMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	fields, err := k.Keeper.UpdateNFTMetadata(ctx, msg.ID, msg.Sender, msg.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateNFTMetadata,
+			sdk.NewAttribute(types.AttributeKeyNFTID, msg.ID),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyFields, strings.Join(fields, ",")),
+		),
+	)
+
+	return &types.MsgUpdateNFTMetadataResponse{}, nil
+}
+
+func (k msgServer) SetUser(goCtx context.Context, msg *types.MsgSetUser) (*types.MsgSetUserResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rental := types.Rental{NFTID: msg.ID, User: msg.User, Expires: msg.Expires}
+	if err := k.Keeper.SetUser(ctx, msg.ID, msg.Sender, rental); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetUser,
+			sdk.NewAttribute(types.AttributeKeyNFTID, msg.ID),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyUser, msg.User),
+			sdk.NewAttribute(types.AttributeKeyExpires, msg.Expires.UTC().Format(time.RFC3339)),
+		),
+	)
+
+	return &types.MsgSetUserResponse{}, nil
 }
@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"skaffacity/x/nft/types"
+)
+
+// SetUser rents the land or item id, owned by owner, to user until expires.
+// While a rental is running the owner can only extend it for the same user,
+// so a renter cannot be evicted early, and the rental survives transfers.
+func (k Keeper) SetUser(ctx sdk.Context, id, owner string, rental types.Rental) error {
+	nft, err := k.GetNFT(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if nft.Owner != owner {
+		return sdkerrors.Wrap(types.ErrUnauthorized, "sender is not the owner")
+	}
+
+	if !types.IsRentableNFTType(nft.Type) {
+		return sdkerrors.Wrapf(types.ErrInvalidRental, "%s NFTs cannot be rented out", nft.Type)
+	}
+
+	now := ctx.BlockTime()
+	if !rental.Active(now) {
+		return sdkerrors.Wrap(types.ErrInvalidRental, "rental must end after the current block time")
+	}
+
+	if rental.Expires.After(now.Add(types.MaxRentalDuration)) {
+		return sdkerrors.Wrapf(types.ErrInvalidRental, "rental cannot run longer than %s", types.MaxRentalDuration)
+	}
+
+	if current, found := k.GetRental(ctx, id); found && current.Active(now) {
+		if current.User != rental.User {
+			return sdkerrors.Wrapf(types.ErrInvalidRental, "%s is rented to %s until %s", id, current.User, current.Expires)
+		}
+		if rental.Expires.Before(current.Expires) {
+			return sdkerrors.Wrapf(types.ErrInvalidRental, "a running rental can only be extended, not shortened")
+		}
+	}
+
+	rental.NFTID = id
+	k.setRental(ctx, rental)
+	return nil
+}
+
+// GetRental returns the rental record of an NFT, which may have expired
+// during the current block
+func (k Keeper) GetRental(ctx sdk.Context, id string) (types.Rental, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetRentalKey(id))
+	if bz == nil {
+		return types.Rental{}, false
+	}
+
+	var rental types.Rental
+	k.cdc.MustUnmarshal(bz, &rental)
+	return rental, true
+}
+
+// GetEffectiveUser returns the account entitled to use an NFT: the renter
+// while a rental is running, otherwise the owner
+func (k Keeper) GetEffectiveUser(ctx sdk.Context, id string) (string, error) {
+	nft, err := k.GetNFT(ctx, id)
+	if err != nil {
+		return "", err
+	}
+
+	if rental, found := k.GetRental(ctx, id); found && rental.Active(ctx.BlockTime()) {
+		return rental.User, nil
+	}
+	return nft.Owner, nil
+}
+
+// GetAllRentals returns every rental record in NFT ID order
+func (k Keeper) GetAllRentals(ctx sdk.Context) []types.Rental {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.RentalKey)
+	defer iterator.Close()
+
+	rentals := []types.Rental{}
+	for ; iterator.Valid(); iterator.Next() {
+		var rental types.Rental
+		k.cdc.MustUnmarshal(iterator.Value(), &rental)
+		rentals = append(rentals, rental)
+	}
+	return rentals
+}
+
+// GetNFTsByUser returns the NFTs rented to user
+func (k Keeper) GetNFTsByUser(ctx sdk.Context, user string) []types.NFT {
+	return k.getIndexedNFTs(ctx, types.GetNFTByUserPrefix(user))
+}
+
+// ExpireRentals clears the rentals that have ended by the current block
+// time, at most MaxRentalExpiriesPerBlock per call so the work per block
+// stays bounded, and returns the cleared rentals
+func (k Keeper) ExpireRentals(ctx sdk.Context) []types.Rental {
+	store := ctx.KVStore(k.storeKey)
+	end := storetypes.PrefixEndBytes(types.GetRentalExpiryPrefix(ctx.BlockTime()))
+	iterator := store.Iterator(types.RentalExpiryKey, end)
+
+	var expired []types.Rental
+	for ; iterator.Valid() && len(expired) < types.MaxRentalExpiriesPerBlock; iterator.Next() {
+		if rental, found := k.GetRental(ctx, string(iterator.Value())); found {
+			expired = append(expired, rental)
+		}
+	}
+	iterator.Close()
+
+	for _, rental := range expired {
+		k.removeRental(ctx, rental)
+	}
+	return expired
+}
+
+// ImportRental stores a rental from genesis without the owner checks of SetUser
+func (k Keeper) ImportRental(ctx sdk.Context, rental types.Rental) error {
+	if !k.HasNFT(ctx, rental.NFTID) {
+		return sdkerrors.Wrap(types.ErrNFTNotFound, rental.NFTID)
+	}
+	k.setRental(ctx, rental)
+	return nil
+}
+
+// setRental stores a rental and its expiry queue and renter index entries,
+// replacing any previous rental of the same NFT
+func (k Keeper) setRental(ctx sdk.Context, rental types.Rental) {
+	if current, found := k.GetRental(ctx, rental.NFTID); found {
+		k.removeRental(ctx, current)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetRentalKey(rental.NFTID), k.cdc.MustMarshal(&rental))
+	store.Set(types.GetRentalExpiryKey(rental.Expires, rental.NFTID), []byte(rental.NFTID))
+	store.Set(types.GetNFTByUserKey(rental.User, rental.NFTID), []byte(rental.NFTID))
+}
+
+// removeRental deletes a rental with its expiry queue and renter index entries
+func (k Keeper) removeRental(ctx sdk.Context, rental types.Rental) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetRentalKey(rental.NFTID))
+	store.Delete(types.GetRentalExpiryKey(rental.Expires, rental.NFTID))
+	store.Delete(types.GetNFTByUserKey(rental.User, rental.NFTID))
+}
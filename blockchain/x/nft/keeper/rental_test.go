@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"skaffacity/x/nft/types"
+)
+
+// rent rents an NFT owned by owner to user until expires
+func (f fixture) rent(owner, id, user string, expires time.Time) error {
+	_, err := f.msgServer.SetUser(sdk.WrapSDKContext(f.ctx), types.NewMsgSetUser(owner, id, user, expires))
+	return err
+}
+
+func TestRentalExpires(t *testing.T) {
+	f := setupKeeper(t)
+	sword := f.mint(t, "sword", types.TypeItem, alice)
+	expires := genesisTime.Add(time.Hour)
+
+	require.ErrorIs(t, f.rent(bob, sword, bob, expires), types.ErrUnauthorized)
+	require.ErrorIs(t, f.rent(alice, sword, bob, genesisTime), types.ErrInvalidRental)
+	require.ErrorIs(t, f.rent(alice, sword, bob, genesisTime.Add(types.MaxRentalDuration+time.Second)), types.ErrInvalidRental)
+	require.NoError(t, f.rent(alice, sword, bob, expires))
+
+	user, err := f.keeper.GetEffectiveUser(f.ctx, sword)
+	require.NoError(t, err)
+	require.Equal(t, bob, user)
+	require.Equal(t, []string{sword}, ids(f.keeper.GetNFTsByUser(f.ctx, bob)))
+
+	// the renter cannot be evicted or cut short, even by a new owner
+	require.NoError(t, f.transfer(sword, alice, creator))
+	require.ErrorIs(t, f.rent(creator, sword, alice, expires), types.ErrInvalidRental)
+	require.ErrorIs(t, f.rent(creator, sword, bob, expires.Add(-time.Minute)), types.ErrInvalidRental)
+
+	require.Empty(t, f.keeper.ExpireRentals(f.ctx.WithBlockTime(expires.Add(-time.Nanosecond))))
+	_, found := f.keeper.GetRental(f.ctx, sword)
+	require.True(t, found)
+
+	f.ctx = f.ctx.WithBlockTime(expires)
+	user, err = f.keeper.GetEffectiveUser(f.ctx, sword)
+	require.NoError(t, err)
+	require.Equal(t, creator, user)
+
+	expired := f.keeper.ExpireRentals(f.ctx)
+	require.Equal(t, []types.Rental{{NFTID: sword, User: bob, Expires: expires}}, expired)
+	_, found = f.keeper.GetRental(f.ctx, sword)
+	require.False(t, found)
+	require.Empty(t, f.keeper.GetNFTsByUser(f.ctx, bob))
+
+	// the new owner may rent it out again
+	require.NoError(t, f.rent(creator, sword, alice, expires.Add(time.Hour)))
+}
+
+func TestExtendedRentalExpiresOnce(t *testing.T) {
+	f := setupKeeper(t)
+	sword := f.mint(t, "sword", types.TypeItem, alice)
+
+	require.NoError(t, f.rent(alice, sword, bob, genesisTime.Add(time.Hour)))
+	require.NoError(t, f.rent(alice, sword, bob, genesisTime.Add(2*time.Hour)))
+
+	require.Empty(t, f.keeper.ExpireRentals(f.ctx.WithBlockTime(genesisTime.Add(time.Hour))))
+	require.Len(t, f.keeper.ExpireRentals(f.ctx.WithBlockTime(genesisTime.Add(2*time.Hour))), 1)
+}
+
+func TestRentalExpiriesPerBlockAreCapped(t *testing.T) {
+	f := setupKeeper(t)
+	expires := genesisTime.Add(time.Hour)
+
+	total := types.MaxRentalExpiriesPerBlock + 5
+	for i := 0; i < total; i++ {
+		item := f.mint(t, fmt.Sprintf("item-%d", i), types.TypeItem, alice)
+		require.NoError(t, f.rent(alice, item, bob, expires))
+	}
+
+	ctx := f.ctx.WithBlockTime(expires)
+	require.Len(t, f.keeper.ExpireRentals(ctx), types.MaxRentalExpiriesPerBlock)
+	require.Len(t, f.keeper.GetNFTsByUser(ctx, bob), 5)
+	require.Len(t, f.keeper.ExpireRentals(ctx.WithBlockTime(expires.Add(5*time.Second))), 5)
+	require.Empty(t, f.keeper.GetAllRentals(ctx))
+}
@@ -1,7 +1,9 @@
 package nft
 
 import (
+    "context"
     "encoding/json"
+    "fmt"
 
     "github.com/cosmos/cosmos-sdk/codec"
     codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -12,8 +14,10 @@ import (
     "github.com/grpc-ecosystem/grpc-gateway/runtime"
     abci "github.com/cometbft/cometbft/abci/types"
     
+    "skaffacity/x/nft/client/cli"
     "skaffacity/x/nft/keeper"
     nfttypes "skaffacity/x/nft/types"
+    nftv1beta1 "skaffacity/x/nft/types/v1beta1"
 )
 
 type AppModule struct {
@@ -26,36 +30,70 @@ func NewAppModule(k keeper.Keeper) AppModule {
 
 func (am AppModule) Name() string { return nfttypes.ModuleName }
 
-func (am AppModule) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {}
+func (am AppModule) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+    nfttypes.RegisterCodec(cdc)
+}
 
-func (am AppModule) RegisterInterfaces(registry codectypes.InterfaceRegistry) {}
+func (am AppModule) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+    nfttypes.RegisterInterfaces(registry)
+}
 
+// DefaultGenesis returns the NFT module's default genesis state. The nft
+// types are JSON-encoded rather than generated protobuf, so genesis uses
+// encoding/json directly instead of the codec.
 func (am AppModule) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
-    return []byte(`{}`) // Simple empty JSON for now
+    bz, err := json.Marshal(nfttypes.DefaultGenesisState())
+    if err != nil {
+        panic(err)
+    }
+    return bz
 }
 
 func (am AppModule) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncodingConfig, bz json.RawMessage) error {
-    return nil
+    var genState nfttypes.GenesisState
+    if err := json.Unmarshal(bz, &genState); err != nil {
+        return fmt.Errorf("failed to unmarshal %s genesis state: %w", nfttypes.ModuleName, err)
+    }
+    return genState.Validate()
 }
 
-func (am AppModule) RegisterServices(cfg module.Configurator) {}
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+    nfttypes.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
+    nfttypes.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServer(am.keeper))
+    // serve the standard cosmos.nft.v1beta1 queries for wallets and explorers
+    nftv1beta1.RegisterQueryServer(cfg.QueryServer(), keeper.NewStandardQueryServer(am.keeper))
+}
 
-func (am AppModule) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {}
+func (am AppModule) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+    nfttypes.RegisterQueryHandlerClient(context.Background(), mux, nfttypes.NewQueryClient(clientCtx))
+    nftv1beta1.RegisterQueryHandlerClient(context.Background(), mux, nftv1beta1.NewQueryClient(clientCtx))
+}
 
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
+    var genState nfttypes.GenesisState
+    if err := json.Unmarshal(data, &genState); err != nil {
+        panic(fmt.Errorf("failed to unmarshal %s genesis state: %w", nfttypes.ModuleName, err))
+    }
+
+    InitGenesis(ctx, am.keeper, genState)
     return []abci.ValidatorUpdate{}
 }
 
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
-    return []byte(`{}`) // Simple empty JSON for now
+    bz, err := json.Marshal(ExportGenesis(ctx, am.keeper))
+    if err != nil {
+        panic(err)
+    }
+    return bz
 }
 
 func (am AppModule) ConsensusVersion() uint64 { return 1 }
 
 func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {}
 func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
+    EndBlocker(ctx, am.keeper)
     return []abci.ValidatorUpdate{}
 }
 
-func (am AppModule) GetTxCmd() *cobra.Command { return nil }
-func (am AppModule) GetQueryCmd() *cobra.Command { return nil }
+func (am AppModule) GetTxCmd() *cobra.Command { return cli.GetTxCmd() }
+func (am AppModule) GetQueryCmd() *cobra.Command { return cli.GetQueryCmd() }
@@ -1,10 +1,62 @@
 package types
 
-// ProtoMessage implements the proto.Message interface for Badge.
-func (b *Badge) ProtoMessage() {}
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
 
-// Reset implements the proto.Message interface for Badge.
-func (b *Badge) Reset() {}
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
 
-// String implements the fmt.Stringer interface for Badge.
-func (b *Badge) String() string { return "Badge stub" }
\ No newline at end of file
+// MaxBadgeIssuerNameLength bounds the display name of a badge issuer
+const MaxBadgeIssuerNameLength = 64
+
+// Validate checks that the issuer has a valid address and a display name
+func (i BadgeIssuer) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(i.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid issuer address (%s)", err)
+	}
+
+	if strings.TrimSpace(i.Name) == "" || len(i.Name) > MaxBadgeIssuerNameLength {
+		return sdkerrors.Wrapf(ErrInvalidBadge, "issuer name must be 1 to %d characters", MaxBadgeIssuerNameLength)
+	}
+
+	return nil
+}
+
+// Equal reports whether two badge records are identical
+func (b BadgeMetadata) Equal(other BadgeMetadata) bool {
+	return b.Achievement == other.Achievement &&
+		b.Issuer == other.Issuer &&
+		b.DateEarned.Equal(other.DateEarned) &&
+		b.Permanent == other.Permanent
+}
+
+// ProtoMessage implements the proto.Message interface for BadgeIssuer.
+func (i *BadgeIssuer) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for BadgeIssuer.
+func (i *BadgeIssuer) Reset() { *i = BadgeIssuer{} }
+
+// String implements the fmt.Stringer interface for BadgeIssuer.
+func (i *BadgeIssuer) String() string {
+	return fmt.Sprintf("BadgeIssuer{Address: %s, Name: %s}", i.Address, i.Name)
+}
+
+// Marshal implements codec.ProtoMarshaler for BadgeIssuer.
+func (i *BadgeIssuer) Marshal() ([]byte, error) { return json.Marshal(i) }
+
+// MarshalTo implements codec.ProtoMarshaler for BadgeIssuer.
+func (i *BadgeIssuer) MarshalTo(data []byte) (int, error) { return jsonMarshalTo(i, data) }
+
+// MarshalToSizedBuffer implements codec.ProtoMarshaler for BadgeIssuer.
+func (i *BadgeIssuer) MarshalToSizedBuffer(data []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(i, data)
+}
+
+// Unmarshal implements codec.ProtoMarshaler for BadgeIssuer.
+func (i *BadgeIssuer) Unmarshal(data []byte) error { return json.Unmarshal(data, i) }
+
+// Size implements codec.ProtoMarshaler for BadgeIssuer.
+func (i *BadgeIssuer) Size() int { return jsonSize(i) }
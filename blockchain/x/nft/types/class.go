@@ -0,0 +1,226 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// MinClassIDLength and MaxClassIDLength bound the length of a class identifier
+	MinClassIDLength = 3
+	MaxClassIDLength = 64
+
+	// MaxRoyaltyBasisPoints is 100% expressed in basis points
+	MaxRoyaltyBasisPoints = 10000
+
+	// MutabilityFrozen marks a metadata field that can never change; it is the default
+	MutabilityFrozen = "frozen"
+	// MutabilityOwner lets the NFT owner change a metadata field
+	MutabilityOwner = "owner"
+	// MutabilityAuthority lets the class mint authority (the game) change a metadata field
+	MutabilityAuthority = "authority"
+	// MutabilityOwnerOrAuthority lets either the owner or the mint authority change a metadata field
+	MutabilityOwnerOrAuthority = "owner_or_authority"
+
+	// classIDSeparator separates the class ID from the class-local part of an NFT ID
+	classIDSeparator = ":"
+)
+
+// Class is a collection of NFTs, such as "Season 3 Weapons", issued by a
+// single mint authority. NFT IDs are scoped by class, so two classes can
+// use the same local IDs without colliding.
+type Class struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	Description   string `json:"description"`
+	Creator       string `json:"creator"`
+	MintAuthority string `json:"mint_authority"`
+	// MaxSupply caps the number of NFTs ever minted in the class; 0 means no cap
+	MaxSupply uint64 `json:"max_supply"`
+	// Transferable is the default transferability of NFTs minted in the class
+	Transferable bool        `json:"transferable"`
+	Royalty      RoyaltyInfo `json:"royalty"`
+	// Mutability controls who may update each metadata field after minting
+	Mutability MetadataMutability `json:"mutability"`
+}
+
+// MetadataMutability holds one Mutability* value per Metadata field. Empty
+// values are treated as MutabilityFrozen.
+type MetadataMutability struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Image       string `json:"image,omitempty"`
+	Properties  string `json:"properties,omitempty"`
+}
+
+// RoyaltyInfo describes the share of each secondary sale paid to the class
+type RoyaltyInfo struct {
+	Recipient   string `json:"recipient,omitempty"`
+	BasisPoints uint32 `json:"basis_points"`
+}
+
+// Validate checks the class fields
+func (c Class) Validate() error {
+	if err := ValidateClassID(c.ID); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(c.Name) == "" {
+		return sdkerrors.Wrap(ErrInvalidClass, "class name cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(c.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(c.MintAuthority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid mint authority address (%s)", err)
+	}
+
+	if err := c.Mutability.Validate(); err != nil {
+		return err
+	}
+
+	return c.Royalty.Validate()
+}
+
+// Validate checks that every field carries a known mutability
+func (m MetadataMutability) Validate() error {
+	for field, mutability := range map[string]string{
+		"name":        m.Name,
+		"description": m.Description,
+		"image":       m.Image,
+		"properties":  m.Properties,
+	} {
+		switch mutability {
+		case "", MutabilityFrozen, MutabilityOwner, MutabilityAuthority, MutabilityOwnerOrAuthority:
+		default:
+			return sdkerrors.Wrapf(ErrInvalidClass, "unknown mutability %q for %s", mutability, field)
+		}
+	}
+	return nil
+}
+
+// ChangedFields compares two metadata values and returns the mutability of
+// every field that differs, keyed by field name
+func (m MetadataMutability) ChangedFields(from, to Metadata) map[string]string {
+	changed := make(map[string]string)
+	if from.Name != to.Name {
+		changed["name"] = m.Name
+	}
+	if from.Description != to.Description {
+		changed["description"] = m.Description
+	}
+	if from.Image != to.Image {
+		changed["image"] = m.Image
+	}
+	if !propertiesEqual(from.Properties, to.Properties) {
+		changed["properties"] = m.Properties
+	}
+	return changed
+}
+
+// MutableBy reports whether a field with the given mutability may be changed
+// by an owner and/or the mint authority
+func MutableBy(mutability string, isOwner, isAuthority bool) bool {
+	switch mutability {
+	case MutabilityOwner:
+		return isOwner
+	case MutabilityAuthority:
+		return isAuthority
+	case MutabilityOwnerOrAuthority:
+		return isOwner || isAuthority
+	default:
+		return false
+	}
+}
+
+func propertiesEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if w, ok := b[k]; !ok || w != v {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate checks that a royalty has a recipient whenever it is non-zero
+func (r RoyaltyInfo) Validate() error {
+	if r.BasisPoints > MaxRoyaltyBasisPoints {
+		return sdkerrors.Wrapf(ErrInvalidClass, "royalty cannot exceed %d basis points", MaxRoyaltyBasisPoints)
+	}
+
+	if r.BasisPoints == 0 {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(r.Recipient); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid royalty recipient address (%s)", err)
+	}
+
+	return nil
+}
+
+// ValidateClassID checks that a class identifier is lowercase alphanumeric
+// with dashes, so it can safely prefix NFT IDs
+func ValidateClassID(id string) error {
+	if len(id) < MinClassIDLength || len(id) > MaxClassIDLength {
+		return sdkerrors.Wrapf(ErrInvalidClass, "class id must be %d to %d characters", MinClassIDLength, MaxClassIDLength)
+	}
+
+	for _, r := range id {
+		if !(r >= 'a' && r <= 'z') && !(r >= '0' && r <= '9') && r != '-' {
+			return sdkerrors.Wrapf(ErrInvalidClass, "class id %q may only contain a-z, 0-9 and '-'", id)
+		}
+	}
+
+	return nil
+}
+
+// NFTID returns the full ID of the NFT with the given class-local ID
+func NFTID(classID, localID string) string {
+	return classID + classIDSeparator + localID
+}
+
+// ClassIDFromNFTID returns the class part of a full NFT ID
+func ClassIDFromNFTID(id string) string {
+	if i := strings.Index(id, classIDSeparator); i >= 0 {
+		return id[:i]
+	}
+	return ""
+}
+
+// ProtoMessage implements the proto.Message interface for Class.
+func (c *Class) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for Class.
+func (c *Class) Reset() { *c = Class{} }
+
+// String implements the fmt.Stringer interface for Class.
+func (c *Class) String() string {
+	return fmt.Sprintf("Class{ID: %s, Name: %s, MintAuthority: %s, MaxSupply: %d}", c.ID, c.Name, c.MintAuthority, c.MaxSupply)
+}
+
+// Marshal implements codec.ProtoMarshaler for Class.
+func (c *Class) Marshal() ([]byte, error) { return json.Marshal(c) }
+
+// MarshalTo implements codec.ProtoMarshaler for Class.
+func (c *Class) MarshalTo(data []byte) (int, error) { return jsonMarshalTo(c, data) }
+
+// MarshalToSizedBuffer implements codec.ProtoMarshaler for Class.
+func (c *Class) MarshalToSizedBuffer(data []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(c, data)
+}
+
+// Unmarshal implements codec.ProtoMarshaler for Class.
+func (c *Class) Unmarshal(data []byte) error { return json.Unmarshal(data, c) }
+
+// Size implements codec.ProtoMarshaler for Class.
+func (c *Class) Size() int { return jsonSize(c) }
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgMintNFT{}, "nft/MintNFT", nil)
+	cdc.RegisterConcrete(&MsgTransferNFT{}, "nft/TransferNFT", nil)
+	cdc.RegisterConcrete(&MsgAttachToItem{}, "nft/AttachToItem", nil)
+	cdc.RegisterConcrete(&MsgDetachFromItem{}, "nft/DetachFromItem", nil)
+	cdc.RegisterConcrete(&MsgAddBadgeIssuer{}, "nft/AddBadgeIssuer", nil)
+	cdc.RegisterConcrete(&MsgRemoveBadgeIssuer{}, "nft/RemoveBadgeIssuer", nil)
+	cdc.RegisterConcrete(&MsgRevokeBadge{}, "nft/RevokeBadge", nil)
+	cdc.RegisterConcrete(&MsgCreateClass{}, "nft/CreateClass", nil)
+	cdc.RegisterConcrete(&MsgBurnNFT{}, "nft/BurnNFT", nil)
+	cdc.RegisterConcrete(&MsgUpdateNFTMetadata{}, "nft/UpdateNFTMetadata", nil)
+	cdc.RegisterConcrete(&MsgBatchMintNFT{}, "nft/BatchMintNFT", nil)
+	cdc.RegisterConcrete(&MsgBatchTransferNFT{}, "nft/BatchTransferNFT", nil)
+	cdc.RegisterConcrete(&MsgSetUser{}, "nft/SetUser", nil)
+}
+
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgMintNFT{},
+		&MsgTransferNFT{},
+		&MsgAttachToItem{},
+		&MsgDetachFromItem{},
+		&MsgAddBadgeIssuer{},
+		&MsgRemoveBadgeIssuer{},
+		&MsgRevokeBadge{},
+		&MsgCreateClass{},
+		&MsgBurnNFT{},
+		&MsgUpdateNFTMetadata{},
+		&MsgBatchMintNFT{},
+		&MsgBatchTransferNFT{},
+		&MsgSetUser{},
+	)
+}
+
+var (
+	Amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
+)
+
+func init() {
+	RegisterCodec(Amino)
+	RegisterInterfaces(ModuleCdc.InterfaceRegistry())
+	Amino.Seal()
+}
@@ -0,0 +1,28 @@
+package types
+
+import "encoding/json"
+
+// The nft types are hand-written rather than generated by protoc, so they
+// satisfy codec.ProtoMarshaler by encoding themselves as JSON. These helpers
+// back the Size/MarshalTo/MarshalToSizedBuffer methods of every such type.
+
+func jsonSize(v interface{}) int {
+	bz, _ := json.Marshal(v)
+	return len(bz)
+}
+
+func jsonMarshalTo(v interface{}, data []byte) (int, error) {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return 0, err
+	}
+	return copy(data, bz), nil
+}
+
+func jsonMarshalToSizedBuffer(v interface{}, data []byte) (int, error) {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return 0, err
+	}
+	return copy(data[len(data)-len(bz):], bz), nil
+}
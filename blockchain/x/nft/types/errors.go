@@ -11,4 +11,13 @@ var (
 	ErrNonTransferable     = sdkerrors.Register(ModuleName, 4, "NFT cannot be transferred")
 	ErrNonTransferableNFT  = sdkerrors.Register(ModuleName, 5, "NFT cannot be transferred")
 	ErrInvalidNFTType      = sdkerrors.Register(ModuleName, 6, "invalid NFT type")
+	ErrInvalidAttachment   = sdkerrors.Register(ModuleName, 7, "invalid attachment")
+	ErrInvalidLand         = sdkerrors.Register(ModuleName, 8, "invalid land parcel")
+	ErrLandOverlap         = sdkerrors.Register(ModuleName, 9, "land parcel overlaps an existing parcel")
+	ErrInvalidBadge        = sdkerrors.Register(ModuleName, 10, "invalid badge")
+	ErrInvalidClass        = sdkerrors.Register(ModuleName, 11, "invalid class")
+	ErrClassExists         = sdkerrors.Register(ModuleName, 12, "class already exists")
+	ErrClassNotFound       = sdkerrors.Register(ModuleName, 13, "class not found")
+	ErrMaxSupplyReached    = sdkerrors.Register(ModuleName, 14, "class max supply reached")
+	ErrInvalidRental       = sdkerrors.Register(ModuleName, 15, "invalid rental")
 )
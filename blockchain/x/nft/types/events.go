@@ -0,0 +1,54 @@
+package types
+
+// nft module event types
+const (
+	// EventTypeMintNFT defines the event type for minting an NFT
+	EventTypeMintNFT = "mint_nft"
+	// EventTypeTransferNFT defines the event type for transferring an NFT
+	EventTypeTransferNFT = "transfer_nft"
+	// EventTypeAttachToItem defines the event type for attaching an NFT to an item
+	EventTypeAttachToItem = "attach_to_item"
+	// EventTypeDetachFromItem defines the event type for detaching an NFT from an item
+	EventTypeDetachFromItem = "detach_from_item"
+	// EventTypeAddBadgeIssuer defines the event type for registering a badge issuer
+	EventTypeAddBadgeIssuer = "add_badge_issuer"
+	// EventTypeRemoveBadgeIssuer defines the event type for removing a badge issuer
+	EventTypeRemoveBadgeIssuer = "remove_badge_issuer"
+	// EventTypeRevokeBadge defines the event type for revoking a badge
+	EventTypeRevokeBadge = "revoke_badge"
+	// EventTypeCreateClass defines the event type for creating an NFT class
+	EventTypeCreateClass = "create_class"
+	// EventTypeBurnNFT defines the event type for burning an NFT
+	EventTypeBurnNFT = "burn_nft"
+	// EventTypeUpdateNFTMetadata defines the event type for updating NFT metadata
+	EventTypeUpdateNFTMetadata = "update_nft_metadata"
+	// EventTypeSetUser defines the event type for renting an NFT to a user
+	EventTypeSetUser = "set_user"
+	// EventTypeUserExpired defines the event type for a rental running out
+	EventTypeUserExpired = "user_expired"
+
+	// AttributeKeyNFTID defines the event attribute for the NFT id
+	AttributeKeyNFTID = "nft_id"
+	// AttributeKeyNFTType defines the event attribute for the NFT type
+	AttributeKeyNFTType = "nft_type"
+	// AttributeKeySender defines the event attribute for the message sender
+	AttributeKeySender = "sender"
+	// AttributeKeyRecipient defines the event attribute for the receiving account
+	AttributeKeyRecipient = "recipient"
+	// AttributeKeyItemID defines the event attribute for the parent item id
+	AttributeKeyItemID = "item_id"
+	// AttributeKeyIssuer defines the event attribute for the badge issuer
+	AttributeKeyIssuer = "issuer"
+	// AttributeKeyAchievement defines the event attribute for the badge achievement
+	AttributeKeyAchievement = "achievement"
+	// AttributeKeyClassID defines the event attribute for the class id
+	AttributeKeyClassID = "class_id"
+	// AttributeKeyMintAuthority defines the event attribute for a class mint authority
+	AttributeKeyMintAuthority = "mint_authority"
+	// AttributeKeyFields defines the event attribute for the comma-separated updated fields
+	AttributeKeyFields = "fields"
+	// AttributeKeyUser defines the event attribute for the renting user
+	AttributeKeyUser = "user"
+	// AttributeKeyExpires defines the event attribute for the rental expiry
+	AttributeKeyExpires = "expires"
+)
@@ -1,18 +1,234 @@
 package types
 
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
 // GenesisState defines the NFT module's genesis state
 type GenesisState struct {
-    NFTs []NFT `json:"nfts"`
+	Classes      []Class       `json:"classes"`
+	NFTs         []NFT         `json:"nfts"`
+	BadgeIssuers []BadgeIssuer `json:"badge_issuers"`
+	// ClassMinted records how many NFTs each class has ever minted, so
+	// supply caps keep counting burned NFTs after a relaunch
+	ClassMinted []ClassMinted `json:"class_minted"`
+	Rentals     []Rental      `json:"rentals"`
+}
+
+// ClassMinted is the number of NFTs ever minted in a class
+type ClassMinted struct {
+	ClassID string `json:"class_id"`
+	Minted  uint64 `json:"minted"`
 }
 
 // DefaultGenesisState returns the NFT module's default genesis state
 func DefaultGenesisState() *GenesisState {
-    return &GenesisState{
-        NFTs: []NFT{},
-    }
+	return &GenesisState{
+		Classes:      []Class{},
+		NFTs:         []NFT{},
+		BadgeIssuers: []BadgeIssuer{},
+		ClassMinted:  []ClassMinted{},
+		Rentals:      []Rental{},
+	}
 }
 
 // Validate performs basic genesis state validation
 func (gs GenesisState) Validate() error {
-    return nil // Simplified for now
+	classes := make(map[string]Class, len(gs.Classes))
+	for _, class := range gs.Classes {
+		if _, ok := classes[class.ID]; ok {
+			return sdkerrors.Wrapf(ErrClassExists, "duplicate class %s", class.ID)
+		}
+		if err := class.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "class %s", class.ID)
+		}
+		classes[class.ID] = class
+	}
+
+	issuers := make(map[string]bool, len(gs.BadgeIssuers))
+	for _, issuer := range gs.BadgeIssuers {
+		if issuers[issuer.Address] {
+			return fmt.Errorf("duplicate badge issuer %s", issuer.Address)
+		}
+		if err := issuer.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "badge issuer %s", issuer.Address)
+		}
+		issuers[issuer.Address] = true
+	}
+
+	nfts := make(map[string]NFT, len(gs.NFTs))
+	supply := make(map[string]uint64, len(gs.Classes))
+	cells := make(map[Location]string)
+	for _, nft := range gs.NFTs {
+		if _, ok := nfts[nft.ID]; ok {
+			return sdkerrors.Wrapf(ErrNFTExists, "duplicate NFT %s", nft.ID)
+		}
+		if err := validateGenesisNFT(nft, classes); err != nil {
+			return sdkerrors.Wrapf(err, "NFT %s", nft.ID)
+		}
+		if nft.Land != nil {
+			if err := claimLandCells(cells, nft.ID, *nft.Land); err != nil {
+				return err
+			}
+		}
+		nfts[nft.ID] = nft
+		supply[nft.ClassID]++
+	}
+
+	attachments := make(map[string]int)
+	for _, nft := range gs.NFTs {
+		if err := validateGenesisParent(nft, nfts); err != nil {
+			return sdkerrors.Wrapf(err, "NFT %s", nft.ID)
+		}
+		if nft.Parent != "" {
+			if attachments[nft.Parent]++; attachments[nft.Parent] > MaxAttachmentsPerItem {
+				return sdkerrors.Wrapf(ErrInvalidAttachment, "%s holds more than %d attachments", nft.Parent, MaxAttachmentsPerItem)
+			}
+		}
+	}
+
+	rented := make(map[string]bool, len(gs.Rentals))
+	for _, rental := range gs.Rentals {
+		if rented[rental.NFTID] {
+			return sdkerrors.Wrapf(ErrInvalidRental, "duplicate rental of %s", rental.NFTID)
+		}
+		if err := rental.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "rental of %s", rental.NFTID)
+		}
+		nft, ok := nfts[rental.NFTID]
+		if !ok {
+			return sdkerrors.Wrapf(ErrNFTNotFound, "rental of %s", rental.NFTID)
+		}
+		if !IsRentableNFTType(nft.Type) {
+			return sdkerrors.Wrapf(ErrInvalidRental, "%s NFTs cannot be rented out", nft.Type)
+		}
+		rented[rental.NFTID] = true
+	}
+
+	minted := make(map[string]uint64, len(gs.ClassMinted))
+	for _, entry := range gs.ClassMinted {
+		if _, ok := classes[entry.ClassID]; !ok {
+			return sdkerrors.Wrapf(ErrClassNotFound, "minted count for unknown class %s", entry.ClassID)
+		}
+		if _, ok := minted[entry.ClassID]; ok {
+			return fmt.Errorf("duplicate minted count for class %s", entry.ClassID)
+		}
+		minted[entry.ClassID] = entry.Minted
+	}
+
+	for _, class := range gs.Classes {
+		total, ok := minted[class.ID]
+		if !ok {
+			total = supply[class.ID]
+		}
+		if total < supply[class.ID] {
+			return fmt.Errorf("class %s has %d NFTs but only %d minted", class.ID, supply[class.ID], total)
+		}
+		if class.MaxSupply > 0 && total > class.MaxSupply {
+			return sdkerrors.Wrapf(ErrMaxSupplyReached, "class %s minted %d NFTs, above its cap of %d", class.ID, total, class.MaxSupply)
+		}
+	}
+
+	return nil
+}
+
+// validateGenesisNFT checks a single NFT against the rules MintNFT enforces
+func validateGenesisNFT(nft NFT, classes map[string]Class) error {
+	if err := ValidateNFTID(nft.ID); err != nil {
+		return err
+	}
+
+	if _, ok := classes[nft.ClassID]; !ok {
+		return sdkerrors.Wrap(ErrClassNotFound, nft.ClassID)
+	}
+
+	if ClassIDFromNFTID(nft.ID) != nft.ClassID {
+		return sdkerrors.Wrapf(ErrInvalidClass, "id is not scoped to class %s", nft.ClassID)
+	}
+
+	if !IsValidNFTType(nft.Type) {
+		return sdkerrors.Wrap(ErrInvalidNFTType, nft.Type)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(nft.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+
+	if strings.TrimSpace(nft.Metadata.Name) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "metadata name cannot be empty")
+	}
+
+	if nft.Type == TypeLand {
+		if nft.Land == nil {
+			return sdkerrors.Wrap(ErrInvalidLand, "land NFTs require a location and size")
+		}
+		if err := nft.Land.Validate(); err != nil {
+			return err
+		}
+	} else if nft.Land != nil {
+		return sdkerrors.Wrapf(ErrInvalidLand, "%s NFTs cannot carry land data", nft.Type)
+	}
+
+	if nft.Type == TypeBadge {
+		if nft.Badge == nil || strings.TrimSpace(nft.Badge.Achievement) == "" {
+			return sdkerrors.Wrap(ErrInvalidBadge, "badge NFTs require an achievement")
+		}
+		if nft.Transferable {
+			return sdkerrors.Wrap(ErrInvalidBadge, "badges are soulbound and cannot be transferable")
+		}
+	} else if nft.Badge != nil {
+		return sdkerrors.Wrapf(ErrInvalidBadge, "%s NFTs cannot carry badge data", nft.Type)
+	}
+
+	return nil
+}
+
+// validateGenesisParent checks that an attached NFT hangs below an item it
+// shares an owner with, without cycles and within the nesting limit
+func validateGenesisParent(nft NFT, nfts map[string]NFT) error {
+	if nft.Parent == "" {
+		return nil
+	}
+
+	if nft.Type != TypeAttachment {
+		return sdkerrors.Wrapf(ErrInvalidAttachment, "%s NFTs cannot be attached", nft.Type)
+	}
+
+	depth := 0
+	for id := nft.Parent; id != ""; id = nfts[id].Parent {
+		parent, ok := nfts[id]
+		if !ok {
+			return sdkerrors.Wrapf(ErrNFTNotFound, "parent %s", id)
+		}
+		if parent.Type != TypeItem && parent.Type != TypeAttachment {
+			return sdkerrors.Wrapf(ErrInvalidAttachment, "%s cannot hold attachments", id)
+		}
+		if parent.Owner != nft.Owner {
+			return sdkerrors.Wrapf(ErrInvalidAttachment, "%s has a different owner", id)
+		}
+		if depth++; depth > MaxAttachmentDepth || id == nft.ID {
+			return sdkerrors.Wrapf(ErrInvalidAttachment, "attachments cannot be nested more than %d deep or in a cycle", MaxAttachmentDepth)
+		}
+	}
+
+	return nil
+}
+
+// claimLandCells marks every cell of a parcel as taken by id, failing if any
+// cell already belongs to another parcel
+func claimLandCells(cells map[Location]string, id string, land LandMetadata) error {
+	for dx := int64(0); dx < int64(land.Size); dx++ {
+		for dy := int64(0); dy < int64(land.Size); dy++ {
+			cell := Location{X: int32(int64(land.Location.X) + dx), Y: int32(int64(land.Location.Y) + dy)}
+			if other, ok := cells[cell]; ok {
+				return sdkerrors.Wrapf(ErrLandOverlap, "parcel %s overlaps %s at (%d, %d)", id, other, cell.X, cell.Y)
+			}
+			cells[cell] = id
+		}
+	}
+	return nil
 }
@@ -1,10 +0,0 @@
-package types
-
-// ProtoMessage implements the proto.Message interface for Item.
-func (i *Item) ProtoMessage() {}
-
-// Reset implements the proto.Message interface for Item.
-func (i *Item) Reset() {}
-
-// String implements the fmt.Stringer interface for Item.
-func (i *Item) String() string { return "Item stub" }
\ No newline at end of file
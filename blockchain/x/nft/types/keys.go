@@ -1,7 +1,167 @@
 package types
 
+import (
+	"encoding/binary"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
-    StoreKey     = "nft"
-    RouterKey    = "nft"
-    QuerierRoute = "nft"
+	StoreKey     = "nft"
+	RouterKey    = "nft"
+	QuerierRoute = "nft"
 )
+
+// Keys for nft store
+var (
+	// NFTKey prefixes the primary NFT records, keyed by ID
+	NFTKey = []byte{0x01}
+
+	// NFTByOwnerKey prefixes the owner index: owner | "/" | id
+	NFTByOwnerKey = []byte{0x02}
+
+	// NFTByTypeKey prefixes the type index: type | "/" | id
+	NFTByTypeKey = []byte{0x03}
+
+	// LandCellKey prefixes the spatial index: x | y -> id of the covering parcel
+	LandCellKey = []byte{0x04}
+
+	// NFTByParentKey prefixes the attachment index: parent | "/" | id
+	NFTByParentKey = []byte{0x05}
+
+	// BadgeIssuerKey prefixes the badge issuer registry, keyed by address
+	BadgeIssuerKey = []byte{0x06}
+
+	// ClassKey prefixes the class records, keyed by class ID
+	ClassKey = []byte{0x07}
+
+	// ClassSupplyKey prefixes the number of NFTs currently in each class
+	ClassSupplyKey = []byte{0x08}
+
+	// ClassMintedKey prefixes the number of NFTs ever minted in each class
+	ClassMintedKey = []byte{0x09}
+
+	// NFTByClassKey prefixes the class index: class | "/" | id
+	NFTByClassKey = []byte{0x0A}
+
+	// RentalKey prefixes the rental records, keyed by NFT ID
+	RentalKey = []byte{0x0B}
+
+	// RentalExpiryKey prefixes the rental expiry queue: expires | id
+	RentalExpiryKey = []byte{0x0C}
+
+	// NFTByUserKey prefixes the renter index: user | "/" | id
+	NFTByUserKey = []byte{0x0D}
+)
+
+// GetNFTKey returns the primary store key for an NFT
+func GetNFTKey(id string) []byte {
+	return append(append([]byte{}, NFTKey...), []byte(id)...)
+}
+
+// GetNFTByOwnerPrefix returns the owner index prefix for all NFTs of owner
+func GetNFTByOwnerPrefix(owner string) []byte {
+	return append(append([]byte{}, NFTByOwnerKey...), []byte(owner+"/")...)
+}
+
+// GetNFTByOwnerKey returns the owner index key for a single NFT
+func GetNFTByOwnerKey(owner, id string) []byte {
+	return append(GetNFTByOwnerPrefix(owner), []byte(id)...)
+}
+
+// GetNFTByTypePrefix returns the type index prefix for all NFTs of nftType
+func GetNFTByTypePrefix(nftType string) []byte {
+	return append(append([]byte{}, NFTByTypeKey...), []byte(nftType+"/")...)
+}
+
+// GetNFTByTypeKey returns the type index key for a single NFT
+func GetNFTByTypeKey(nftType, id string) []byte {
+	return append(GetNFTByTypePrefix(nftType), []byte(id)...)
+}
+
+// GetNFTByParentPrefix returns the attachment index prefix for all NFTs attached to parent
+func GetNFTByParentPrefix(parent string) []byte {
+	return append(append([]byte{}, NFTByParentKey...), []byte(parent+"/")...)
+}
+
+// GetNFTByParentKey returns the attachment index key for a single attached NFT
+func GetNFTByParentKey(parent, id string) []byte {
+	return append(GetNFTByParentPrefix(parent), []byte(id)...)
+}
+
+// GetBadgeIssuerKey returns the registry key for a badge issuer
+func GetBadgeIssuerKey(address string) []byte {
+	return append(append([]byte{}, BadgeIssuerKey...), []byte(address)...)
+}
+
+// GetClassKey returns the store key for a class
+func GetClassKey(classID string) []byte {
+	return append(append([]byte{}, ClassKey...), []byte(classID)...)
+}
+
+// GetClassSupplyKey returns the store key for the current supply of a class
+func GetClassSupplyKey(classID string) []byte {
+	return append(append([]byte{}, ClassSupplyKey...), []byte(classID)...)
+}
+
+// GetClassMintedKey returns the store key for the mint count of a class
+func GetClassMintedKey(classID string) []byte {
+	return append(append([]byte{}, ClassMintedKey...), []byte(classID)...)
+}
+
+// GetNFTByClassPrefix returns the class index prefix for all NFTs of a class
+func GetNFTByClassPrefix(classID string) []byte {
+	return append(append([]byte{}, NFTByClassKey...), []byte(classID+"/")...)
+}
+
+// GetNFTByClassKey returns the class index key for a single NFT
+func GetNFTByClassKey(classID, id string) []byte {
+	return append(GetNFTByClassPrefix(classID), []byte(id)...)
+}
+
+// GetRentalKey returns the store key for the rental of an NFT
+func GetRentalKey(id string) []byte {
+	return append(append([]byte{}, RentalKey...), []byte(id)...)
+}
+
+// GetRentalExpiryPrefix returns the expiry queue prefix for rentals ending at expires
+func GetRentalExpiryPrefix(expires time.Time) []byte {
+	return append(append([]byte{}, RentalExpiryKey...), sdk.FormatTimeBytes(expires)...)
+}
+
+// GetRentalExpiryKey returns the expiry queue key for a rental
+func GetRentalExpiryKey(expires time.Time, id string) []byte {
+	return append(GetRentalExpiryPrefix(expires), []byte(id)...)
+}
+
+// GetNFTByUserPrefix returns the renter index prefix for all NFTs rented by user
+func GetNFTByUserPrefix(user string) []byte {
+	return append(append([]byte{}, NFTByUserKey...), []byte(user+"/")...)
+}
+
+// GetNFTByUserKey returns the renter index key for a single rented NFT
+func GetNFTByUserKey(user, id string) []byte {
+	return append(GetNFTByUserPrefix(user), []byte(id)...)
+}
+
+// GetLandColumnPrefix returns the spatial index prefix for all cells with coordinate x
+func GetLandColumnPrefix(x int32) []byte {
+	bz := make([]byte, len(LandCellKey)+4)
+	copy(bz, LandCellKey)
+	binary.BigEndian.PutUint32(bz[len(LandCellKey):], encodeCoordinate(x))
+	return bz
+}
+
+// GetLandCellKey returns the spatial index key for the cell at (x, y)
+func GetLandCellKey(x, y int32) []byte {
+	bz := make([]byte, 4)
+	binary.BigEndian.PutUint32(bz, encodeCoordinate(y))
+	return append(GetLandColumnPrefix(x), bz...)
+}
+
+// encodeCoordinate maps a signed coordinate onto an unsigned value whose
+// big-endian encoding sorts in numeric order.
+func encodeCoordinate(c int32) uint32 {
+	return uint32(c) ^ 0x80000000
+}
@@ -1,10 +1,57 @@
 package types
 
-// ProtoMessage implements the proto.Message interface for Land.
-func (l *Land) ProtoMessage() {}
+import (
+	"math"
 
-// Reset implements the proto.Message interface for Land.
-func (l *Land) Reset() {}
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
 
-// String implements the fmt.Stringer interface for Land.
-func (l *Land) String() string { return "Land stub" }
\ No newline at end of file
+const (
+	// MaxLandSize bounds the side length of a single land parcel
+	MaxLandSize = 64
+
+	// MaxLandQuerySpan bounds the width and height of a bounding box query
+	MaxLandQuerySpan = 256
+)
+
+// Validate checks that the parcel has a usable size and lies inside the map
+func (l LandMetadata) Validate() error {
+	if l.Size == 0 || l.Size > MaxLandSize {
+		return sdkerrors.Wrapf(ErrInvalidLand, "size must be between 1 and %d", MaxLandSize)
+	}
+
+	if int64(l.Location.X)+int64(l.Size)-1 > math.MaxInt32 ||
+		int64(l.Location.Y)+int64(l.Size)-1 > math.MaxInt32 {
+		return sdkerrors.Wrap(ErrInvalidLand, "parcel extends past the edge of the map")
+	}
+
+	return nil
+}
+
+// MaxX returns the largest x coordinate covered by the parcel
+func (l LandMetadata) MaxX() int32 {
+	return l.Location.X + int32(l.Size) - 1
+}
+
+// MaxY returns the largest y coordinate covered by the parcel
+func (l LandMetadata) MaxY() int32 {
+	return l.Location.Y + int32(l.Size) - 1
+}
+
+// Contains reports whether the cell (x, y) lies inside the parcel
+func (l LandMetadata) Contains(x, y int32) bool {
+	return x >= l.Location.X && x <= l.MaxX() && y >= l.Location.Y && y <= l.MaxY()
+}
+
+// ValidateLandArea checks the corners of a bounding box query
+func ValidateLandArea(minX, minY, maxX, maxY int32) error {
+	if minX > maxX || minY > maxY {
+		return sdkerrors.Wrap(ErrInvalidLand, "min corner must not exceed max corner")
+	}
+
+	if int64(maxX)-int64(minX) >= MaxLandQuerySpan || int64(maxY)-int64(minY) >= MaxLandQuerySpan {
+		return sdkerrors.Wrapf(ErrInvalidLand, "area cannot be wider or taller than %d cells", MaxLandQuerySpan)
+	}
+
+	return nil
+}
@@ -1,5 +1,791 @@
 package types
 
-func NewMsgMintNFT(sender, id, nftType, name, uri string) {}
-func NewMsgTransferNFT(sender, id, recipient string) {}
-func NewMsgAttachToItem(sender, id, item string) {}
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgMintNFT           = "mint_nft"
+	TypeMsgTransferNFT       = "transfer_nft"
+	TypeMsgAttachToItem      = "attach_to_item"
+	TypeMsgDetachFromItem    = "detach_from_item"
+	TypeMsgAddBadgeIssuer    = "add_badge_issuer"
+	TypeMsgRemoveBadgeIssuer = "remove_badge_issuer"
+	TypeMsgRevokeBadge       = "revoke_badge"
+	TypeMsgCreateClass       = "create_class"
+	TypeMsgBurnNFT           = "burn_nft"
+	TypeMsgUpdateNFTMetadata = "update_nft_metadata"
+	TypeMsgSetUser           = "set_user"
+
+	// MaxNFTIDLength bounds the length of an NFT identifier
+	MaxNFTIDLength = 128
+
+	// MaxAttachmentDepth bounds how deeply attachments can be nested below a root item
+	MaxAttachmentDepth = 4
+
+	// MaxAttachmentsPerItem bounds the number of NFTs attached directly to one parent
+	MaxAttachmentsPerItem = 16
+)
+
+var (
+	_ sdk.Msg = &MsgMintNFT{}
+	_ sdk.Msg = &MsgTransferNFT{}
+	_ sdk.Msg = &MsgAttachToItem{}
+	_ sdk.Msg = &MsgDetachFromItem{}
+	_ sdk.Msg = &MsgAddBadgeIssuer{}
+	_ sdk.Msg = &MsgRemoveBadgeIssuer{}
+	_ sdk.Msg = &MsgRevokeBadge{}
+	_ sdk.Msg = &MsgCreateClass{}
+	_ sdk.Msg = &MsgBurnNFT{}
+	_ sdk.Msg = &MsgUpdateNFTMetadata{}
+	_ sdk.Msg = &MsgSetUser{}
+)
+
+// MsgMintNFT mints a new NFT in a class to the recipient. ID is local to the
+// class; the minted NFT's full ID is "<class-id>:<id>".
+type MsgMintNFT struct {
+	Sender    string   `json:"sender"`
+	ClassID   string   `json:"class_id"`
+	ID        string   `json:"id"`
+	NFTType   string   `json:"nft_type"`
+	Recipient string   `json:"recipient"`
+	Metadata  Metadata `json:"metadata"`
+	// Land places the parcel on the map and is required for land NFTs
+	Land *LandMetadata `json:"land,omitempty"`
+	// Badge describes the achievement and is required for badge NFTs. The
+	// issuer and date earned are filled in on chain.
+	Badge *BadgeMetadata `json:"badge,omitempty"`
+}
+
+// NewMsgMintNFT creates a new MsgMintNFT
+func NewMsgMintNFT(sender, classID, id, nftType, recipient string, metadata Metadata) *MsgMintNFT {
+	return &MsgMintNFT{
+		Sender:    sender,
+		ClassID:   classID,
+		ID:        id,
+		NFTType:   nftType,
+		Recipient: recipient,
+		Metadata:  metadata,
+	}
+}
+
+// NewMsgMintLand creates a new MsgMintNFT for a land parcel
+func NewMsgMintLand(sender, classID, id, recipient string, metadata Metadata, land LandMetadata) *MsgMintNFT {
+	msg := NewMsgMintNFT(sender, classID, id, TypeLand, recipient, metadata)
+	msg.Land = &land
+	return msg
+}
+
+// NewMsgMintBadge creates a new MsgMintNFT awarding a badge to a player
+func NewMsgMintBadge(issuer, classID, id, player string, metadata Metadata, achievement string, permanent bool) *MsgMintNFT {
+	msg := NewMsgMintNFT(issuer, classID, id, TypeBadge, player, metadata)
+	msg.Badge = &BadgeMetadata{Achievement: achievement, Permanent: permanent}
+	return msg
+}
+
+// Route returns the route of MsgMintNFT
+func (msg *MsgMintNFT) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of MsgMintNFT
+func (msg *MsgMintNFT) Type() string {
+	return TypeMsgMintNFT
+}
+
+// GetSigners returns the signers of MsgMintNFT
+func (msg *MsgMintNFT) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// GetSignBytes returns the sign bytes of MsgMintNFT
+func (msg *MsgMintNFT) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validates the basic fields of MsgMintNFT
+func (msg *MsgMintNFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address (%s)", err)
+	}
+
+	if err := ValidateClassID(msg.ClassID); err != nil {
+		return err
+	}
+
+	if strings.Contains(msg.ID, classIDSeparator) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "NFT id cannot contain '%s'", classIDSeparator)
+	}
+
+	if err := ValidateNFTID(msg.ID); err != nil {
+		return err
+	}
+
+	if err := ValidateNFTID(NFTID(msg.ClassID, msg.ID)); err != nil {
+		return err
+	}
+
+	if !IsValidNFTType(msg.NFTType) {
+		return sdkerrors.Wrap(ErrInvalidNFTType, msg.NFTType)
+	}
+
+	if strings.TrimSpace(msg.Metadata.Name) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "metadata name cannot be empty")
+	}
+
+	if msg.NFTType == TypeLand {
+		if msg.Land == nil {
+			return sdkerrors.Wrap(ErrInvalidLand, "land NFTs require a location and size")
+		}
+		if err := msg.Land.Validate(); err != nil {
+			return err
+		}
+	} else if msg.Land != nil {
+		return sdkerrors.Wrapf(ErrInvalidLand, "%s NFTs cannot carry land data", msg.NFTType)
+	}
+
+	if msg.NFTType == TypeBadge {
+		if msg.Badge == nil || strings.TrimSpace(msg.Badge.Achievement) == "" {
+			return sdkerrors.Wrap(ErrInvalidBadge, "badge NFTs require an achievement")
+		}
+	} else if msg.Badge != nil {
+		return sdkerrors.Wrapf(ErrInvalidBadge, "%s NFTs cannot carry badge data", msg.NFTType)
+	}
+
+	return nil
+}
+
+// MsgTransferNFT transfers an NFT from the sender to the recipient
+type MsgTransferNFT struct {
+	Sender    string `json:"sender"`
+	ID        string `json:"id"`
+	Recipient string `json:"recipient"`
+}
+
+// NewMsgTransferNFT creates a new MsgTransferNFT
+func NewMsgTransferNFT(sender, id, recipient string) *MsgTransferNFT {
+	return &MsgTransferNFT{
+		Sender:    sender,
+		ID:        id,
+		Recipient: recipient,
+	}
+}
+
+// Route returns the route of MsgTransferNFT
+func (msg *MsgTransferNFT) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of MsgTransferNFT
+func (msg *MsgTransferNFT) Type() string {
+	return TypeMsgTransferNFT
+}
+
+// GetSigners returns the signers of MsgTransferNFT
+func (msg *MsgTransferNFT) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// GetSignBytes returns the sign bytes of MsgTransferNFT
+func (msg *MsgTransferNFT) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validates the basic fields of MsgTransferNFT
+func (msg *MsgTransferNFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address (%s)", err)
+	}
+
+	if msg.Sender == msg.Recipient {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot transfer NFT to yourself")
+	}
+
+	return ValidateNFTID(msg.ID)
+}
+
+// MsgAttachToItem attaches an attachment NFT to an item NFT owned by the sender
+type MsgAttachToItem struct {
+	Sender       string `json:"sender"`
+	ItemID       string `json:"item_id"`
+	AttachmentID string `json:"attachment_id"`
+}
+
+// NewMsgAttachToItem creates a new MsgAttachToItem
+func NewMsgAttachToItem(sender, itemID, attachmentID string) *MsgAttachToItem {
+	return &MsgAttachToItem{
+		Sender:       sender,
+		ItemID:       itemID,
+		AttachmentID: attachmentID,
+	}
+}
+
+// Route returns the route of MsgAttachToItem
+func (msg *MsgAttachToItem) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of MsgAttachToItem
+func (msg *MsgAttachToItem) Type() string {
+	return TypeMsgAttachToItem
+}
+
+// GetSigners returns the signers of MsgAttachToItem
+func (msg *MsgAttachToItem) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// GetSignBytes returns the sign bytes of MsgAttachToItem
+func (msg *MsgAttachToItem) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validates the basic fields of MsgAttachToItem
+func (msg *MsgAttachToItem) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	if err := ValidateNFTID(msg.ItemID); err != nil {
+		return err
+	}
+
+	if err := ValidateNFTID(msg.AttachmentID); err != nil {
+		return err
+	}
+
+	if msg.ItemID == msg.AttachmentID {
+		return sdkerrors.Wrap(ErrInvalidAttachment, "cannot attach an NFT to itself")
+	}
+
+	return nil
+}
+
+// MsgDetachFromItem detaches an attachment NFT from its parent, returning it
+// to the owner's top-level inventory
+type MsgDetachFromItem struct {
+	Sender       string `json:"sender"`
+	AttachmentID string `json:"attachment_id"`
+}
+
+// NewMsgDetachFromItem creates a new MsgDetachFromItem
+func NewMsgDetachFromItem(sender, attachmentID string) *MsgDetachFromItem {
+	return &MsgDetachFromItem{
+		Sender:       sender,
+		AttachmentID: attachmentID,
+	}
+}
+
+// Route returns the route of MsgDetachFromItem
+func (msg *MsgDetachFromItem) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of MsgDetachFromItem
+func (msg *MsgDetachFromItem) Type() string {
+	return TypeMsgDetachFromItem
+}
+
+// GetSigners returns the signers of MsgDetachFromItem
+func (msg *MsgDetachFromItem) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// GetSignBytes returns the sign bytes of MsgDetachFromItem
+func (msg *MsgDetachFromItem) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validates the basic fields of MsgDetachFromItem
+func (msg *MsgDetachFromItem) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	return ValidateNFTID(msg.AttachmentID)
+}
+
+// MsgAddBadgeIssuer registers an account that may award badges. It must be
+// signed by the module authority, i.e. executed through governance.
+type MsgAddBadgeIssuer struct {
+	Authority string `json:"authority"`
+	Issuer    string `json:"issuer"`
+	Name      string `json:"name"`
+}
+
+// NewMsgAddBadgeIssuer creates a new MsgAddBadgeIssuer
+func NewMsgAddBadgeIssuer(authority, issuer, name string) *MsgAddBadgeIssuer {
+	return &MsgAddBadgeIssuer{
+		Authority: authority,
+		Issuer:    issuer,
+		Name:      name,
+	}
+}
+
+// Route returns the route of MsgAddBadgeIssuer
+func (msg *MsgAddBadgeIssuer) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of MsgAddBadgeIssuer
+func (msg *MsgAddBadgeIssuer) Type() string {
+	return TypeMsgAddBadgeIssuer
+}
+
+// GetSigners returns the signers of MsgAddBadgeIssuer
+func (msg *MsgAddBadgeIssuer) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// GetSignBytes returns the sign bytes of MsgAddBadgeIssuer
+func (msg *MsgAddBadgeIssuer) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validates the basic fields of MsgAddBadgeIssuer
+func (msg *MsgAddBadgeIssuer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	return BadgeIssuer{Address: msg.Issuer, Name: msg.Name}.Validate()
+}
+
+// MsgRemoveBadgeIssuer removes an account from the badge issuer registry. It
+// must be signed by the module authority.
+type MsgRemoveBadgeIssuer struct {
+	Authority string `json:"authority"`
+	Issuer    string `json:"issuer"`
+}
+
+// NewMsgRemoveBadgeIssuer creates a new MsgRemoveBadgeIssuer
+func NewMsgRemoveBadgeIssuer(authority, issuer string) *MsgRemoveBadgeIssuer {
+	return &MsgRemoveBadgeIssuer{
+		Authority: authority,
+		Issuer:    issuer,
+	}
+}
+
+// Route returns the route of MsgRemoveBadgeIssuer
+func (msg *MsgRemoveBadgeIssuer) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of MsgRemoveBadgeIssuer
+func (msg *MsgRemoveBadgeIssuer) Type() string {
+	return TypeMsgRemoveBadgeIssuer
+}
+
+// GetSigners returns the signers of MsgRemoveBadgeIssuer
+func (msg *MsgRemoveBadgeIssuer) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// GetSignBytes returns the sign bytes of MsgRemoveBadgeIssuer
+func (msg *MsgRemoveBadgeIssuer) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validates the basic fields of MsgRemoveBadgeIssuer
+func (msg *MsgRemoveBadgeIssuer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Issuer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid issuer address (%s)", err)
+	}
+
+	return nil
+}
+
+// MsgRevokeBadge revokes a non-permanent badge. It must be signed by the
+// badge's issuer or the module authority.
+type MsgRevokeBadge struct {
+	Sender string `json:"sender"`
+	ID     string `json:"id"`
+}
+
+// NewMsgRevokeBadge creates a new MsgRevokeBadge
+func NewMsgRevokeBadge(sender, id string) *MsgRevokeBadge {
+	return &MsgRevokeBadge{
+		Sender: sender,
+		ID:     id,
+	}
+}
+
+// Route returns the route of MsgRevokeBadge
+func (msg *MsgRevokeBadge) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of MsgRevokeBadge
+func (msg *MsgRevokeBadge) Type() string {
+	return TypeMsgRevokeBadge
+}
+
+// GetSigners returns the signers of MsgRevokeBadge
+func (msg *MsgRevokeBadge) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// GetSignBytes returns the sign bytes of MsgRevokeBadge
+func (msg *MsgRevokeBadge) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validates the basic fields of MsgRevokeBadge
+func (msg *MsgRevokeBadge) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	return ValidateNFTID(msg.ID)
+}
+
+// MsgCreateClass creates a new NFT class owned by the sender
+type MsgCreateClass struct {
+	Creator       string      `json:"creator"`
+	ID            string      `json:"id"`
+	Name          string      `json:"name"`
+	Description   string      `json:"description"`
+	MintAuthority string      `json:"mint_authority"`
+	MaxSupply     uint64      `json:"max_supply"`
+	Transferable  bool        `json:"transferable"`
+	Royalty       RoyaltyInfo `json:"royalty"`
+	// Mutability controls who may update each metadata field after minting
+	Mutability MetadataMutability `json:"mutability"`
+}
+
+// NewMsgCreateClass creates a new MsgCreateClass
+func NewMsgCreateClass(creator, id, name, description, mintAuthority string, maxSupply uint64, transferable bool, royalty RoyaltyInfo, mutability MetadataMutability) *MsgCreateClass {
+	return &MsgCreateClass{
+		Creator:       creator,
+		ID:            id,
+		Name:          name,
+		Description:   description,
+		MintAuthority: mintAuthority,
+		MaxSupply:     maxSupply,
+		Transferable:  transferable,
+		Royalty:       royalty,
+		Mutability:    mutability,
+	}
+}
+
+// Route returns the route of MsgCreateClass
+func (msg *MsgCreateClass) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of MsgCreateClass
+func (msg *MsgCreateClass) Type() string {
+	return TypeMsgCreateClass
+}
+
+// GetSigners returns the signers of MsgCreateClass
+func (msg *MsgCreateClass) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// GetSignBytes returns the sign bytes of MsgCreateClass
+func (msg *MsgCreateClass) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validates the basic fields of MsgCreateClass
+func (msg *MsgCreateClass) ValidateBasic() error {
+	return msg.Class().Validate()
+}
+
+// Class returns the class described by the message
+func (msg *MsgCreateClass) Class() Class {
+	return Class{
+		ID:            msg.ID,
+		Name:          msg.Name,
+		Description:   msg.Description,
+		Creator:       msg.Creator,
+		MintAuthority: msg.MintAuthority,
+		MaxSupply:     msg.MaxSupply,
+		Transferable:  msg.Transferable,
+		Royalty:       msg.Royalty,
+		Mutability:    msg.Mutability,
+	}
+}
+
+// MsgBurnNFT destroys an NFT owned by the sender
+type MsgBurnNFT struct {
+	Sender string `json:"sender"`
+	ID     string `json:"id"`
+}
+
+// NewMsgBurnNFT creates a new MsgBurnNFT
+func NewMsgBurnNFT(sender, id string) *MsgBurnNFT {
+	return &MsgBurnNFT{
+		Sender: sender,
+		ID:     id,
+	}
+}
+
+// Route returns the route of MsgBurnNFT
+func (msg *MsgBurnNFT) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of MsgBurnNFT
+func (msg *MsgBurnNFT) Type() string {
+	return TypeMsgBurnNFT
+}
+
+// GetSigners returns the signers of MsgBurnNFT
+func (msg *MsgBurnNFT) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// GetSignBytes returns the sign bytes of MsgBurnNFT
+func (msg *MsgBurnNFT) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validates the basic fields of MsgBurnNFT
+func (msg *MsgBurnNFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	return ValidateNFTID(msg.ID)
+}
+
+// MsgUpdateNFTMetadata replaces the metadata of an NFT. Every field that
+// differs from the stored metadata must be mutable by the sender under the
+// NFT's class mutability, as its owner or as the class mint authority.
+type MsgUpdateNFTMetadata struct {
+	Sender   string   `json:"sender"`
+	ID       string   `json:"id"`
+	Metadata Metadata `json:"metadata"`
+}
+
+// NewMsgUpdateNFTMetadata creates a new MsgUpdateNFTMetadata
+func NewMsgUpdateNFTMetadata(sender, id string, metadata Metadata) *MsgUpdateNFTMetadata {
+	return &MsgUpdateNFTMetadata{
+		Sender:   sender,
+		ID:       id,
+		Metadata: metadata,
+	}
+}
+
+// Route returns the route of MsgUpdateNFTMetadata
+func (msg *MsgUpdateNFTMetadata) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of MsgUpdateNFTMetadata
+func (msg *MsgUpdateNFTMetadata) Type() string {
+	return TypeMsgUpdateNFTMetadata
+}
+
+// GetSigners returns the signers of MsgUpdateNFTMetadata
+func (msg *MsgUpdateNFTMetadata) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// GetSignBytes returns the sign bytes of MsgUpdateNFTMetadata
+func (msg *MsgUpdateNFTMetadata) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validates the basic fields of MsgUpdateNFTMetadata
+func (msg *MsgUpdateNFTMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	if err := ValidateNFTID(msg.ID); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(msg.Metadata.Name) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "metadata name cannot be empty")
+	}
+
+	return nil
+}
+
+// MsgSetUser rents an NFT out: the owner grants User the use of the NFT
+// until Expires while keeping ownership
+type MsgSetUser struct {
+	Sender  string    `json:"sender"`
+	ID      string    `json:"id"`
+	User    string    `json:"user"`
+	Expires time.Time `json:"expires"`
+}
+
+// NewMsgSetUser creates a new MsgSetUser
+func NewMsgSetUser(sender, id, user string, expires time.Time) *MsgSetUser {
+	return &MsgSetUser{
+		Sender:  sender,
+		ID:      id,
+		User:    user,
+		Expires: expires,
+	}
+}
+
+// Route returns the route of MsgSetUser
+func (msg *MsgSetUser) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of MsgSetUser
+func (msg *MsgSetUser) Type() string {
+	return TypeMsgSetUser
+}
+
+// GetSigners returns the signers of MsgSetUser
+func (msg *MsgSetUser) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// GetSignBytes returns the sign bytes of MsgSetUser
+func (msg *MsgSetUser) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validates the basic fields of MsgSetUser
+func (msg *MsgSetUser) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	if msg.Sender == msg.User {
+		return sdkerrors.Wrap(ErrInvalidRental, "cannot rent an NFT to its owner")
+	}
+
+	return Rental{NFTID: msg.ID, User: msg.User, Expires: msg.Expires}.Validate()
+}
+
+// ValidateNFTID checks that an NFT identifier is usable as a store key
+func ValidateNFTID(id string) error {
+	if strings.TrimSpace(id) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "NFT id cannot be empty")
+	}
+
+	if len(id) > MaxNFTIDLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "NFT id longer than %d characters", MaxNFTIDLength)
+	}
+
+	if strings.Contains(id, "/") {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "NFT id cannot contain '/'")
+	}
+
+	return nil
+}
+
+// IsValidNFTType reports whether the given type is a known NFT type
+func IsValidNFTType(nftType string) bool {
+	switch nftType {
+	case TypeLand, TypeItem, TypeBadge, TypeAttachment:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,211 @@
+package types
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgBatchMintNFT     = "batch_mint_nft"
+	TypeMsgBatchTransferNFT = "batch_transfer_nft"
+
+	// MaxBatchSize bounds the number of NFTs minted or transferred by one batch message
+	MaxBatchSize = 1000
+
+	// BatchMintGasPerItem and BatchTransferGasPerItem are charged for every
+	// NFT in a batch on top of the store gas, so a batch costs at least as
+	// much as the equivalent single messages
+	BatchMintGasPerItem     = 10000
+	BatchTransferGasPerItem = 5000
+)
+
+var (
+	_ sdk.Msg = &MsgBatchMintNFT{}
+	_ sdk.Msg = &MsgBatchTransferNFT{}
+)
+
+// MintEntry describes one NFT of a MsgBatchMintNFT. Its fields mean the same
+// as in MsgMintNFT.
+type MintEntry struct {
+	ID        string         `json:"id"`
+	NFTType   string         `json:"nft_type"`
+	Recipient string         `json:"recipient"`
+	Metadata  Metadata       `json:"metadata"`
+	Land      *LandMetadata  `json:"land,omitempty"`
+	Badge     *BadgeMetadata `json:"badge,omitempty"`
+}
+
+// MsgBatchMintNFT mints many NFTs of one class in a single transaction. The
+// batch is atomic: if any entry fails, nothing is minted.
+type MsgBatchMintNFT struct {
+	Sender  string      `json:"sender"`
+	ClassID string      `json:"class_id"`
+	Entries []MintEntry `json:"entries"`
+}
+
+// NewMsgBatchMintNFT creates a new MsgBatchMintNFT
+func NewMsgBatchMintNFT(sender, classID string, entries []MintEntry) *MsgBatchMintNFT {
+	return &MsgBatchMintNFT{
+		Sender:  sender,
+		ClassID: classID,
+		Entries: entries,
+	}
+}
+
+// MintMsgs returns the batch as the equivalent MsgMintNFT messages
+func (msg *MsgBatchMintNFT) MintMsgs() []*MsgMintNFT {
+	msgs := make([]*MsgMintNFT, len(msg.Entries))
+	for i, entry := range msg.Entries {
+		msgs[i] = &MsgMintNFT{
+			Sender:    msg.Sender,
+			ClassID:   msg.ClassID,
+			ID:        entry.ID,
+			NFTType:   entry.NFTType,
+			Recipient: entry.Recipient,
+			Metadata:  entry.Metadata,
+			Land:      entry.Land,
+			Badge:     entry.Badge,
+		}
+	}
+	return msgs
+}
+
+// Route returns the route of MsgBatchMintNFT
+func (msg *MsgBatchMintNFT) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of MsgBatchMintNFT
+func (msg *MsgBatchMintNFT) Type() string {
+	return TypeMsgBatchMintNFT
+}
+
+// GetSigners returns the signers of MsgBatchMintNFT
+func (msg *MsgBatchMintNFT) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// GetSignBytes returns the sign bytes of MsgBatchMintNFT
+func (msg *MsgBatchMintNFT) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validates every entry of MsgBatchMintNFT as a MsgMintNFT and
+// rejects duplicate IDs
+func (msg *MsgBatchMintNFT) ValidateBasic() error {
+	if err := validateBatchSize(len(msg.Entries)); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(msg.Entries))
+	for i, mint := range msg.MintMsgs() {
+		if err := mint.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "entry %d", i)
+		}
+		if seen[mint.ID] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate NFT id %s in batch", mint.ID)
+		}
+		seen[mint.ID] = true
+	}
+
+	return nil
+}
+
+// TransferEntry describes one NFT of a MsgBatchTransferNFT
+type TransferEntry struct {
+	ID        string `json:"id"`
+	Recipient string `json:"recipient"`
+}
+
+// MsgBatchTransferNFT transfers many NFTs owned by the sender in a single
+// transaction. The batch is atomic: if any entry fails, nothing moves.
+type MsgBatchTransferNFT struct {
+	Sender  string          `json:"sender"`
+	Entries []TransferEntry `json:"entries"`
+}
+
+// NewMsgBatchTransferNFT creates a new MsgBatchTransferNFT
+func NewMsgBatchTransferNFT(sender string, entries []TransferEntry) *MsgBatchTransferNFT {
+	return &MsgBatchTransferNFT{
+		Sender:  sender,
+		Entries: entries,
+	}
+}
+
+// TransferMsgs returns the batch as the equivalent MsgTransferNFT messages
+func (msg *MsgBatchTransferNFT) TransferMsgs() []*MsgTransferNFT {
+	msgs := make([]*MsgTransferNFT, len(msg.Entries))
+	for i, entry := range msg.Entries {
+		msgs[i] = NewMsgTransferNFT(msg.Sender, entry.ID, entry.Recipient)
+	}
+	return msgs
+}
+
+// Route returns the route of MsgBatchTransferNFT
+func (msg *MsgBatchTransferNFT) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of MsgBatchTransferNFT
+func (msg *MsgBatchTransferNFT) Type() string {
+	return TypeMsgBatchTransferNFT
+}
+
+// GetSigners returns the signers of MsgBatchTransferNFT
+func (msg *MsgBatchTransferNFT) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// GetSignBytes returns the sign bytes of MsgBatchTransferNFT
+func (msg *MsgBatchTransferNFT) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validates every entry of MsgBatchTransferNFT as a
+// MsgTransferNFT and rejects NFTs listed twice
+func (msg *MsgBatchTransferNFT) ValidateBasic() error {
+	if err := validateBatchSize(len(msg.Entries)); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(msg.Entries))
+	for i, transfer := range msg.TransferMsgs() {
+		if err := transfer.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "entry %d", i)
+		}
+		if seen[transfer.ID] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate NFT id %s in batch", transfer.ID)
+		}
+		seen[transfer.ID] = true
+	}
+
+	return nil
+}
+
+func validateBatchSize(n int) error {
+	if n == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "batch cannot be empty")
+	}
+	if n > MaxBatchSize {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "batch of %d exceeds the maximum of %d", n, MaxBatchSize)
+	}
+	return nil
+}
@@ -1,10 +1,608 @@
 package types
 
-type MsgServer interface{}
+import (
+	"context"
+	"fmt"
 
-type MsgMintNFT struct{}
-type MsgMintNFTResponse struct{}
-type MsgTransferNFT struct{}
+	"google.golang.org/grpc"
+)
+
+// MsgServer defines the NFT module's message service
+type MsgServer interface {
+	MintNFT(context.Context, *MsgMintNFT) (*MsgMintNFTResponse, error)
+	TransferNFT(context.Context, *MsgTransferNFT) (*MsgTransferNFTResponse, error)
+	AttachToItem(context.Context, *MsgAttachToItem) (*MsgAttachToItemResponse, error)
+	DetachFromItem(context.Context, *MsgDetachFromItem) (*MsgDetachFromItemResponse, error)
+	AddBadgeIssuer(context.Context, *MsgAddBadgeIssuer) (*MsgAddBadgeIssuerResponse, error)
+	RemoveBadgeIssuer(context.Context, *MsgRemoveBadgeIssuer) (*MsgRemoveBadgeIssuerResponse, error)
+	RevokeBadge(context.Context, *MsgRevokeBadge) (*MsgRevokeBadgeResponse, error)
+	CreateClass(context.Context, *MsgCreateClass) (*MsgCreateClassResponse, error)
+	BurnNFT(context.Context, *MsgBurnNFT) (*MsgBurnNFTResponse, error)
+	UpdateNFTMetadata(context.Context, *MsgUpdateNFTMetadata) (*MsgUpdateNFTMetadataResponse, error)
+	BatchMintNFT(context.Context, *MsgBatchMintNFT) (*MsgBatchMintNFTResponse, error)
+	BatchTransferNFT(context.Context, *MsgBatchTransferNFT) (*MsgBatchTransferNFTResponse, error)
+	SetUser(context.Context, *MsgSetUser) (*MsgSetUserResponse, error)
+}
+
+// MsgMintNFTResponse is the response for MsgMintNFT
+type MsgMintNFTResponse struct {
+	ID string `json:"id"`
+}
+
+// MsgTransferNFTResponse is the response for MsgTransferNFT
 type MsgTransferNFTResponse struct{}
-type MsgAttachToItem struct{}
+
+// MsgAttachToItemResponse is the response for MsgAttachToItem
 type MsgAttachToItemResponse struct{}
+
+// MsgDetachFromItemResponse is the response for MsgDetachFromItem
+type MsgDetachFromItemResponse struct{}
+
+// MsgAddBadgeIssuerResponse is the response for MsgAddBadgeIssuer
+type MsgAddBadgeIssuerResponse struct{}
+
+// MsgRemoveBadgeIssuerResponse is the response for MsgRemoveBadgeIssuer
+type MsgRemoveBadgeIssuerResponse struct{}
+
+// MsgRevokeBadgeResponse is the response for MsgRevokeBadge
+type MsgRevokeBadgeResponse struct{}
+
+// MsgCreateClassResponse is the response for MsgCreateClass
+type MsgCreateClassResponse struct{}
+
+// MsgBurnNFTResponse is the response for MsgBurnNFT
+type MsgBurnNFTResponse struct{}
+
+// MsgUpdateNFTMetadataResponse is the response for MsgUpdateNFTMetadata
+type MsgUpdateNFTMetadataResponse struct{}
+
+// MsgBatchMintNFTResponse is the response for MsgBatchMintNFT
+type MsgBatchMintNFTResponse struct {
+	IDs []string `json:"ids"`
+}
+
+// MsgBatchTransferNFTResponse is the response for MsgBatchTransferNFT
+type MsgBatchTransferNFTResponse struct{}
+
+// MsgSetUserResponse is the response for MsgSetUser
+type MsgSetUserResponse struct{}
+
+// ProtoMessage implements the proto.Message interface for MsgMintNFT.
+func (msg *MsgMintNFT) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgMintNFT.
+func (msg *MsgMintNFT) Reset() { *msg = MsgMintNFT{} }
+
+// String implements the proto.Message interface for MsgMintNFT.
+func (msg *MsgMintNFT) String() string {
+	return fmt.Sprintf("MsgMintNFT{Sender: %s, ClassID: %s, ID: %s, NFTType: %s, Recipient: %s}",
+		msg.Sender, msg.ClassID, msg.ID, msg.NFTType, msg.Recipient)
+}
+
+// XXX_MessageName returns the fully qualified message name used as type URL.
+func (msg *MsgMintNFT) XXX_MessageName() string { return "skaffacity.nft.v1.MsgMintNFT" }
+
+// ProtoMessage implements the proto.Message interface for MsgMintNFTResponse.
+func (m *MsgMintNFTResponse) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgMintNFTResponse.
+func (m *MsgMintNFTResponse) Reset() { *m = MsgMintNFTResponse{} }
+
+// String implements the proto.Message interface for MsgMintNFTResponse.
+func (m *MsgMintNFTResponse) String() string {
+	return fmt.Sprintf("MsgMintNFTResponse{ID: %s}", m.ID)
+}
+
+// ProtoMessage implements the proto.Message interface for MsgTransferNFT.
+func (msg *MsgTransferNFT) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgTransferNFT.
+func (msg *MsgTransferNFT) Reset() { *msg = MsgTransferNFT{} }
+
+// String implements the proto.Message interface for MsgTransferNFT.
+func (msg *MsgTransferNFT) String() string {
+	return fmt.Sprintf("MsgTransferNFT{Sender: %s, ID: %s, Recipient: %s}",
+		msg.Sender, msg.ID, msg.Recipient)
+}
+
+// XXX_MessageName returns the fully qualified message name used as type URL.
+func (msg *MsgTransferNFT) XXX_MessageName() string { return "skaffacity.nft.v1.MsgTransferNFT" }
+
+// ProtoMessage implements the proto.Message interface for MsgTransferNFTResponse.
+func (m *MsgTransferNFTResponse) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgTransferNFTResponse.
+func (m *MsgTransferNFTResponse) Reset() { *m = MsgTransferNFTResponse{} }
+
+// String implements the proto.Message interface for MsgTransferNFTResponse.
+func (m *MsgTransferNFTResponse) String() string { return "MsgTransferNFTResponse{}" }
+
+// ProtoMessage implements the proto.Message interface for MsgAttachToItem.
+func (msg *MsgAttachToItem) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgAttachToItem.
+func (msg *MsgAttachToItem) Reset() { *msg = MsgAttachToItem{} }
+
+// String implements the proto.Message interface for MsgAttachToItem.
+func (msg *MsgAttachToItem) String() string {
+	return fmt.Sprintf("MsgAttachToItem{Sender: %s, ItemID: %s, AttachmentID: %s}",
+		msg.Sender, msg.ItemID, msg.AttachmentID)
+}
+
+// XXX_MessageName returns the fully qualified message name used as type URL.
+func (msg *MsgAttachToItem) XXX_MessageName() string { return "skaffacity.nft.v1.MsgAttachToItem" }
+
+// ProtoMessage implements the proto.Message interface for MsgAttachToItemResponse.
+func (m *MsgAttachToItemResponse) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgAttachToItemResponse.
+func (m *MsgAttachToItemResponse) Reset() { *m = MsgAttachToItemResponse{} }
+
+// String implements the proto.Message interface for MsgAttachToItemResponse.
+func (m *MsgAttachToItemResponse) String() string { return "MsgAttachToItemResponse{}" }
+
+// ProtoMessage implements the proto.Message interface for MsgDetachFromItem.
+func (msg *MsgDetachFromItem) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgDetachFromItem.
+func (msg *MsgDetachFromItem) Reset() { *msg = MsgDetachFromItem{} }
+
+// String implements the proto.Message interface for MsgDetachFromItem.
+func (msg *MsgDetachFromItem) String() string {
+	return fmt.Sprintf("MsgDetachFromItem{Sender: %s, AttachmentID: %s}", msg.Sender, msg.AttachmentID)
+}
+
+// XXX_MessageName returns the fully qualified message name used as type URL.
+func (msg *MsgDetachFromItem) XXX_MessageName() string { return "skaffacity.nft.v1.MsgDetachFromItem" }
+
+// ProtoMessage implements the proto.Message interface for MsgDetachFromItemResponse.
+func (m *MsgDetachFromItemResponse) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgDetachFromItemResponse.
+func (m *MsgDetachFromItemResponse) Reset() { *m = MsgDetachFromItemResponse{} }
+
+// String implements the proto.Message interface for MsgDetachFromItemResponse.
+func (m *MsgDetachFromItemResponse) String() string { return "MsgDetachFromItemResponse{}" }
+
+// ProtoMessage implements the proto.Message interface for MsgAddBadgeIssuer.
+func (msg *MsgAddBadgeIssuer) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgAddBadgeIssuer.
+func (msg *MsgAddBadgeIssuer) Reset() { *msg = MsgAddBadgeIssuer{} }
+
+// String implements the proto.Message interface for MsgAddBadgeIssuer.
+func (msg *MsgAddBadgeIssuer) String() string {
+	return fmt.Sprintf("MsgAddBadgeIssuer{Authority: %s, Issuer: %s, Name: %s}", msg.Authority, msg.Issuer, msg.Name)
+}
+
+// XXX_MessageName returns the fully qualified message name used as type URL.
+func (msg *MsgAddBadgeIssuer) XXX_MessageName() string { return "skaffacity.nft.v1.MsgAddBadgeIssuer" }
+
+// ProtoMessage implements the proto.Message interface for MsgAddBadgeIssuerResponse.
+func (m *MsgAddBadgeIssuerResponse) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgAddBadgeIssuerResponse.
+func (m *MsgAddBadgeIssuerResponse) Reset() { *m = MsgAddBadgeIssuerResponse{} }
+
+// String implements the proto.Message interface for MsgAddBadgeIssuerResponse.
+func (m *MsgAddBadgeIssuerResponse) String() string { return "MsgAddBadgeIssuerResponse{}" }
+
+// ProtoMessage implements the proto.Message interface for MsgRemoveBadgeIssuer.
+func (msg *MsgRemoveBadgeIssuer) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgRemoveBadgeIssuer.
+func (msg *MsgRemoveBadgeIssuer) Reset() { *msg = MsgRemoveBadgeIssuer{} }
+
+// String implements the proto.Message interface for MsgRemoveBadgeIssuer.
+func (msg *MsgRemoveBadgeIssuer) String() string {
+	return fmt.Sprintf("MsgRemoveBadgeIssuer{Authority: %s, Issuer: %s}", msg.Authority, msg.Issuer)
+}
+
+// XXX_MessageName returns the fully qualified message name used as type URL.
+func (msg *MsgRemoveBadgeIssuer) XXX_MessageName() string {
+	return "skaffacity.nft.v1.MsgRemoveBadgeIssuer"
+}
+
+// ProtoMessage implements the proto.Message interface for MsgRemoveBadgeIssuerResponse.
+func (m *MsgRemoveBadgeIssuerResponse) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgRemoveBadgeIssuerResponse.
+func (m *MsgRemoveBadgeIssuerResponse) Reset() { *m = MsgRemoveBadgeIssuerResponse{} }
+
+// String implements the proto.Message interface for MsgRemoveBadgeIssuerResponse.
+func (m *MsgRemoveBadgeIssuerResponse) String() string { return "MsgRemoveBadgeIssuerResponse{}" }
+
+// ProtoMessage implements the proto.Message interface for MsgRevokeBadge.
+func (msg *MsgRevokeBadge) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgRevokeBadge.
+func (msg *MsgRevokeBadge) Reset() { *msg = MsgRevokeBadge{} }
+
+// String implements the proto.Message interface for MsgRevokeBadge.
+func (msg *MsgRevokeBadge) String() string {
+	return fmt.Sprintf("MsgRevokeBadge{Sender: %s, ID: %s}", msg.Sender, msg.ID)
+}
+
+// XXX_MessageName returns the fully qualified message name used as type URL.
+func (msg *MsgRevokeBadge) XXX_MessageName() string { return "skaffacity.nft.v1.MsgRevokeBadge" }
+
+// ProtoMessage implements the proto.Message interface for MsgRevokeBadgeResponse.
+func (m *MsgRevokeBadgeResponse) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgRevokeBadgeResponse.
+func (m *MsgRevokeBadgeResponse) Reset() { *m = MsgRevokeBadgeResponse{} }
+
+// String implements the proto.Message interface for MsgRevokeBadgeResponse.
+func (m *MsgRevokeBadgeResponse) String() string { return "MsgRevokeBadgeResponse{}" }
+
+// ProtoMessage implements the proto.Message interface for MsgCreateClass.
+func (msg *MsgCreateClass) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgCreateClass.
+func (msg *MsgCreateClass) Reset() { *msg = MsgCreateClass{} }
+
+// String implements the proto.Message interface for MsgCreateClass.
+func (msg *MsgCreateClass) String() string {
+	return fmt.Sprintf("MsgCreateClass{Creator: %s, ID: %s, MintAuthority: %s, MaxSupply: %d}",
+		msg.Creator, msg.ID, msg.MintAuthority, msg.MaxSupply)
+}
+
+// XXX_MessageName returns the fully qualified message name used as type URL.
+func (msg *MsgCreateClass) XXX_MessageName() string { return "skaffacity.nft.v1.MsgCreateClass" }
+
+// ProtoMessage implements the proto.Message interface for MsgCreateClassResponse.
+func (m *MsgCreateClassResponse) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgCreateClassResponse.
+func (m *MsgCreateClassResponse) Reset() { *m = MsgCreateClassResponse{} }
+
+// String implements the proto.Message interface for MsgCreateClassResponse.
+func (m *MsgCreateClassResponse) String() string { return "MsgCreateClassResponse{}" }
+
+// ProtoMessage implements the proto.Message interface for MsgBurnNFT.
+func (msg *MsgBurnNFT) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgBurnNFT.
+func (msg *MsgBurnNFT) Reset() { *msg = MsgBurnNFT{} }
+
+// String implements the proto.Message interface for MsgBurnNFT.
+func (msg *MsgBurnNFT) String() string {
+	return fmt.Sprintf("MsgBurnNFT{Sender: %s, ID: %s}", msg.Sender, msg.ID)
+}
+
+// XXX_MessageName returns the fully qualified message name used as type URL.
+func (msg *MsgBurnNFT) XXX_MessageName() string { return "skaffacity.nft.v1.MsgBurnNFT" }
+
+// ProtoMessage implements the proto.Message interface for MsgBurnNFTResponse.
+func (m *MsgBurnNFTResponse) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgBurnNFTResponse.
+func (m *MsgBurnNFTResponse) Reset() { *m = MsgBurnNFTResponse{} }
+
+// String implements the proto.Message interface for MsgBurnNFTResponse.
+func (m *MsgBurnNFTResponse) String() string { return "MsgBurnNFTResponse{}" }
+
+// ProtoMessage implements the proto.Message interface for MsgUpdateNFTMetadata.
+func (msg *MsgUpdateNFTMetadata) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgUpdateNFTMetadata.
+func (msg *MsgUpdateNFTMetadata) Reset() { *msg = MsgUpdateNFTMetadata{} }
+
+// String implements the proto.Message interface for MsgUpdateNFTMetadata.
+func (msg *MsgUpdateNFTMetadata) String() string {
+	return fmt.Sprintf("MsgUpdateNFTMetadata{Sender: %s, ID: %s, Name: %s}", msg.Sender, msg.ID, msg.Metadata.Name)
+}
+
+// XXX_MessageName returns the fully qualified message name used as type URL.
+func (msg *MsgUpdateNFTMetadata) XXX_MessageName() string {
+	return "skaffacity.nft.v1.MsgUpdateNFTMetadata"
+}
+
+// ProtoMessage implements the proto.Message interface for MsgUpdateNFTMetadataResponse.
+func (m *MsgUpdateNFTMetadataResponse) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgUpdateNFTMetadataResponse.
+func (m *MsgUpdateNFTMetadataResponse) Reset() { *m = MsgUpdateNFTMetadataResponse{} }
+
+// String implements the proto.Message interface for MsgUpdateNFTMetadataResponse.
+func (m *MsgUpdateNFTMetadataResponse) String() string { return "MsgUpdateNFTMetadataResponse{}" }
+
+// ProtoMessage implements the proto.Message interface for MsgBatchMintNFT.
+func (msg *MsgBatchMintNFT) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgBatchMintNFT.
+func (msg *MsgBatchMintNFT) Reset() { *msg = MsgBatchMintNFT{} }
+
+// String implements the proto.Message interface for MsgBatchMintNFT.
+func (msg *MsgBatchMintNFT) String() string {
+	return fmt.Sprintf("MsgBatchMintNFT{Sender: %s, ClassID: %s, Entries: %d}", msg.Sender, msg.ClassID, len(msg.Entries))
+}
+
+// XXX_MessageName returns the fully qualified message name used as type URL.
+func (msg *MsgBatchMintNFT) XXX_MessageName() string { return "skaffacity.nft.v1.MsgBatchMintNFT" }
+
+// ProtoMessage implements the proto.Message interface for MsgBatchMintNFTResponse.
+func (m *MsgBatchMintNFTResponse) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgBatchMintNFTResponse.
+func (m *MsgBatchMintNFTResponse) Reset() { *m = MsgBatchMintNFTResponse{} }
+
+// String implements the proto.Message interface for MsgBatchMintNFTResponse.
+func (m *MsgBatchMintNFTResponse) String() string {
+	return fmt.Sprintf("MsgBatchMintNFTResponse{IDs: %v}", m.IDs)
+}
+
+// ProtoMessage implements the proto.Message interface for MsgBatchTransferNFT.
+func (msg *MsgBatchTransferNFT) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgBatchTransferNFT.
+func (msg *MsgBatchTransferNFT) Reset() { *msg = MsgBatchTransferNFT{} }
+
+// String implements the proto.Message interface for MsgBatchTransferNFT.
+func (msg *MsgBatchTransferNFT) String() string {
+	return fmt.Sprintf("MsgBatchTransferNFT{Sender: %s, Entries: %d}", msg.Sender, len(msg.Entries))
+}
+
+// XXX_MessageName returns the fully qualified message name used as type URL.
+func (msg *MsgBatchTransferNFT) XXX_MessageName() string {
+	return "skaffacity.nft.v1.MsgBatchTransferNFT"
+}
+
+// ProtoMessage implements the proto.Message interface for MsgBatchTransferNFTResponse.
+func (m *MsgBatchTransferNFTResponse) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgBatchTransferNFTResponse.
+func (m *MsgBatchTransferNFTResponse) Reset() { *m = MsgBatchTransferNFTResponse{} }
+
+// String implements the proto.Message interface for MsgBatchTransferNFTResponse.
+func (m *MsgBatchTransferNFTResponse) String() string { return "MsgBatchTransferNFTResponse{}" }
+
+// ProtoMessage implements the proto.Message interface for MsgSetUser.
+func (msg *MsgSetUser) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgSetUser.
+func (msg *MsgSetUser) Reset() { *msg = MsgSetUser{} }
+
+// String implements the proto.Message interface for MsgSetUser.
+func (msg *MsgSetUser) String() string {
+	return fmt.Sprintf("MsgSetUser{Sender: %s, ID: %s, User: %s, Expires: %s}", msg.Sender, msg.ID, msg.User, msg.Expires)
+}
+
+// XXX_MessageName returns the fully qualified message name used as type URL.
+func (msg *MsgSetUser) XXX_MessageName() string { return "skaffacity.nft.v1.MsgSetUser" }
+
+// ProtoMessage implements the proto.Message interface for MsgSetUserResponse.
+func (m *MsgSetUserResponse) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgSetUserResponse.
+func (m *MsgSetUserResponse) Reset() { *m = MsgSetUserResponse{} }
+
+// String implements the proto.Message interface for MsgSetUserResponse.
+func (m *MsgSetUserResponse) String() string { return "MsgSetUserResponse{}" }
+
+const msgServiceName = "skaffacity.nft.v1.Msg"
+
+// RegisterMsgServer registers the message service implementation with the
+// server. The app's MsgServiceRouter delivers the module's transactions
+// through it.
+func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_MintNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMintNFT)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MintNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + msgServiceName + "/MintNFT"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MintNFT(ctx, req.(*MsgMintNFT))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferNFT)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + msgServiceName + "/TransferNFT"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferNFT(ctx, req.(*MsgTransferNFT))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AttachToItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAttachToItem)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AttachToItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + msgServiceName + "/AttachToItem"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AttachToItem(ctx, req.(*MsgAttachToItem))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DetachFromItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDetachFromItem)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DetachFromItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + msgServiceName + "/DetachFromItem"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DetachFromItem(ctx, req.(*MsgDetachFromItem))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddBadgeIssuer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddBadgeIssuer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddBadgeIssuer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + msgServiceName + "/AddBadgeIssuer"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddBadgeIssuer(ctx, req.(*MsgAddBadgeIssuer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveBadgeIssuer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveBadgeIssuer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveBadgeIssuer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + msgServiceName + "/RemoveBadgeIssuer"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveBadgeIssuer(ctx, req.(*MsgRemoveBadgeIssuer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeBadge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeBadge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeBadge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + msgServiceName + "/RevokeBadge"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeBadge(ctx, req.(*MsgRevokeBadge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateClass)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + msgServiceName + "/CreateClass"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateClass(ctx, req.(*MsgCreateClass))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BurnNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBurnNFT)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BurnNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + msgServiceName + "/BurnNFT"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BurnNFT(ctx, req.(*MsgBurnNFT))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateNFTMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateNFTMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateNFTMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + msgServiceName + "/UpdateNFTMetadata"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateNFTMetadata(ctx, req.(*MsgUpdateNFTMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BatchMintNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchMintNFT)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchMintNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + msgServiceName + "/BatchMintNFT"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchMintNFT(ctx, req.(*MsgBatchMintNFT))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BatchTransferNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchTransferNFT)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchTransferNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + msgServiceName + "/BatchTransferNFT"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchTransferNFT(ctx, req.(*MsgBatchTransferNFT))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetUser)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + msgServiceName + "/SetUser"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetUser(ctx, req.(*MsgSetUser))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// _Msg_serviceDesc is written by hand like the module's other types; there is
+// no tx.proto to generate it from.
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: msgServiceName,
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "MintNFT", Handler: _Msg_MintNFT_Handler},
+		{MethodName: "TransferNFT", Handler: _Msg_TransferNFT_Handler},
+		{MethodName: "AttachToItem", Handler: _Msg_AttachToItem_Handler},
+		{MethodName: "DetachFromItem", Handler: _Msg_DetachFromItem_Handler},
+		{MethodName: "AddBadgeIssuer", Handler: _Msg_AddBadgeIssuer_Handler},
+		{MethodName: "RemoveBadgeIssuer", Handler: _Msg_RemoveBadgeIssuer_Handler},
+		{MethodName: "RevokeBadge", Handler: _Msg_RevokeBadge_Handler},
+		{MethodName: "CreateClass", Handler: _Msg_CreateClass_Handler},
+		{MethodName: "BurnNFT", Handler: _Msg_BurnNFT_Handler},
+		{MethodName: "UpdateNFTMetadata", Handler: _Msg_UpdateNFTMetadata_Handler},
+		{MethodName: "BatchMintNFT", Handler: _Msg_BatchMintNFT_Handler},
+		{MethodName: "BatchTransferNFT", Handler: _Msg_BatchTransferNFT_Handler},
+		{MethodName: "SetUser", Handler: _Msg_SetUser_Handler},
+	},
+	Streams: []grpc.StreamDesc{},
+}
@@ -1,25 +1,32 @@
 package types
 
+import (
+    "encoding/json"
+    "fmt"
+)
+
 // ProtoMessage implements the proto.Message interface for NFT.
 func (n *NFT) ProtoMessage() {}
 
 // Reset implements the proto.Message interface for NFT.
-func (n *NFT) Reset() {}
+func (n *NFT) Reset() { *n = NFT{} }
 
 // String implements the fmt.Stringer interface for NFT.
-func (n *NFT) String() string { return "NFT stub" }
+func (n *NFT) String() string {
+    return fmt.Sprintf("NFT{ID: %s, Type: %s, Owner: %s, Name: %s}", n.ID, n.Type, n.Owner, n.Metadata.Name)
+}
 
-// MarshalTo implements codec.ProtoMarshaler for NFT (stub).
-func (n *NFT) MarshalTo(data []byte) (int, error) { return 0, nil }
+// MarshalTo implements codec.ProtoMarshaler for NFT.
+func (n *NFT) MarshalTo(data []byte) (int, error) { return jsonMarshalTo(n, data) }
 
-// Marshal implements codec.ProtoMarshaler for NFT (stub).
-func (n *NFT) Marshal() ([]byte, error) { return nil, nil }
+// Marshal implements codec.ProtoMarshaler for NFT.
+func (n *NFT) Marshal() ([]byte, error) { return json.Marshal(n) }
 
-// Unmarshal implements codec.ProtoMarshaler for NFT (stub).
-func (n *NFT) Unmarshal([]byte) error { return nil }
+// Unmarshal implements codec.ProtoMarshaler for NFT.
+func (n *NFT) Unmarshal(data []byte) error { return json.Unmarshal(data, n) }
 
-// Size implements codec.ProtoMarshaler for NFT (stub).
-func (n *NFT) Size() int { return 0 }
+// Size implements codec.ProtoMarshaler for NFT.
+func (n *NFT) Size() int { return jsonSize(n) }
 
-// MarshalToSizedBuffer implements codec.ProtoMarshaler for NFT (stub).
-func (n *NFT) MarshalToSizedBuffer(data []byte) (int, error) { return 0, nil }
\ No newline at end of file
+// MarshalToSizedBuffer implements codec.ProtoMarshaler for NFT.
+func (n *NFT) MarshalToSizedBuffer(data []byte) (int, error) { return jsonMarshalToSizedBuffer(n, data) }
@@ -1,38 +1,551 @@
 package types
 
-type QueryServer interface{}
-
-type QueryBadgesRequest struct{ Id string }
-type QueryBadgesResponse struct{ Badges []Badge }
-type QueryNFTRequest struct{ Id string }
-type QueryNFTResponse struct{ Nft NFT }
-type QueryNFTsRequest struct{ Owner string }
-type QueryNFTsResponse struct{ Nfts []NFT }
-type QueryLandRequest struct{}
-type QueryLandResponse struct{ Land []Land }
-type QueryItemsRequest struct{}
-type QueryItemsResponse struct{ Items []Item }
-
-type Badge struct{}
-func (b *Badge) Marshal() ([]byte, error) { return nil, nil }
-func (b *Badge) Unmarshal([]byte) error { return nil }
-func (b *Badge) Size() int { return 0 }
-func (b *Badge) MarshalTo([]byte) (int, error) { return 0, nil }
-func (b *Badge) UnmarshalTo(interface{}) error { return nil }
-func (b *Badge) MarshalToSizedBuffer([]byte) (int, error) { return 0, nil }
-
-type Land struct{}
-func (l *Land) Marshal() ([]byte, error) { return nil, nil }
-func (l *Land) Unmarshal([]byte) error { return nil }
-func (l *Land) Size() int { return 0 }
-func (l *Land) MarshalTo([]byte) (int, error) { return 0, nil }
-func (l *Land) UnmarshalTo(interface{}) error { return nil }
-func (l *Land) MarshalToSizedBuffer([]byte) (int, error) { return 0, nil }
-
-type Item struct{}
-func (i *Item) Marshal() ([]byte, error) { return nil, nil }
-func (i *Item) Unmarshal([]byte) error { return nil }
-func (i *Item) Size() int { return 0 }
-func (i *Item) MarshalTo([]byte) (int, error) { return 0, nil }
-func (i *Item) UnmarshalTo(interface{}) error { return nil }
-func (i *Item) MarshalToSizedBuffer([]byte) (int, error) { return 0, nil }
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// QueryNFTRequest is the request type for the Query/NFT RPC method
+type QueryNFTRequest struct {
+	Id string `json:"id"`
+}
+
+// QueryNFTResponse is the response type for the Query/NFT RPC method
+type QueryNFTResponse struct {
+	Nft NFT `json:"nft"`
+}
+
+// QueryNFTsRequest is the request type for the Query/NFTs RPC method
+type QueryNFTsRequest struct {
+	Owner      string             `json:"owner"`
+	Pagination *query.PageRequest `json:"pagination,omitempty"`
+}
+
+// QueryNFTsResponse is the response type for the Query/NFTs RPC method
+type QueryNFTsResponse struct {
+	Nfts       []NFT               `json:"nfts"`
+	Pagination *query.PageResponse `json:"pagination,omitempty"`
+}
+
+// QueryLandRequest is the request type for the Query/Land RPC method
+type QueryLandRequest struct {
+	Pagination *query.PageRequest `json:"pagination,omitempty"`
+}
+
+// QueryLandResponse is the response type for the Query/Land RPC method
+type QueryLandResponse struct {
+	Land       []NFT               `json:"land"`
+	Pagination *query.PageResponse `json:"pagination,omitempty"`
+}
+
+// QueryItemsRequest is the request type for the Query/Items RPC method
+type QueryItemsRequest struct {
+	Pagination *query.PageRequest `json:"pagination,omitempty"`
+}
+
+// QueryItemsResponse is the response type for the Query/Items RPC method
+type QueryItemsResponse struct {
+	Items      []NFT               `json:"items"`
+	Pagination *query.PageResponse `json:"pagination,omitempty"`
+}
+
+// QueryBadgesRequest is the request type for the Query/Badges RPC method
+type QueryBadgesRequest struct {
+	Pagination *query.PageRequest `json:"pagination,omitempty"`
+}
+
+// QueryBadgesResponse is the response type for the Query/Badges RPC method
+type QueryBadgesResponse struct {
+	Badges     []NFT               `json:"badges"`
+	Pagination *query.PageResponse `json:"pagination,omitempty"`
+}
+
+// QueryLandAtRequest is the request type for the Query/LandAt RPC method
+type QueryLandAtRequest struct {
+	X int32 `json:"x"`
+	Y int32 `json:"y"`
+}
+
+// QueryLandAtResponse is the response type for the Query/LandAt RPC method
+type QueryLandAtResponse struct {
+	Land NFT `json:"land"`
+}
+
+// QueryLandInAreaRequest is the request type for the Query/LandInArea RPC method
+type QueryLandInAreaRequest struct {
+	MinX int32 `json:"min_x"`
+	MinY int32 `json:"min_y"`
+	MaxX int32 `json:"max_x"`
+	MaxY int32 `json:"max_y"`
+}
+
+// QueryLandInAreaResponse is the response type for the Query/LandInArea RPC method
+type QueryLandInAreaResponse struct {
+	Land []NFT `json:"land"`
+}
+
+// QueryLandNeighborsRequest is the request type for the Query/LandNeighbors RPC method
+type QueryLandNeighborsRequest struct {
+	Id string `json:"id"`
+}
+
+// QueryLandNeighborsResponse is the response type for the Query/LandNeighbors RPC method
+type QueryLandNeighborsResponse struct {
+	Neighbors []NFT `json:"neighbors"`
+}
+
+// QueryAttachmentsRequest is the request type for the Query/Attachments RPC method
+type QueryAttachmentsRequest struct {
+	Id string `json:"id"`
+}
+
+// QueryAttachmentsResponse is the response type for the Query/Attachments RPC
+// method. Attachments are listed depth first; Parent links rebuild the tree.
+type QueryAttachmentsResponse struct {
+	Attachments []NFT `json:"attachments"`
+}
+
+// QueryPlayerBadgesRequest is the request type for the Query/PlayerBadges RPC method
+type QueryPlayerBadgesRequest struct {
+	Owner      string             `json:"owner"`
+	Pagination *query.PageRequest `json:"pagination,omitempty"`
+}
+
+// QueryPlayerBadgesResponse is the response type for the Query/PlayerBadges RPC method
+type QueryPlayerBadgesResponse struct {
+	Badges     []NFT               `json:"badges"`
+	Pagination *query.PageResponse `json:"pagination,omitempty"`
+}
+
+// QueryBadgeIssuersRequest is the request type for the Query/BadgeIssuers RPC method
+type QueryBadgeIssuersRequest struct {
+	Pagination *query.PageRequest `json:"pagination,omitempty"`
+}
+
+// QueryBadgeIssuersResponse is the response type for the Query/BadgeIssuers RPC method
+type QueryBadgeIssuersResponse struct {
+	Issuers    []BadgeIssuer       `json:"issuers"`
+	Pagination *query.PageResponse `json:"pagination,omitempty"`
+}
+
+// QueryClassRequest is the request type for the Query/Class RPC method
+type QueryClassRequest struct {
+	ClassId string `json:"class_id"`
+}
+
+// QueryClassResponse is the response type for the Query/Class RPC method
+type QueryClassResponse struct {
+	Class  Class  `json:"class"`
+	Supply uint64 `json:"supply"`
+	Minted uint64 `json:"minted"`
+}
+
+// QueryClassesRequest is the request type for the Query/Classes RPC method
+type QueryClassesRequest struct {
+	Pagination *query.PageRequest `json:"pagination,omitempty"`
+}
+
+// QueryClassesResponse is the response type for the Query/Classes RPC method
+type QueryClassesResponse struct {
+	Classes    []Class             `json:"classes"`
+	Pagination *query.PageResponse `json:"pagination,omitempty"`
+}
+
+// QueryClassNFTsRequest is the request type for the Query/ClassNFTs RPC method
+type QueryClassNFTsRequest struct {
+	ClassId    string             `json:"class_id"`
+	Pagination *query.PageRequest `json:"pagination,omitempty"`
+}
+
+// QueryClassNFTsResponse is the response type for the Query/ClassNFTs RPC method
+type QueryClassNFTsResponse struct {
+	Nfts       []NFT               `json:"nfts"`
+	Pagination *query.PageResponse `json:"pagination,omitempty"`
+}
+
+// QueryEffectiveUserRequest is the request type for the Query/EffectiveUser RPC method
+type QueryEffectiveUserRequest struct {
+	Id string `json:"id"`
+}
+
+// QueryEffectiveUserResponse is the response type for the Query/EffectiveUser
+// RPC method. User is the renter while Rental is running, otherwise Owner.
+type QueryEffectiveUserResponse struct {
+	User   string  `json:"user"`
+	Owner  string  `json:"owner"`
+	Rental *Rental `json:"rental,omitempty"`
+}
+
+// QueryUserRentalsRequest is the request type for the Query/UserRentals RPC method
+type QueryUserRentalsRequest struct {
+	User       string             `json:"user"`
+	Pagination *query.PageRequest `json:"pagination,omitempty"`
+}
+
+// QueryUserRentalsResponse is the response type for the Query/UserRentals RPC method
+type QueryUserRentalsResponse struct {
+	Nfts       []NFT               `json:"nfts"`
+	Pagination *query.PageResponse `json:"pagination,omitempty"`
+}
+
+func (m *QueryNFTRequest) ProtoMessage()                    {}
+func (m *QueryNFTRequest) Reset()                           { *m = QueryNFTRequest{} }
+func (m *QueryNFTRequest) String() string                   { return "QueryNFTRequest{" + m.Id + "}" }
+func (m *QueryNFTRequest) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryNFTRequest) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryNFTRequest) Size() int                        { return jsonSize(m) }
+func (m *QueryNFTRequest) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryNFTRequest) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryNFTResponse) ProtoMessage()                    {}
+func (m *QueryNFTResponse) Reset()                           { *m = QueryNFTResponse{} }
+func (m *QueryNFTResponse) String() string                   { return "QueryNFTResponse{" + m.Nft.String() + "}" }
+func (m *QueryNFTResponse) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryNFTResponse) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryNFTResponse) Size() int                        { return jsonSize(m) }
+func (m *QueryNFTResponse) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryNFTResponse) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryNFTsRequest) ProtoMessage()                    {}
+func (m *QueryNFTsRequest) Reset()                           { *m = QueryNFTsRequest{} }
+func (m *QueryNFTsRequest) String() string                   { return "QueryNFTsRequest{" + m.Owner + "}" }
+func (m *QueryNFTsRequest) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryNFTsRequest) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryNFTsRequest) Size() int                        { return jsonSize(m) }
+func (m *QueryNFTsRequest) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryNFTsRequest) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryNFTsResponse) ProtoMessage()                    {}
+func (m *QueryNFTsResponse) Reset()                           { *m = QueryNFTsResponse{} }
+func (m *QueryNFTsResponse) String() string                   { return "QueryNFTsResponse{}" }
+func (m *QueryNFTsResponse) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryNFTsResponse) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryNFTsResponse) Size() int                        { return jsonSize(m) }
+func (m *QueryNFTsResponse) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryNFTsResponse) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryLandRequest) ProtoMessage()                    {}
+func (m *QueryLandRequest) Reset()                           { *m = QueryLandRequest{} }
+func (m *QueryLandRequest) String() string                   { return "QueryLandRequest{}" }
+func (m *QueryLandRequest) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryLandRequest) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryLandRequest) Size() int                        { return jsonSize(m) }
+func (m *QueryLandRequest) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryLandRequest) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryLandResponse) ProtoMessage()                    {}
+func (m *QueryLandResponse) Reset()                           { *m = QueryLandResponse{} }
+func (m *QueryLandResponse) String() string                   { return "QueryLandResponse{}" }
+func (m *QueryLandResponse) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryLandResponse) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryLandResponse) Size() int                        { return jsonSize(m) }
+func (m *QueryLandResponse) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryLandResponse) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryItemsRequest) ProtoMessage()                    {}
+func (m *QueryItemsRequest) Reset()                           { *m = QueryItemsRequest{} }
+func (m *QueryItemsRequest) String() string                   { return "QueryItemsRequest{}" }
+func (m *QueryItemsRequest) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryItemsRequest) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryItemsRequest) Size() int                        { return jsonSize(m) }
+func (m *QueryItemsRequest) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryItemsRequest) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryItemsResponse) ProtoMessage()                    {}
+func (m *QueryItemsResponse) Reset()                           { *m = QueryItemsResponse{} }
+func (m *QueryItemsResponse) String() string                   { return "QueryItemsResponse{}" }
+func (m *QueryItemsResponse) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryItemsResponse) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryItemsResponse) Size() int                        { return jsonSize(m) }
+func (m *QueryItemsResponse) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryItemsResponse) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryBadgesRequest) ProtoMessage()                    {}
+func (m *QueryBadgesRequest) Reset()                           { *m = QueryBadgesRequest{} }
+func (m *QueryBadgesRequest) String() string                   { return "QueryBadgesRequest{}" }
+func (m *QueryBadgesRequest) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryBadgesRequest) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryBadgesRequest) Size() int                        { return jsonSize(m) }
+func (m *QueryBadgesRequest) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryBadgesRequest) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryBadgesResponse) ProtoMessage()                    {}
+func (m *QueryBadgesResponse) Reset()                           { *m = QueryBadgesResponse{} }
+func (m *QueryBadgesResponse) String() string                   { return "QueryBadgesResponse{}" }
+func (m *QueryBadgesResponse) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryBadgesResponse) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryBadgesResponse) Size() int                        { return jsonSize(m) }
+func (m *QueryBadgesResponse) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryBadgesResponse) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryLandAtRequest) ProtoMessage() {}
+func (m *QueryLandAtRequest) Reset()        { *m = QueryLandAtRequest{} }
+func (m *QueryLandAtRequest) String() string {
+	return fmt.Sprintf("QueryLandAtRequest{%d, %d}", m.X, m.Y)
+}
+func (m *QueryLandAtRequest) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryLandAtRequest) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryLandAtRequest) Size() int                        { return jsonSize(m) }
+func (m *QueryLandAtRequest) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryLandAtRequest) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryLandAtResponse) ProtoMessage()                    {}
+func (m *QueryLandAtResponse) Reset()                           { *m = QueryLandAtResponse{} }
+func (m *QueryLandAtResponse) String() string                   { return "QueryLandAtResponse{" + m.Land.String() + "}" }
+func (m *QueryLandAtResponse) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryLandAtResponse) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryLandAtResponse) Size() int                        { return jsonSize(m) }
+func (m *QueryLandAtResponse) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryLandAtResponse) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryLandInAreaRequest) ProtoMessage() {}
+func (m *QueryLandInAreaRequest) Reset()        { *m = QueryLandInAreaRequest{} }
+func (m *QueryLandInAreaRequest) String() string {
+	return fmt.Sprintf("QueryLandInAreaRequest{%d, %d, %d, %d}", m.MinX, m.MinY, m.MaxX, m.MaxY)
+}
+func (m *QueryLandInAreaRequest) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryLandInAreaRequest) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryLandInAreaRequest) Size() int                        { return jsonSize(m) }
+func (m *QueryLandInAreaRequest) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryLandInAreaRequest) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryLandInAreaResponse) ProtoMessage()                    {}
+func (m *QueryLandInAreaResponse) Reset()                           { *m = QueryLandInAreaResponse{} }
+func (m *QueryLandInAreaResponse) String() string                   { return "QueryLandInAreaResponse{}" }
+func (m *QueryLandInAreaResponse) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryLandInAreaResponse) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryLandInAreaResponse) Size() int                        { return jsonSize(m) }
+func (m *QueryLandInAreaResponse) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryLandInAreaResponse) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryLandNeighborsRequest) ProtoMessage()                    {}
+func (m *QueryLandNeighborsRequest) Reset()                           { *m = QueryLandNeighborsRequest{} }
+func (m *QueryLandNeighborsRequest) String() string                   { return "QueryLandNeighborsRequest{" + m.Id + "}" }
+func (m *QueryLandNeighborsRequest) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryLandNeighborsRequest) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryLandNeighborsRequest) Size() int                        { return jsonSize(m) }
+func (m *QueryLandNeighborsRequest) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryLandNeighborsRequest) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryLandNeighborsResponse) ProtoMessage()                    {}
+func (m *QueryLandNeighborsResponse) Reset()                           { *m = QueryLandNeighborsResponse{} }
+func (m *QueryLandNeighborsResponse) String() string                   { return "QueryLandNeighborsResponse{}" }
+func (m *QueryLandNeighborsResponse) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryLandNeighborsResponse) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryLandNeighborsResponse) Size() int                        { return jsonSize(m) }
+func (m *QueryLandNeighborsResponse) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryLandNeighborsResponse) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryAttachmentsRequest) ProtoMessage()                    {}
+func (m *QueryAttachmentsRequest) Reset()                           { *m = QueryAttachmentsRequest{} }
+func (m *QueryAttachmentsRequest) String() string                   { return "QueryAttachmentsRequest{" + m.Id + "}" }
+func (m *QueryAttachmentsRequest) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryAttachmentsRequest) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryAttachmentsRequest) Size() int                        { return jsonSize(m) }
+func (m *QueryAttachmentsRequest) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryAttachmentsRequest) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryAttachmentsResponse) ProtoMessage()                    {}
+func (m *QueryAttachmentsResponse) Reset()                           { *m = QueryAttachmentsResponse{} }
+func (m *QueryAttachmentsResponse) String() string                   { return "QueryAttachmentsResponse{}" }
+func (m *QueryAttachmentsResponse) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryAttachmentsResponse) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryAttachmentsResponse) Size() int                        { return jsonSize(m) }
+func (m *QueryAttachmentsResponse) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryAttachmentsResponse) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryPlayerBadgesRequest) ProtoMessage() {}
+func (m *QueryPlayerBadgesRequest) Reset()        { *m = QueryPlayerBadgesRequest{} }
+func (m *QueryPlayerBadgesRequest) String() string {
+	return "QueryPlayerBadgesRequest{" + m.Owner + "}"
+}
+func (m *QueryPlayerBadgesRequest) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryPlayerBadgesRequest) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryPlayerBadgesRequest) Size() int                        { return jsonSize(m) }
+func (m *QueryPlayerBadgesRequest) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryPlayerBadgesRequest) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryPlayerBadgesResponse) ProtoMessage()                    {}
+func (m *QueryPlayerBadgesResponse) Reset()                           { *m = QueryPlayerBadgesResponse{} }
+func (m *QueryPlayerBadgesResponse) String() string                   { return "QueryPlayerBadgesResponse{}" }
+func (m *QueryPlayerBadgesResponse) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryPlayerBadgesResponse) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryPlayerBadgesResponse) Size() int                        { return jsonSize(m) }
+func (m *QueryPlayerBadgesResponse) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryPlayerBadgesResponse) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryBadgeIssuersRequest) ProtoMessage()                    {}
+func (m *QueryBadgeIssuersRequest) Reset()                           { *m = QueryBadgeIssuersRequest{} }
+func (m *QueryBadgeIssuersRequest) String() string                   { return "QueryBadgeIssuersRequest{}" }
+func (m *QueryBadgeIssuersRequest) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryBadgeIssuersRequest) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryBadgeIssuersRequest) Size() int                        { return jsonSize(m) }
+func (m *QueryBadgeIssuersRequest) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryBadgeIssuersRequest) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryBadgeIssuersResponse) ProtoMessage()                    {}
+func (m *QueryBadgeIssuersResponse) Reset()                           { *m = QueryBadgeIssuersResponse{} }
+func (m *QueryBadgeIssuersResponse) String() string                   { return "QueryBadgeIssuersResponse{}" }
+func (m *QueryBadgeIssuersResponse) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryBadgeIssuersResponse) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryBadgeIssuersResponse) Size() int                        { return jsonSize(m) }
+func (m *QueryBadgeIssuersResponse) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryBadgeIssuersResponse) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryClassRequest) ProtoMessage()                    {}
+func (m *QueryClassRequest) Reset()                           { *m = QueryClassRequest{} }
+func (m *QueryClassRequest) String() string                   { return "QueryClassRequest{" + m.ClassId + "}" }
+func (m *QueryClassRequest) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryClassRequest) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryClassRequest) Size() int                        { return jsonSize(m) }
+func (m *QueryClassRequest) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryClassRequest) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryClassResponse) ProtoMessage()                    {}
+func (m *QueryClassResponse) Reset()                           { *m = QueryClassResponse{} }
+func (m *QueryClassResponse) String() string                   { return "QueryClassResponse{" + m.Class.String() + "}" }
+func (m *QueryClassResponse) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryClassResponse) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryClassResponse) Size() int                        { return jsonSize(m) }
+func (m *QueryClassResponse) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryClassResponse) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryClassesRequest) ProtoMessage()                    {}
+func (m *QueryClassesRequest) Reset()                           { *m = QueryClassesRequest{} }
+func (m *QueryClassesRequest) String() string                   { return "QueryClassesRequest{}" }
+func (m *QueryClassesRequest) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryClassesRequest) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryClassesRequest) Size() int                        { return jsonSize(m) }
+func (m *QueryClassesRequest) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryClassesRequest) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryClassesResponse) ProtoMessage()                    {}
+func (m *QueryClassesResponse) Reset()                           { *m = QueryClassesResponse{} }
+func (m *QueryClassesResponse) String() string                   { return "QueryClassesResponse{}" }
+func (m *QueryClassesResponse) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryClassesResponse) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryClassesResponse) Size() int                        { return jsonSize(m) }
+func (m *QueryClassesResponse) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryClassesResponse) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryClassNFTsRequest) ProtoMessage()                    {}
+func (m *QueryClassNFTsRequest) Reset()                           { *m = QueryClassNFTsRequest{} }
+func (m *QueryClassNFTsRequest) String() string                   { return "QueryClassNFTsRequest{" + m.ClassId + "}" }
+func (m *QueryClassNFTsRequest) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryClassNFTsRequest) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryClassNFTsRequest) Size() int                        { return jsonSize(m) }
+func (m *QueryClassNFTsRequest) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryClassNFTsRequest) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryClassNFTsResponse) ProtoMessage()                    {}
+func (m *QueryClassNFTsResponse) Reset()                           { *m = QueryClassNFTsResponse{} }
+func (m *QueryClassNFTsResponse) String() string                   { return "QueryClassNFTsResponse{}" }
+func (m *QueryClassNFTsResponse) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryClassNFTsResponse) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryClassNFTsResponse) Size() int                        { return jsonSize(m) }
+func (m *QueryClassNFTsResponse) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryClassNFTsResponse) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryEffectiveUserRequest) ProtoMessage()                    {}
+func (m *QueryEffectiveUserRequest) Reset()                           { *m = QueryEffectiveUserRequest{} }
+func (m *QueryEffectiveUserRequest) String() string                   { return "QueryEffectiveUserRequest{" + m.Id + "}" }
+func (m *QueryEffectiveUserRequest) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryEffectiveUserRequest) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryEffectiveUserRequest) Size() int                        { return jsonSize(m) }
+func (m *QueryEffectiveUserRequest) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryEffectiveUserRequest) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryEffectiveUserResponse) ProtoMessage() {}
+func (m *QueryEffectiveUserResponse) Reset()        { *m = QueryEffectiveUserResponse{} }
+func (m *QueryEffectiveUserResponse) String() string {
+	return "QueryEffectiveUserResponse{" + m.User + "}"
+}
+func (m *QueryEffectiveUserResponse) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryEffectiveUserResponse) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryEffectiveUserResponse) Size() int                        { return jsonSize(m) }
+func (m *QueryEffectiveUserResponse) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryEffectiveUserResponse) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryUserRentalsRequest) ProtoMessage()                    {}
+func (m *QueryUserRentalsRequest) Reset()                           { *m = QueryUserRentalsRequest{} }
+func (m *QueryUserRentalsRequest) String() string                   { return "QueryUserRentalsRequest{" + m.User + "}" }
+func (m *QueryUserRentalsRequest) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryUserRentalsRequest) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryUserRentalsRequest) Size() int                        { return jsonSize(m) }
+func (m *QueryUserRentalsRequest) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryUserRentalsRequest) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
+
+func (m *QueryUserRentalsResponse) ProtoMessage()                    {}
+func (m *QueryUserRentalsResponse) Reset()                           { *m = QueryUserRentalsResponse{} }
+func (m *QueryUserRentalsResponse) String() string                   { return "QueryUserRentalsResponse{}" }
+func (m *QueryUserRentalsResponse) Marshal() ([]byte, error)         { return json.Marshal(m) }
+func (m *QueryUserRentalsResponse) Unmarshal(bz []byte) error        { return json.Unmarshal(bz, m) }
+func (m *QueryUserRentalsResponse) Size() int                        { return jsonSize(m) }
+func (m *QueryUserRentalsResponse) MarshalTo(bz []byte) (int, error) { return jsonMarshalTo(m, bz) }
+func (m *QueryUserRentalsResponse) MarshalToSizedBuffer(bz []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(m, bz)
+}
@@ -0,0 +1,719 @@
+package types
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// QueryServer defines the gRPC querier service, see proto/skaffacity/nft/v1/query.proto.
+type QueryServer interface {
+	// NFT returns a single NFT by ID.
+	NFT(context.Context, *QueryNFTRequest) (*QueryNFTResponse, error)
+	// NFTs returns a page of the NFTs owned by an address.
+	NFTs(context.Context, *QueryNFTsRequest) (*QueryNFTsResponse, error)
+	// Land returns a page of all land NFTs.
+	Land(context.Context, *QueryLandRequest) (*QueryLandResponse, error)
+	// Items returns a page of all item NFTs.
+	Items(context.Context, *QueryItemsRequest) (*QueryItemsResponse, error)
+	// Badges returns a page of all badge NFTs.
+	Badges(context.Context, *QueryBadgesRequest) (*QueryBadgesResponse, error)
+	// LandAt returns the land parcel covering a map cell.
+	LandAt(context.Context, *QueryLandAtRequest) (*QueryLandAtResponse, error)
+	// LandInArea returns the land parcels overlapping a bounding box.
+	LandInArea(context.Context, *QueryLandInAreaRequest) (*QueryLandInAreaResponse, error)
+	// LandNeighbors returns the land parcels adjacent to a parcel.
+	LandNeighbors(context.Context, *QueryLandNeighborsRequest) (*QueryLandNeighborsResponse, error)
+	// Attachments returns the full attachment tree below an NFT.
+	Attachments(context.Context, *QueryAttachmentsRequest) (*QueryAttachmentsResponse, error)
+	// PlayerBadges returns a page of the badges held by a player.
+	PlayerBadges(context.Context, *QueryPlayerBadgesRequest) (*QueryPlayerBadgesResponse, error)
+	// BadgeIssuers returns a page of the registered badge issuers.
+	BadgeIssuers(context.Context, *QueryBadgeIssuersRequest) (*QueryBadgeIssuersResponse, error)
+	// Class returns a class with its current and total minted supply.
+	Class(context.Context, *QueryClassRequest) (*QueryClassResponse, error)
+	// Classes returns a page of all classes.
+	Classes(context.Context, *QueryClassesRequest) (*QueryClassesResponse, error)
+	// ClassNFTs returns a page of the NFTs in a class.
+	ClassNFTs(context.Context, *QueryClassNFTsRequest) (*QueryClassNFTsResponse, error)
+	// EffectiveUser returns the account entitled to use an NFT, the renter or the owner.
+	EffectiveUser(context.Context, *QueryEffectiveUserRequest) (*QueryEffectiveUserResponse, error)
+	// UserRentals returns a page of the NFTs rented to an account.
+	UserRentals(context.Context, *QueryUserRentalsRequest) (*QueryUserRentalsResponse, error)
+}
+
+// QueryClient defines the gRPC querier client.
+type QueryClient interface {
+	NFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryNFTResponse, error)
+	NFTs(ctx context.Context, in *QueryNFTsRequest, opts ...grpc.CallOption) (*QueryNFTsResponse, error)
+	Land(ctx context.Context, in *QueryLandRequest, opts ...grpc.CallOption) (*QueryLandResponse, error)
+	Items(ctx context.Context, in *QueryItemsRequest, opts ...grpc.CallOption) (*QueryItemsResponse, error)
+	Badges(ctx context.Context, in *QueryBadgesRequest, opts ...grpc.CallOption) (*QueryBadgesResponse, error)
+	LandAt(ctx context.Context, in *QueryLandAtRequest, opts ...grpc.CallOption) (*QueryLandAtResponse, error)
+	LandInArea(ctx context.Context, in *QueryLandInAreaRequest, opts ...grpc.CallOption) (*QueryLandInAreaResponse, error)
+	LandNeighbors(ctx context.Context, in *QueryLandNeighborsRequest, opts ...grpc.CallOption) (*QueryLandNeighborsResponse, error)
+	Attachments(ctx context.Context, in *QueryAttachmentsRequest, opts ...grpc.CallOption) (*QueryAttachmentsResponse, error)
+	PlayerBadges(ctx context.Context, in *QueryPlayerBadgesRequest, opts ...grpc.CallOption) (*QueryPlayerBadgesResponse, error)
+	BadgeIssuers(ctx context.Context, in *QueryBadgeIssuersRequest, opts ...grpc.CallOption) (*QueryBadgeIssuersResponse, error)
+	Class(ctx context.Context, in *QueryClassRequest, opts ...grpc.CallOption) (*QueryClassResponse, error)
+	Classes(ctx context.Context, in *QueryClassesRequest, opts ...grpc.CallOption) (*QueryClassesResponse, error)
+	ClassNFTs(ctx context.Context, in *QueryClassNFTsRequest, opts ...grpc.CallOption) (*QueryClassNFTsResponse, error)
+	EffectiveUser(ctx context.Context, in *QueryEffectiveUserRequest, opts ...grpc.CallOption) (*QueryEffectiveUserResponse, error)
+	UserRentals(ctx context.Context, in *QueryUserRentalsRequest, opts ...grpc.CallOption) (*QueryUserRentalsResponse, error)
+}
+
+const queryServiceName = "skaffacity.nft.v1.Query"
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+// NewQueryClient creates a new QueryClient.
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) NFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryNFTResponse, error) {
+	out := new(QueryNFTResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/NFT", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NFTs(ctx context.Context, in *QueryNFTsRequest, opts ...grpc.CallOption) (*QueryNFTsResponse, error) {
+	out := new(QueryNFTsResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/NFTs", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Land(ctx context.Context, in *QueryLandRequest, opts ...grpc.CallOption) (*QueryLandResponse, error) {
+	out := new(QueryLandResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/Land", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Items(ctx context.Context, in *QueryItemsRequest, opts ...grpc.CallOption) (*QueryItemsResponse, error) {
+	out := new(QueryItemsResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/Items", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Badges(ctx context.Context, in *QueryBadgesRequest, opts ...grpc.CallOption) (*QueryBadgesResponse, error) {
+	out := new(QueryBadgesResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/Badges", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LandAt(ctx context.Context, in *QueryLandAtRequest, opts ...grpc.CallOption) (*QueryLandAtResponse, error) {
+	out := new(QueryLandAtResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/LandAt", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LandInArea(ctx context.Context, in *QueryLandInAreaRequest, opts ...grpc.CallOption) (*QueryLandInAreaResponse, error) {
+	out := new(QueryLandInAreaResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/LandInArea", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LandNeighbors(ctx context.Context, in *QueryLandNeighborsRequest, opts ...grpc.CallOption) (*QueryLandNeighborsResponse, error) {
+	out := new(QueryLandNeighborsResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/LandNeighbors", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Attachments(ctx context.Context, in *QueryAttachmentsRequest, opts ...grpc.CallOption) (*QueryAttachmentsResponse, error) {
+	out := new(QueryAttachmentsResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/Attachments", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PlayerBadges(ctx context.Context, in *QueryPlayerBadgesRequest, opts ...grpc.CallOption) (*QueryPlayerBadgesResponse, error) {
+	out := new(QueryPlayerBadgesResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/PlayerBadges", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BadgeIssuers(ctx context.Context, in *QueryBadgeIssuersRequest, opts ...grpc.CallOption) (*QueryBadgeIssuersResponse, error) {
+	out := new(QueryBadgeIssuersResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/BadgeIssuers", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Class(ctx context.Context, in *QueryClassRequest, opts ...grpc.CallOption) (*QueryClassResponse, error) {
+	out := new(QueryClassResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/Class", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Classes(ctx context.Context, in *QueryClassesRequest, opts ...grpc.CallOption) (*QueryClassesResponse, error) {
+	out := new(QueryClassesResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/Classes", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClassNFTs(ctx context.Context, in *QueryClassNFTsRequest, opts ...grpc.CallOption) (*QueryClassNFTsResponse, error) {
+	out := new(QueryClassNFTsResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/ClassNFTs", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EffectiveUser(ctx context.Context, in *QueryEffectiveUserRequest, opts ...grpc.CallOption) (*QueryEffectiveUserResponse, error) {
+	out := new(QueryEffectiveUserResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/EffectiveUser", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UserRentals(ctx context.Context, in *QueryUserRentalsRequest, opts ...grpc.CallOption) (*QueryUserRentalsResponse, error) {
+	out := new(QueryUserRentalsResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/UserRentals", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RegisterQueryServer registers a service implementation with the server.
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_NFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNFTRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + queryServiceName + "/NFT"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NFT(ctx, req.(*QueryNFTRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NFTs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNFTsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NFTs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + queryServiceName + "/NFTs"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NFTs(ctx, req.(*QueryNFTsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Land_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Land(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + queryServiceName + "/Land"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Land(ctx, req.(*QueryLandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Items_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Items(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + queryServiceName + "/Items"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Items(ctx, req.(*QueryItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Badges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBadgesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Badges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + queryServiceName + "/Badges"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Badges(ctx, req.(*QueryBadgesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LandAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLandAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LandAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + queryServiceName + "/LandAt"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LandAt(ctx, req.(*QueryLandAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LandInArea_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLandInAreaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LandInArea(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + queryServiceName + "/LandInArea"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LandInArea(ctx, req.(*QueryLandInAreaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LandNeighbors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLandNeighborsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LandNeighbors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + queryServiceName + "/LandNeighbors"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LandNeighbors(ctx, req.(*QueryLandNeighborsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Attachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttachmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Attachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + queryServiceName + "/Attachments"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Attachments(ctx, req.(*QueryAttachmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PlayerBadges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPlayerBadgesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PlayerBadges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + queryServiceName + "/PlayerBadges"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PlayerBadges(ctx, req.(*QueryPlayerBadgesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BadgeIssuers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBadgeIssuersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BadgeIssuers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + queryServiceName + "/BadgeIssuers"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BadgeIssuers(ctx, req.(*QueryBadgeIssuersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Class_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Class(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + queryServiceName + "/Class"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Class(ctx, req.(*QueryClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Classes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Classes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + queryServiceName + "/Classes"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Classes(ctx, req.(*QueryClassesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClassNFTs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassNFTsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClassNFTs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + queryServiceName + "/ClassNFTs"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassNFTs(ctx, req.(*QueryClassNFTsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EffectiveUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEffectiveUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EffectiveUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + queryServiceName + "/EffectiveUser"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EffectiveUser(ctx, req.(*QueryEffectiveUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UserRentals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUserRentalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UserRentals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + queryServiceName + "/UserRentals"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserRentals(ctx, req.(*QueryUserRentalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: queryServiceName,
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "NFT", Handler: _Query_NFT_Handler},
+		{MethodName: "NFTs", Handler: _Query_NFTs_Handler},
+		{MethodName: "Land", Handler: _Query_Land_Handler},
+		{MethodName: "Items", Handler: _Query_Items_Handler},
+		{MethodName: "Badges", Handler: _Query_Badges_Handler},
+		{MethodName: "LandAt", Handler: _Query_LandAt_Handler},
+		{MethodName: "LandInArea", Handler: _Query_LandInArea_Handler},
+		{MethodName: "LandNeighbors", Handler: _Query_LandNeighbors_Handler},
+		{MethodName: "Attachments", Handler: _Query_Attachments_Handler},
+		{MethodName: "PlayerBadges", Handler: _Query_PlayerBadges_Handler},
+		{MethodName: "BadgeIssuers", Handler: _Query_BadgeIssuers_Handler},
+		{MethodName: "Class", Handler: _Query_Class_Handler},
+		{MethodName: "Classes", Handler: _Query_Classes_Handler},
+		{MethodName: "ClassNFTs", Handler: _Query_ClassNFTs_Handler},
+		{MethodName: "EffectiveUser", Handler: _Query_EffectiveUser_Handler},
+		{MethodName: "UserRentals", Handler: _Query_UserRentals_Handler},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "skaffacity/nft/v1/query.proto",
+}
+
+var (
+	pattern_Query_NFT_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"skaffacity", "nft", "v1", "nfts", "id"}, "", runtime.AssumeColonVerbOpt(false)))
+
+	pattern_Query_NFTs_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"skaffacity", "nft", "v1", "owners", "owner", "nfts"}, "", runtime.AssumeColonVerbOpt(false)))
+
+	pattern_Query_Land_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"skaffacity", "nft", "v1", "land"}, "", runtime.AssumeColonVerbOpt(false)))
+
+	pattern_Query_Items_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"skaffacity", "nft", "v1", "items"}, "", runtime.AssumeColonVerbOpt(false)))
+
+	pattern_Query_Badges_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"skaffacity", "nft", "v1", "badges"}, "", runtime.AssumeColonVerbOpt(false)))
+
+	pattern_Query_LandAt_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 1, 0, 4, 1, 5, 5, 1, 0, 4, 1, 5, 6}, []string{"skaffacity", "nft", "v1", "land", "at", "x", "y"}, "", runtime.AssumeColonVerbOpt(false)))
+
+	pattern_Query_LandInArea_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4}, []string{"skaffacity", "nft", "v1", "land", "area"}, "", runtime.AssumeColonVerbOpt(false)))
+
+	pattern_Query_LandNeighbors_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"skaffacity", "nft", "v1", "land", "id", "neighbors"}, "", runtime.AssumeColonVerbOpt(false)))
+
+	pattern_Query_Attachments_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"skaffacity", "nft", "v1", "nfts", "id", "attachments"}, "", runtime.AssumeColonVerbOpt(false)))
+
+	pattern_Query_PlayerBadges_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"skaffacity", "nft", "v1", "owners", "owner", "badges"}, "", runtime.AssumeColonVerbOpt(false)))
+
+	pattern_Query_BadgeIssuers_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"skaffacity", "nft", "v1", "badge_issuers"}, "", runtime.AssumeColonVerbOpt(false)))
+
+	pattern_Query_Class_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"skaffacity", "nft", "v1", "classes", "class_id"}, "", runtime.AssumeColonVerbOpt(false)))
+
+	pattern_Query_Classes_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"skaffacity", "nft", "v1", "classes"}, "", runtime.AssumeColonVerbOpt(false)))
+
+	pattern_Query_ClassNFTs_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"skaffacity", "nft", "v1", "classes", "class_id", "nfts"}, "", runtime.AssumeColonVerbOpt(false)))
+
+	pattern_Query_EffectiveUser_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"skaffacity", "nft", "v1", "nfts", "id", "user"}, "", runtime.AssumeColonVerbOpt(false)))
+
+	pattern_Query_UserRentals_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"skaffacity", "nft", "v1", "users", "user", "rentals"}, "", runtime.AssumeColonVerbOpt(false)))
+)
+
+// RegisterQueryHandlerClient registers the http handlers for service Query to "mux".
+func RegisterQueryHandlerClient(ctx context.Context, mux *runtime.ServeMux, client QueryClient) error {
+	HandleGateway(mux, pattern_Query_NFT_0, func(ctx context.Context, req *http.Request, pathParams map[string]string) (interface{}, error) {
+		return client.NFT(ctx, &QueryNFTRequest{Id: pathParams["id"]})
+	})
+
+	HandleGateway(mux, pattern_Query_NFTs_0, func(ctx context.Context, req *http.Request, pathParams map[string]string) (interface{}, error) {
+		pageReq, err := PageRequestFromQuery(req.URL.Query())
+		if err != nil {
+			return nil, err
+		}
+		return client.NFTs(ctx, &QueryNFTsRequest{Owner: pathParams["owner"], Pagination: pageReq})
+	})
+
+	HandleGateway(mux, pattern_Query_Land_0, func(ctx context.Context, req *http.Request, pathParams map[string]string) (interface{}, error) {
+		pageReq, err := PageRequestFromQuery(req.URL.Query())
+		if err != nil {
+			return nil, err
+		}
+		return client.Land(ctx, &QueryLandRequest{Pagination: pageReq})
+	})
+
+	HandleGateway(mux, pattern_Query_Items_0, func(ctx context.Context, req *http.Request, pathParams map[string]string) (interface{}, error) {
+		pageReq, err := PageRequestFromQuery(req.URL.Query())
+		if err != nil {
+			return nil, err
+		}
+		return client.Items(ctx, &QueryItemsRequest{Pagination: pageReq})
+	})
+
+	HandleGateway(mux, pattern_Query_Badges_0, func(ctx context.Context, req *http.Request, pathParams map[string]string) (interface{}, error) {
+		pageReq, err := PageRequestFromQuery(req.URL.Query())
+		if err != nil {
+			return nil, err
+		}
+		return client.Badges(ctx, &QueryBadgesRequest{Pagination: pageReq})
+	})
+
+	HandleGateway(mux, pattern_Query_LandAt_0, func(ctx context.Context, req *http.Request, pathParams map[string]string) (interface{}, error) {
+		x, err := parseCoordinate("x", pathParams["x"])
+		if err != nil {
+			return nil, err
+		}
+		y, err := parseCoordinate("y", pathParams["y"])
+		if err != nil {
+			return nil, err
+		}
+		return client.LandAt(ctx, &QueryLandAtRequest{X: x, Y: y})
+	})
+
+	HandleGateway(mux, pattern_Query_LandInArea_0, func(ctx context.Context, req *http.Request, pathParams map[string]string) (interface{}, error) {
+		values := req.URL.Query()
+		var (
+			in  QueryLandInAreaRequest
+			err error
+		)
+		if in.MinX, err = parseCoordinate("min_x", values.Get("min_x")); err != nil {
+			return nil, err
+		}
+		if in.MinY, err = parseCoordinate("min_y", values.Get("min_y")); err != nil {
+			return nil, err
+		}
+		if in.MaxX, err = parseCoordinate("max_x", values.Get("max_x")); err != nil {
+			return nil, err
+		}
+		if in.MaxY, err = parseCoordinate("max_y", values.Get("max_y")); err != nil {
+			return nil, err
+		}
+		return client.LandInArea(ctx, &in)
+	})
+
+	HandleGateway(mux, pattern_Query_LandNeighbors_0, func(ctx context.Context, req *http.Request, pathParams map[string]string) (interface{}, error) {
+		return client.LandNeighbors(ctx, &QueryLandNeighborsRequest{Id: pathParams["id"]})
+	})
+
+	HandleGateway(mux, pattern_Query_Attachments_0, func(ctx context.Context, req *http.Request, pathParams map[string]string) (interface{}, error) {
+		return client.Attachments(ctx, &QueryAttachmentsRequest{Id: pathParams["id"]})
+	})
+
+	HandleGateway(mux, pattern_Query_PlayerBadges_0, func(ctx context.Context, req *http.Request, pathParams map[string]string) (interface{}, error) {
+		pageReq, err := PageRequestFromQuery(req.URL.Query())
+		if err != nil {
+			return nil, err
+		}
+		return client.PlayerBadges(ctx, &QueryPlayerBadgesRequest{Owner: pathParams["owner"], Pagination: pageReq})
+	})
+
+	HandleGateway(mux, pattern_Query_BadgeIssuers_0, func(ctx context.Context, req *http.Request, pathParams map[string]string) (interface{}, error) {
+		pageReq, err := PageRequestFromQuery(req.URL.Query())
+		if err != nil {
+			return nil, err
+		}
+		return client.BadgeIssuers(ctx, &QueryBadgeIssuersRequest{Pagination: pageReq})
+	})
+
+	HandleGateway(mux, pattern_Query_Class_0, func(ctx context.Context, req *http.Request, pathParams map[string]string) (interface{}, error) {
+		return client.Class(ctx, &QueryClassRequest{ClassId: pathParams["class_id"]})
+	})
+
+	HandleGateway(mux, pattern_Query_Classes_0, func(ctx context.Context, req *http.Request, pathParams map[string]string) (interface{}, error) {
+		pageReq, err := PageRequestFromQuery(req.URL.Query())
+		if err != nil {
+			return nil, err
+		}
+		return client.Classes(ctx, &QueryClassesRequest{Pagination: pageReq})
+	})
+
+	HandleGateway(mux, pattern_Query_ClassNFTs_0, func(ctx context.Context, req *http.Request, pathParams map[string]string) (interface{}, error) {
+		pageReq, err := PageRequestFromQuery(req.URL.Query())
+		if err != nil {
+			return nil, err
+		}
+		return client.ClassNFTs(ctx, &QueryClassNFTsRequest{ClassId: pathParams["class_id"], Pagination: pageReq})
+	})
+
+	HandleGateway(mux, pattern_Query_EffectiveUser_0, func(ctx context.Context, req *http.Request, pathParams map[string]string) (interface{}, error) {
+		return client.EffectiveUser(ctx, &QueryEffectiveUserRequest{Id: pathParams["id"]})
+	})
+
+	HandleGateway(mux, pattern_Query_UserRentals_0, func(ctx context.Context, req *http.Request, pathParams map[string]string) (interface{}, error) {
+		pageReq, err := PageRequestFromQuery(req.URL.Query())
+		if err != nil {
+			return nil, err
+		}
+		return client.UserRentals(ctx, &QueryUserRentalsRequest{User: pathParams["user"], Pagination: pageReq})
+	})
+
+	return nil
+}
+
+// HandleGateway registers a GET route that forwards to the query client and
+// writes the response as JSON.
+func HandleGateway(mux *runtime.ServeMux, pattern runtime.Pattern, call func(context.Context, *http.Request, map[string]string) (interface{}, error)) {
+	mux.Handle("GET", pattern, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateContext(ctx, mux, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		resp, err := call(rctx, req, pathParams)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+		}
+	})
+}
+
+// PageRequestFromQuery reads the pagination.* query parameters of a gateway request
+func PageRequestFromQuery(values url.Values) (*query.PageRequest, error) {
+	pageReq := &query.PageRequest{}
+	var err error
+
+	if key := values.Get("pagination.key"); key != "" {
+		if pageReq.Key, err = base64.StdEncoding.DecodeString(key); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid pagination.key: %s", err)
+		}
+	}
+
+	if offset := values.Get("pagination.offset"); offset != "" {
+		if pageReq.Offset, err = strconv.ParseUint(offset, 10, 64); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid pagination.offset: %s", err)
+		}
+	}
+
+	if limit := values.Get("pagination.limit"); limit != "" {
+		if pageReq.Limit, err = strconv.ParseUint(limit, 10, 64); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid pagination.limit: %s", err)
+		}
+	}
+
+	if countTotal := values.Get("pagination.count_total"); countTotal != "" {
+		if pageReq.CountTotal, err = strconv.ParseBool(countTotal); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid pagination.count_total: %s", err)
+		}
+	}
+
+	if reverse := values.Get("pagination.reverse"); reverse != "" {
+		if pageReq.Reverse, err = strconv.ParseBool(reverse); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid pagination.reverse: %s", err)
+		}
+	}
+
+	return pageReq, nil
+}
+
+// parseCoordinate reads a map coordinate from a gateway path or query parameter
+func parseCoordinate(name, value string) (int32, error) {
+	c, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid %s: %s", name, err)
+	}
+	return int32(c), nil
+}
@@ -14,11 +14,19 @@ const (
 // NFT represents a non-fungible token in the game
 type NFT struct {
     ID          string    `json:"id"`
+    // ClassID is the class the NFT was minted in; ID starts with it
+    ClassID     string    `json:"class_id"`
     Type        string    `json:"type"`
     Owner       string    `json:"owner"`
     Metadata    Metadata  `json:"metadata"`
     Created     time.Time `json:"created"`
     Transferable bool     `json:"transferable"`
+    // Parent is the ID of the item this NFT is attached to, if any
+    Parent      string    `json:"parent,omitempty"`
+    // Land holds the parcel placement, set only for land NFTs
+    Land        *LandMetadata `json:"land,omitempty"`
+    // Badge holds the achievement record, set only for badge NFTs
+    Badge       *BadgeMetadata `json:"badge,omitempty"`
 }
 
 
@@ -30,9 +38,9 @@ type Metadata struct {
     Properties  map[string]string `json:"properties"`
 }
 
-// LandMetadata contains land-specific properties
+// LandMetadata contains land-specific properties. A parcel covers the
+// Size x Size square of cells whose lower-left corner is Location.
 type LandMetadata struct {
-    Metadata
     Location    Location `json:"location"`
     Size        uint32   `json:"size"`
     BuildRights bool     `json:"build_rights"`
@@ -44,19 +52,27 @@ type Location struct {
     Y int32 `json:"y"`
 }
 
-// ItemMetadata contains item-specific properties
+// ItemMetadata contains item-specific properties. Attachments are not listed
+// here; they are tracked through each attachment's Parent.
 type ItemMetadata struct {
     Metadata
     ItemType    string   `json:"item_type"`
     Rarity      string   `json:"rarity"`
     Season      uint32   `json:"season"`
-    Attachments []string `json:"attachments"`
 }
 
-// BadgeMetadata contains badge-specific properties
+// BadgeMetadata contains badge-specific properties. Badges are soulbound:
+// they never leave the player they were issued to, and only non-permanent
+// badges can be revoked by their issuer.
 type BadgeMetadata struct {
-    Metadata
     Achievement string    `json:"achievement"`
+    Issuer      string    `json:"issuer"`
     DateEarned  time.Time `json:"date_earned"`
     Permanent   bool      `json:"permanent"`
 }
+
+// BadgeIssuer is an account allowed to award badges
+type BadgeIssuer struct {
+    Address string `json:"address"`
+    Name    string `json:"name"`
+}
@@ -0,0 +1,9 @@
+// Package v1beta1 holds the cosmos.nft.v1beta1 types and query service, so
+// that x/nft can serve the standard NFT queries to wallets and explorers that
+// speak the Cosmos SDK NFT interface. The .pb.go files are the cosmos-sdk
+// v0.47.0 x/nft code generated by protoc-gen-gogo from the protos in
+// third_party/proto/cosmos/nft/v1beta1; the SDK package itself cannot be
+// imported as its errors use the same "nft" codespace as ours. NFT IDs in
+// this package are local to their class, as in the standard; the full
+// skaffacity ID is "<class_id>:<id>".
+package v1beta1
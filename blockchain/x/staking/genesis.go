@@ -0,0 +1,25 @@
+package staking
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"skaffacity/x/staking/keeper"
+	"skaffacity/x/staking/types"
+)
+
+// InitGenesis stores the genesis validator set and returns it as the
+// chain's initial consensus validators
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) []abci.ValidatorUpdate {
+	updates := make([]abci.ValidatorUpdate, 0, len(genState.Validators))
+	for _, validator := range genState.Validators {
+		k.SetValidator(ctx, validator)
+		updates = append(updates, validator.ValidatorUpdate())
+	}
+	return updates
+}
+
+// ExportGenesis returns the staking module's exported genesis
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{Validators: k.GetAllValidators(ctx)}
+}
@@ -43,16 +43,30 @@ func (k Keeper) Unstake(ctx sdk.Context, delegatorAddr string, amount sdk.Int) e
 	return nil
 }
 
-// CalculateStatus determines player status based on staked amount
+// CalculateStatus determines player status based on staked amount: the
+// level of the highest status tier it reaches, or zero below every tier
 func (k Keeper) CalculateStatus(ctx sdk.Context, amount sdk.Int) sdk.Dec {
-	// Stubbed: status calculation removed for build
-	return sdk.ZeroDec()
+	tier, found := k.GetParams(ctx).StatusTier(amount)
+	if !found {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDec(int64(tier.Level))
+}
+
+// GetVoteWeight returns the governance vote weight of the highest status
+// tier a stake reaches. Stake below every tier counts at face value.
+func (k Keeper) GetVoteWeight(ctx sdk.Context, amount sdk.Int) sdk.Dec {
+	tier, found := k.GetParams(ctx).StatusTier(amount)
+	if !found {
+		return sdk.OneDec()
+	}
+	return tier.VoteWeight
 }
 
 // GetDelegation retrieves delegation information
 func (k Keeper) GetDelegation(ctx sdk.Context, delegatorAddr string) (*types.Delegation, error) {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get([]byte(delegatorAddr))
+	bz := store.Get(types.GetDelegationKey(delegatorAddr))
 	if bz == nil {
 		return nil, errors.Wrap(types.ErrNoDelegation, "delegation not found")
 	}
@@ -62,12 +76,64 @@ func (k Keeper) GetDelegation(ctx sdk.Context, delegatorAddr string) (*types.Del
 	return &delegation, nil
 }
 
-// SetDelegation saves delegation information
+// SetDelegation saves delegation information and keeps the total stake in
+// step with its amount. The keeper owns StartTime: a new delegation, or one
+// whose amount grows, starts at the block time, while one that shrinks or
+// stays the same keeps its start, so stake added during a vote never counts
+// towards it.
 func (k Keeper) SetDelegation(ctx sdk.Context, delegation *types.Delegation) {
+	previous := k.GetStakedAmount(ctx, delegation.DelegatorAddress)
+	if current, err := k.GetDelegation(ctx, delegation.DelegatorAddress); err == nil && delegation.Amount.LTE(previous) {
+		delegation.StartTime = current.StartTime
+	} else {
+		delegation.StartTime = ctx.BlockTime()
+	}
+
+	k.setTotalStake(ctx, k.GetTotalStake(ctx).Add(delegation.Amount).Sub(previous))
+
 	store := ctx.KVStore(k.storeKey)
-	key := []byte("delegation/" + delegation.DelegatorAddress)
 	bz := k.cdc.MustMarshal(delegation)
-	store.Set(key, bz)
+	store.Set(types.GetDelegationKey(delegation.DelegatorAddress), bz)
+}
+
+// GetTotalStake returns the sum of every delegation's amount. Until the
+// first SetDelegation stores the total, it is summed from the delegations
+// already in the store.
+func (k Keeper) GetTotalStake(ctx sdk.Context) sdk.Int {
+	bz := ctx.KVStore(k.storeKey).Get(types.TotalStakeKey)
+	if bz == nil {
+		return k.sumDelegations(ctx)
+	}
+
+	var total sdk.Int
+	if err := total.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return total
+}
+
+// sumDelegations adds up the amount of every stored delegation
+func (k Keeper) sumDelegations(ctx sdk.Context) sdk.Int {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.DelegationKeyPrefix)
+	defer iterator.Close()
+
+	total := sdk.ZeroInt()
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.Delegation
+		k.cdc.MustUnmarshal(iterator.Value(), &delegation)
+		if !delegation.Amount.IsNil() {
+			total = total.Add(delegation.Amount)
+		}
+	}
+	return total
+}
+
+func (k Keeper) setTotalStake(ctx sdk.Context, total sdk.Int) {
+	bz, err := total.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.TotalStakeKey, bz)
 }
 
 // GetStakedAmount returns the total staked amount for a delegator (for governance interface)
@@ -78,3 +144,39 @@ func (k Keeper) GetStakedAmount(ctx sdk.Context, address string) sdk.Int {
     }
     return delegation.Amount
 }
+
+// GetParams returns the staking params, or the defaults if none are set
+func (k Keeper) GetParams(ctx sdk.Context) types.StakingParams {
+	bz := ctx.KVStore(k.storeKey).Get(types.ParamsKey)
+	if bz == nil {
+		return types.DefaultParams()
+	}
+
+	var params types.StakingParams
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams stores the staking params
+func (k Keeper) SetParams(ctx sdk.Context, params types.StakingParams) {
+	ctx.KVStore(k.storeKey).Set(types.ParamsKey, k.cdc.MustMarshal(&params))
+}
+
+// SetValidator stores a member of the consensus validator set
+func (k Keeper) SetValidator(ctx sdk.Context, validator types.GenesisValidator) {
+	ctx.KVStore(k.storeKey).Set(types.GetValidatorKey(validator.PubKey), k.cdc.MustMarshal(&validator))
+}
+
+// GetAllValidators returns the consensus validator set in key order
+func (k Keeper) GetAllValidators(ctx sdk.Context) []types.GenesisValidator {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ValidatorKeyPrefix)
+	defer iterator.Close()
+
+	validators := []types.GenesisValidator{}
+	for ; iterator.Valid(); iterator.Next() {
+		var validator types.GenesisValidator
+		k.cdc.MustUnmarshal(iterator.Value(), &validator)
+		validators = append(validators, validator)
+	}
+	return validators
+}
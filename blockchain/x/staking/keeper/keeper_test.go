@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"skaffacity/x/staking/keeper"
+	"skaffacity/x/staking/types"
+)
+
+// genesisTime is the block time every test starts at
+var genesisTime = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// testAddr returns a distinct account address for each n
+func testAddr(n byte) string {
+	return sdk.AccAddress(append(make([]byte, 19), n)).String()
+}
+
+type fixture struct {
+	ctx      sdk.Context
+	keeper   *keeper.Keeper
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+}
+
+// setupKeeper returns a staking keeper at genesisTime
+func setupKeeper(t *testing.T) fixture {
+	t.Helper()
+
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(cms, tmproto.Header{Time: genesisTime}, false, log.NewNopLogger())
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	return fixture{
+		ctx:      ctx,
+		keeper:   keeper.NewKeeper(cdc, storeKey, nil),
+		cdc:      cdc,
+		storeKey: storeKey,
+	}
+}
+
+// stake sets the delegation of addr to amount in a block at the given time
+// and returns the stored delegation
+func (f fixture) stake(t *testing.T, addr string, amount int64, at time.Time) types.Delegation {
+	t.Helper()
+
+	f.keeper.SetDelegation(f.ctx.WithBlockTime(at), &types.Delegation{
+		DelegatorAddress: addr,
+		Amount:           sdk.NewInt(amount),
+	})
+	delegation, err := f.keeper.GetDelegation(f.ctx, addr)
+	require.NoError(t, err)
+	return *delegation
+}
+
+func TestDelegationStartsWhenItsAmountGrows(t *testing.T) {
+	f := setupKeeper(t)
+	addr := testAddr(1)
+
+	// the start time given by the caller is ignored
+	f.keeper.SetDelegation(f.ctx, &types.Delegation{
+		DelegatorAddress: addr,
+		Amount:           sdk.NewInt(100),
+		StartTime:        genesisTime.Add(-24 * time.Hour),
+	})
+	delegation, err := f.keeper.GetDelegation(f.ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, genesisTime, delegation.StartTime)
+
+	require.Equal(t, genesisTime, f.stake(t, addr, 60, genesisTime.Add(time.Hour)).StartTime)
+	require.Equal(t, genesisTime, f.stake(t, addr, 60, genesisTime.Add(2*time.Hour)).StartTime)
+	require.Equal(t, genesisTime.Add(3*time.Hour), f.stake(t, addr, 61, genesisTime.Add(3*time.Hour)).StartTime)
+}
+
+func TestTotalStakeFollowsDelegations(t *testing.T) {
+	f := setupKeeper(t)
+
+	f.stake(t, testAddr(1), 300, genesisTime)
+	f.stake(t, testAddr(2), 200, genesisTime)
+	f.stake(t, testAddr(1), 100, genesisTime)
+	require.Equal(t, int64(300), f.keeper.GetTotalStake(f.ctx).Int64())
+}
+
+func TestTotalStakeCountsDelegationsStoredBeforeIt(t *testing.T) {
+	f := setupKeeper(t)
+
+	// delegations written without going through SetDelegation, as they were
+	// before the total was kept
+	store := f.ctx.KVStore(f.storeKey)
+	for i, amount := range []int64{300, 200} {
+		delegation := types.Delegation{DelegatorAddress: testAddr(byte(i + 1)), Amount: sdk.NewInt(amount)}
+		store.Set(types.GetDelegationKey(delegation.DelegatorAddress), f.cdc.MustMarshal(&delegation))
+	}
+	require.Equal(t, int64(500), f.keeper.GetTotalStake(f.ctx).Int64())
+
+	f.stake(t, testAddr(1), 100, genesisTime)
+	f.stake(t, testAddr(3), 50, genesisTime)
+	require.Equal(t, int64(350), f.keeper.GetTotalStake(f.ctx).Int64())
+}
@@ -2,6 +2,7 @@ package staking
 
 import (
     "encoding/json"
+    "fmt"
 
     "github.com/cosmos/cosmos-sdk/codec"
     codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -30,24 +31,49 @@ func (am AppModule) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {}
 
 func (am AppModule) RegisterInterfaces(registry codectypes.InterfaceRegistry) {}
 
+// DefaultGenesis returns the staking module's default genesis state. The
+// staking types are JSON-encoded rather than generated protobuf, so genesis
+// uses encoding/json directly instead of the codec.
 func (am AppModule) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
-    return []byte(`{}`) // Simple empty JSON for now
+    bz, err := json.Marshal(stakingtypes.DefaultGenesisState())
+    if err != nil {
+        panic(err)
+    }
+    return bz
 }
 
 func (am AppModule) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncodingConfig, bz json.RawMessage) error {
-    return nil
+    var genState stakingtypes.GenesisState
+    if err := json.Unmarshal(bz, &genState); err != nil {
+        return fmt.Errorf("failed to unmarshal %s genesis state: %w", stakingtypes.ModuleName, err)
+    }
+    return genState.Validate()
 }
 
 func (am AppModule) RegisterServices(cfg module.Configurator) {}
 
 func (am AppModule) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {}
 
+// InitGenesis stores the genesis validators and returns them to CometBFT as
+// the initial validator set
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
-    return []abci.ValidatorUpdate{}
+    var genState stakingtypes.GenesisState
+    if err := json.Unmarshal(data, &genState); err != nil {
+        panic(fmt.Errorf("failed to unmarshal %s genesis state: %w", stakingtypes.ModuleName, err))
+    }
+    if err := genState.Validate(); err != nil {
+        panic(err)
+    }
+
+    return InitGenesis(ctx, am.keeper, genState)
 }
 
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
-    return []byte(`{}`) // Simple empty JSON for now
+    bz, err := json.Marshal(ExportGenesis(ctx, am.keeper))
+    if err != nil {
+        panic(err)
+    }
+    return bz
 }
 
 func (am AppModule) ConsensusVersion() uint64 { return 1 }
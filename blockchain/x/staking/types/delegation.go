@@ -1,10 +1,34 @@
 package types
 
+import (
+	"encoding/json"
+	"fmt"
+)
+
 // ProtoMessage implements the proto.Message interface for Delegation.
 func (d *Delegation) ProtoMessage() {}
 
 // Reset implements the proto.Message interface for Delegation.
-func (d *Delegation) Reset() {}
+func (d *Delegation) Reset() { *d = Delegation{} }
 
 // String implements the fmt.Stringer interface for Delegation.
-func (d *Delegation) String() string { return "Delegation stub" }
\ No newline at end of file
+func (d *Delegation) String() string {
+	return fmt.Sprintf("Delegation{DelegatorAddress: %s, ValidatorAddress: %s, Amount: %s}", d.DelegatorAddress, d.ValidatorAddress, d.Amount)
+}
+
+// Marshal implements codec.ProtoMarshaler for Delegation.
+func (d *Delegation) Marshal() ([]byte, error) { return json.Marshal(d) }
+
+// MarshalTo implements codec.ProtoMarshaler for Delegation.
+func (d *Delegation) MarshalTo(data []byte) (int, error) { return jsonMarshalTo(d, data) }
+
+// MarshalToSizedBuffer implements codec.ProtoMarshaler for Delegation.
+func (d *Delegation) MarshalToSizedBuffer(data []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(d, data)
+}
+
+// Unmarshal implements codec.ProtoMarshaler for Delegation.
+func (d *Delegation) Unmarshal(data []byte) error { return json.Unmarshal(data, d) }
+
+// Size implements codec.ProtoMarshaler for Delegation.
+func (d *Delegation) Size() int { return jsonSize(d) }
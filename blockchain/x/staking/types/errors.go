@@ -11,4 +11,5 @@ var (
 	ErrInvalidAmount = sdkerrors.Register(ModuleName, 101, "invalid amount")
 	ErrInsufficientStake = sdkerrors.Register(ModuleName, 102, "insufficient stake")
 	ErrNoDelegation = sdkerrors.Register(ModuleName, 103, "no delegation found")
+	ErrInvalidParams = sdkerrors.Register(ModuleName, 104, "invalid params")
 )
@@ -1,14 +1,31 @@
 package types
 
+import (
+	"bytes"
+	"fmt"
+)
+
 // GenesisState defines the staking module's genesis state
 type GenesisState struct {
-	// Empty for now, basic structure
+	// Validators is the consensus validator set the chain starts with
+	Validators []GenesisValidator `json:"validators"`
 }
 
 func DefaultGenesisState() *GenesisState {
-	return &GenesisState{}
+	return &GenesisState{Validators: []GenesisValidator{}}
 }
 
+// Validate checks every validator and that no consensus key is listed twice
 func (gs GenesisState) Validate() error {
+	for i, validator := range gs.Validators {
+		if err := validator.Validate(); err != nil {
+			return err
+		}
+		for _, other := range gs.Validators[:i] {
+			if bytes.Equal(other.PubKey, validator.PubKey) {
+				return fmt.Errorf("validator %q: duplicate consensus key", validator.Name)
+			}
+		}
+	}
 	return nil
 }
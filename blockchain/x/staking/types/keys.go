@@ -0,0 +1,20 @@
+package types
+
+// Keys for staking store
+var (
+	DelegationKeyPrefix = []byte("delegation/")
+	ValidatorKeyPrefix  = []byte("validator/")
+	ParamsKey           = []byte("params")
+	// TotalStakeKey holds the sum of every delegation's amount
+	TotalStakeKey = []byte("total_stake")
+)
+
+// GetDelegationKey returns the store key of a delegator's delegation
+func GetDelegationKey(delegatorAddr string) []byte {
+	return append(append([]byte{}, DelegationKeyPrefix...), delegatorAddr...)
+}
+
+// GetValidatorKey returns the store key of a validator by consensus key
+func GetValidatorKey(pubKey []byte) []byte {
+	return append(append([]byte{}, ValidatorKeyPrefix...), pubKey...)
+}
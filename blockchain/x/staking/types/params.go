@@ -0,0 +1,100 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	DefaultUnbondingTime        = 21 * 24 * time.Hour
+	DefaultMaxValidators uint32 = 100
+)
+
+// DefaultMinStake is 1 SKAF at 6 decimals
+var DefaultMinStake = sdk.NewInt(1_000_000)
+
+// DefaultStatusThresholds returns the default player status tiers. Each tier
+// multiplies the governance voting power of its stakers by its vote weight.
+func DefaultStatusThresholds() []StatusTier {
+	return []StatusTier{
+		{Level: 1, MinStake: sdk.NewInt(1_000_000), Benefits: "citizen", VoteWeight: sdk.OneDec()},
+		{Level: 2, MinStake: sdk.NewInt(10_000_000_000), Benefits: "resident", VoteWeight: sdk.NewDecWithPrec(11, 1)},
+		{Level: 3, MinStake: sdk.NewInt(100_000_000_000), Benefits: "councillor", VoteWeight: sdk.NewDecWithPrec(125, 2)},
+		{Level: 4, MinStake: sdk.NewInt(1_000_000_000_000), Benefits: "founder", VoteWeight: sdk.NewDecWithPrec(15, 1)},
+	}
+}
+
+// DefaultParams returns the default staking params
+func DefaultParams() StakingParams {
+	return StakingParams{
+		UnbondingTime:    DefaultUnbondingTime,
+		MaxValidators:    DefaultMaxValidators,
+		MinStake:         DefaultMinStake,
+		StatusThresholds: DefaultStatusThresholds(),
+	}
+}
+
+// Validate checks the staking params. Status tiers must be listed from the
+// lowest to the highest minimum stake.
+func (p StakingParams) Validate() error {
+	if p.UnbondingTime <= 0 {
+		return sdkerrors.Wrap(ErrInvalidParams, "unbonding time must be positive")
+	}
+	if p.MinStake.IsNil() || p.MinStake.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidParams, "min stake cannot be negative")
+	}
+	for i, tier := range p.StatusThresholds {
+		if tier.MinStake.IsNil() || tier.MinStake.IsNegative() {
+			return sdkerrors.Wrapf(ErrInvalidParams, "status tier %d: min stake cannot be negative", tier.Level)
+		}
+		if tier.VoteWeight.IsNil() || !tier.VoteWeight.IsPositive() {
+			return sdkerrors.Wrapf(ErrInvalidParams, "status tier %d: vote weight must be positive", tier.Level)
+		}
+		if i > 0 && !tier.MinStake.GT(p.StatusThresholds[i-1].MinStake) {
+			return sdkerrors.Wrapf(ErrInvalidParams, "status tier %d must require more stake than tier %d", tier.Level, p.StatusThresholds[i-1].Level)
+		}
+	}
+	return nil
+}
+
+// StatusTier returns the highest status tier a stake reaches, if any
+func (p StakingParams) StatusTier(stake sdk.Int) (StatusTier, bool) {
+	for i := len(p.StatusThresholds) - 1; i >= 0; i-- {
+		if stake.GTE(p.StatusThresholds[i].MinStake) {
+			return p.StatusThresholds[i], true
+		}
+	}
+	return StatusTier{}, false
+}
+
+// ProtoMessage implements the proto.Message interface for StakingParams.
+func (p *StakingParams) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for StakingParams.
+func (p *StakingParams) Reset() { *p = StakingParams{} }
+
+// String implements the fmt.Stringer interface for StakingParams.
+func (p *StakingParams) String() string {
+	return fmt.Sprintf("StakingParams{UnbondingTime: %s, MaxValidators: %d, MinStake: %s, StatusThresholds: %d}", p.UnbondingTime, p.MaxValidators, p.MinStake, len(p.StatusThresholds))
+}
+
+// Marshal implements codec.ProtoMarshaler for StakingParams.
+func (p *StakingParams) Marshal() ([]byte, error) { return json.Marshal(p) }
+
+// MarshalTo implements codec.ProtoMarshaler for StakingParams.
+func (p *StakingParams) MarshalTo(data []byte) (int, error) { return jsonMarshalTo(p, data) }
+
+// MarshalToSizedBuffer implements codec.ProtoMarshaler for StakingParams.
+func (p *StakingParams) MarshalToSizedBuffer(data []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(p, data)
+}
+
+// Unmarshal implements codec.ProtoMarshaler for StakingParams.
+func (p *StakingParams) Unmarshal(data []byte) error { return json.Unmarshal(data, p) }
+
+// Size implements codec.ProtoMarshaler for StakingParams.
+func (p *StakingParams) Size() int { return jsonSize(p) }
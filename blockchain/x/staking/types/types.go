@@ -19,17 +19,12 @@ type Delegation struct {
     DelegatorAddress string    `json:"delegator_address"`
     ValidatorAddress string    `json:"validator_address"`
     Amount          sdk.Int   `json:"amount"`
+    // StartTime is when the delegation was created or its amount last grew;
+    // governance only counts stake in place before a vote started
     StartTime       time.Time `json:"start_time"`
     Status          sdk.Dec   `json:"status_level"` // Player status based on staking
 }
 
-func (d *Delegation) Marshal() ([]byte, error) { return nil, nil }
-func (d *Delegation) Unmarshal([]byte) error { return nil }
-func (d *Delegation) Size() int { return 0 }
-func (d *Delegation) MarshalTo([]byte) (int, error) { return 0, nil }
-func (d *Delegation) UnmarshalTo(interface{}) error { return nil }
-func (d *Delegation) MarshalToSizedBuffer([]byte) (int, error) { return 0, nil }
-
 // StakingParams defines staking parameters
 type StakingParams struct {
     UnbondingTime     time.Duration `json:"unbonding_time"`
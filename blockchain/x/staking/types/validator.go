@@ -0,0 +1,79 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cryptoproto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	tmtypes "github.com/cometbft/cometbft/types"
+)
+
+// GenesisValidator is a member of the consensus validator set. Stake is not
+// bonded to validators, so the set is the one listed in genesis.
+type GenesisValidator struct {
+	Name string `json:"name,omitempty"`
+	// PubKey is the validator's ed25519 consensus public key
+	PubKey []byte `json:"pub_key"`
+	Power  int64  `json:"power"`
+}
+
+// Validate checks the consensus key and power of a genesis validator
+func (v GenesisValidator) Validate() error {
+	if len(v.PubKey) != ed25519.PubKeySize {
+		return fmt.Errorf("validator %q: consensus key must be %d bytes, got %d", v.Name, ed25519.PubKeySize, len(v.PubKey))
+	}
+	if v.Power <= 0 {
+		return fmt.Errorf("validator %q: power must be positive", v.Name)
+	}
+	return nil
+}
+
+// ValidatorUpdate returns the update that adds the validator to the
+// consensus validator set
+func (v GenesisValidator) ValidatorUpdate() abci.ValidatorUpdate {
+	return abci.ValidatorUpdate{
+		PubKey: cryptoproto.PublicKey{Sum: &cryptoproto.PublicKey_Ed25519{Ed25519: v.PubKey}},
+		Power:  v.Power,
+	}
+}
+
+// TendermintValidator returns the validator as listed in a CometBFT genesis
+func (v GenesisValidator) TendermintValidator() tmtypes.GenesisValidator {
+	pubKey := ed25519.PubKey(v.PubKey)
+	return tmtypes.GenesisValidator{
+		Address: pubKey.Address(),
+		PubKey:  pubKey,
+		Power:   v.Power,
+		Name:    v.Name,
+	}
+}
+
+// ProtoMessage implements the proto.Message interface for GenesisValidator.
+func (v *GenesisValidator) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for GenesisValidator.
+func (v *GenesisValidator) Reset() { *v = GenesisValidator{} }
+
+// String implements the fmt.Stringer interface for GenesisValidator.
+func (v *GenesisValidator) String() string {
+	return fmt.Sprintf("GenesisValidator{Name: %s, Power: %d}", v.Name, v.Power)
+}
+
+// Marshal implements codec.ProtoMarshaler for GenesisValidator.
+func (v *GenesisValidator) Marshal() ([]byte, error) { return json.Marshal(v) }
+
+// MarshalTo implements codec.ProtoMarshaler for GenesisValidator.
+func (v *GenesisValidator) MarshalTo(data []byte) (int, error) { return jsonMarshalTo(v, data) }
+
+// MarshalToSizedBuffer implements codec.ProtoMarshaler for GenesisValidator.
+func (v *GenesisValidator) MarshalToSizedBuffer(data []byte) (int, error) {
+	return jsonMarshalToSizedBuffer(v, data)
+}
+
+// Unmarshal implements codec.ProtoMarshaler for GenesisValidator.
+func (v *GenesisValidator) Unmarshal(data []byte) error { return json.Unmarshal(data, v) }
+
+// Size implements codec.ProtoMarshaler for GenesisValidator.
+func (v *GenesisValidator) Size() int { return jsonSize(v) }
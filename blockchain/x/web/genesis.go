@@ -12,6 +12,9 @@ import (
 // GenesisState represents the web module genesis state
 type GenesisState struct {
 	WebConfig types.WebConfig `json:"web_config"`
+	// RetainedFees is the fee collector balance already split, which is not
+	// split again after a restart
+	RetainedFees sdk.Coins `json:"retained_fees,omitempty"`
 }
 
 // DefaultGenesis returns the default genesis state
@@ -89,6 +92,7 @@ func (gs *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState GenesisState) {
 	// Set web config if provided
 	k.SetWebConfig(ctx, genState.WebConfig)
+	k.SetRetainedFees(ctx, genState.RetainedFees)
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -98,6 +102,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *GenesisState {
 	// Get web config (using the new method that returns default if not found)
 	webConfig := k.GetWebConfig(ctx)
 	genesis.WebConfig = webConfig
+	genesis.RetainedFees = k.GetRetainedFees(ctx)
 
 	return &genesis
 }
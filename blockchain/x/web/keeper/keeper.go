@@ -22,6 +22,9 @@ type (
 		bankKeeper types.BankKeeper
 		authKeeper types.AccountKeeper
 		feeHandler FeeHandler
+		// authority may change the fee distribution; it is the governance
+		// module account
+		authority string
 	}
 )
 
@@ -32,6 +35,7 @@ func NewKeeper(
 	ps paramtypes.Subspace,
 	bankKeeper types.BankKeeper,
 	authKeeper types.AccountKeeper,
+	authority string,
 ) *Keeper {
 	// set KeyTable if it has not already been set (use string comparison to check for zero value)
 	if ps.Name() != "" && !ps.HasKeyTable() {
@@ -45,6 +49,7 @@ func NewKeeper(
 		paramstore: ps,
 		bankKeeper: bankKeeper,
 		authKeeper: authKeeper,
+		authority:  authority,
 	}
 	
 	// Initialize fee handler
@@ -53,6 +58,11 @@ func NewKeeper(
 	return keeper
 }
 
+// GetAuthority returns the address allowed to change the fee distribution
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
@@ -100,6 +110,45 @@ func (k Keeper) DistributeFees(ctx sdk.Context, feeCollector string, totalFees s
 	return k.feeHandler.DistributeFees(ctx, k, feeCollector, totalFees)
 }
 
+// DistributeNewFees splits the fees that reached the fee collector since the
+// last call, leaving the validator share and any earlier balance in place.
+// The fee collector balance afterwards is recorded as retained, so no fee is
+// split twice.
+func (k Keeper) DistributeNewFees(ctx sdk.Context, feeCollector string) error {
+	feeCollectorAddr := k.authKeeper.GetModuleAddress(feeCollector)
+	if feeCollectorAddr == nil {
+		return fmt.Errorf("fee collector account not found: %s", feeCollector)
+	}
+
+	newFees := types.NewFees(k.bankKeeper.GetAllBalances(ctx, feeCollectorAddr), k.GetRetainedFees(ctx))
+	err := k.DistributeFees(ctx, feeCollector, newFees)
+
+	k.SetRetainedFees(ctx, k.bankKeeper.GetAllBalances(ctx, feeCollectorAddr))
+	return err
+}
+
+// GetRetainedFees returns the fee collector balance left after the last fee
+// distribution
+func (k Keeper) GetRetainedFees(ctx sdk.Context) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.KeyPrefix(types.RetainedFeesKey))
+	if b == nil {
+		return sdk.NewCoins()
+	}
+
+	retained, err := sdk.ParseCoinsNormalized(string(b))
+	if err != nil {
+		panic(err)
+	}
+	return retained
+}
+
+// SetRetainedFees records the fee collector balance that has been split
+func (k Keeper) SetRetainedFees(ctx sdk.Context, retained sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefix(types.RetainedFeesKey), []byte(retained.String()))
+}
+
 // GetFeeDistributionConfig returns the current fee distribution configuration
 func (k Keeper) GetFeeDistributionConfig(ctx sdk.Context) types.FeeDistribution {
 	webConfig := k.GetWebConfig(ctx)
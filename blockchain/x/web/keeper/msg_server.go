@@ -5,13 +5,16 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"skaffacity/x/web/types"
 )
 
 func (k msgServer) UpdateWebConfig(goCtx context.Context, msg *types.MsgUpdateWebConfig) (*types.MsgUpdateWebConfigResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// TODO: Handling the message
+	// the fee distribution is only changed through the authority's
+	// SetDeveloperAddress and EnableFeeDistribution messages
+	msg.Config.FeeDistribution = k.GetFeeDistributionConfig(ctx)
 	k.SetWebConfig(ctx, msg.Config)
 
 	return &types.MsgUpdateWebConfigResponse{}, nil
@@ -21,7 +24,9 @@ func (k msgServer) UpdateWebConfig(goCtx context.Context, msg *types.MsgUpdateWe
 func (k msgServer) SetDeveloperAddress(goCtx context.Context, msg *types.MsgSetDeveloperAddress) (*types.MsgSetDeveloperAddressResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// TODO: Add permission check (only admin/governance should be able to set this)
+	if msg.Creator != k.authority {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("expected %s as authority, got %s", k.authority, msg.Creator)
+	}
 	
 	err := k.Keeper.SetDeveloperAddress(ctx, msg.DeveloperAddress)
 	if err != nil {
@@ -44,7 +49,9 @@ func (k msgServer) SetDeveloperAddress(goCtx context.Context, msg *types.MsgSetD
 func (k msgServer) EnableFeeDistribution(goCtx context.Context, msg *types.MsgEnableFeeDistribution) (*types.MsgEnableFeeDistributionResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// TODO: Add permission check (only admin/governance should be able to set this)
+	if msg.Creator != k.authority {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("expected %s as authority, got %s", k.authority, msg.Creator)
+	}
 	
 	err := k.Keeper.EnableFeeDistribution(ctx, msg.Enabled)
 	if err != nil {
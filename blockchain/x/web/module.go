@@ -15,6 +15,7 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"skaffacity/x/web/client/cli"
 	"skaffacity/x/web/keeper"
@@ -53,15 +54,21 @@ func (a AppModuleBasic) RegisterInterfaces(reg cdctypes.InterfaceRegistry) {
 	types.RegisterInterfaces(reg)
 }
 
-// DefaultGenesis returns the capability module's default genesis state.
+// DefaultGenesis returns the capability module's default genesis state. The
+// web types are JSON-encoded rather than generated protobuf, so genesis uses
+// encoding/json directly instead of the codec.
 func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
-	return cdc.MustMarshalJSON(DefaultGenesis())
+	bz, err := json.Marshal(DefaultGenesis())
+	if err != nil {
+		panic(err)
+	}
+	return bz
 }
 
 // ValidateGenesis performs genesis state validation for the capability module.
 func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncodingConfig, bz json.RawMessage) error {
 	var genState GenesisState
-	if err := cdc.UnmarshalJSON(bz, &genState); err != nil {
+	if err := json.Unmarshal(bz, &genState); err != nil {
 		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
 	}
 	return genState.Validate()
@@ -132,8 +139,9 @@ func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.RawMessage) []abci.ValidatorUpdate {
 	var genState GenesisState
-	// Initialize global index to index in genesis state
-	cdc.MustUnmarshalJSON(gs, &genState)
+	if err := json.Unmarshal(gs, &genState); err != nil {
+		panic(fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err))
+	}
 
 	InitGenesis(ctx, am.keeper, genState)
 
@@ -142,34 +150,21 @@ func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.Ra
 
 // ExportGenesis returns the capability module's exported genesis.
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
-	genState := ExportGenesis(ctx, am.keeper)
-	return cdc.MustMarshalJSON(genState)
+	bz, err := json.Marshal(ExportGenesis(ctx, am.keeper))
+	if err != nil {
+		panic(err)
+	}
+	return bz
 }
 
 // ConsensusVersion implements ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return 2 }
 
-// BeginBlock executes all ABCI BeginBlock logic respective to the capability module.
+// BeginBlock splits the fees the fee collector received since the previous
+// block between the developer and the validators.
 func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
-	// Check for fee collection and distribution at the beginning of each block
-	feeCollectorAddr := am.accountKeeper.GetModuleAddress("fee_collector")
-	if feeCollectorAddr != nil {
-		// Get balance of fee collector
-		feeCollectorBalance := am.bankKeeper.GetAllBalances(ctx, feeCollectorAddr)
-		
-		// If there are fees to distribute, handle them
-		if !feeCollectorBalance.IsZero() {
-			ctx.Logger().Info("Fee collector has balance for distribution", 
-				"balance", feeCollectorBalance.String(),
-				"fee_collector", feeCollectorAddr.String(),
-			)
-			
-			// Distribute fees through web keeper
-			err := am.keeper.DistributeFees(ctx, "fee_collector", feeCollectorBalance)
-			if err != nil {
-				ctx.Logger().Error("Failed to distribute fees in BeginBlock", "error", err)
-			}
-		}
+	if err := am.keeper.DistributeNewFees(ctx, authtypes.FeeCollectorName); err != nil {
+		ctx.Logger().Error("Failed to distribute fees in BeginBlock", "error", err)
 	}
 }
 
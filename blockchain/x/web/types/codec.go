@@ -8,11 +8,15 @@ import (
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateWebConfig{}, "web/UpdateWebConfig", nil)
+	cdc.RegisterConcrete(&MsgSetDeveloperAddress{}, "web/SetDeveloperAddress", nil)
+	cdc.RegisterConcrete(&MsgEnableFeeDistribution{}, "web/EnableFeeDistribution", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateWebConfig{},
+		&MsgSetDeveloperAddress{},
+		&MsgEnableFeeDistribution{},
 	)
 
 	// TODO: Register service desc when protobuf is properly generated
@@ -86,3 +86,15 @@ func (fd FeeDistribution) CalculateFees(totalFees sdk.Coins) (developerFee, vali
 	
 	return sdk.NewCoins(developerCoins...), sdk.NewCoins(validatorCoins...)
 }
+
+// NewFees returns the part of the fee collector balance that arrived after
+// retained was left behind, denom by denom
+func NewFees(balance, retained sdk.Coins) sdk.Coins {
+	var newCoins []sdk.Coin
+	for _, coin := range balance {
+		if amount := coin.Amount.Sub(retained.AmountOf(coin.Denom)); amount.IsPositive() {
+			newCoins = append(newCoins, sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	return sdk.NewCoins(newCoins...)
+}
@@ -1,8 +1,8 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
-	"github.com/cosmos/gogoproto/proto"
 )
 
 // GenesisState defines the web module's genesis state
@@ -39,12 +39,12 @@ func (gs *GenesisState) String() string {
 
 // Marshal implements ProtoMarshaler interface
 func (gs *GenesisState) Marshal() ([]byte, error) {
-	return proto.Marshal(gs)
+	return json.Marshal(gs)
 }
 
 // Unmarshal implements ProtoMarshaler interface
 func (gs *GenesisState) Unmarshal(data []byte) error {
-	return proto.Unmarshal(data, gs)
+	return json.Unmarshal(data, gs)
 }
 
 // MarshalTo implements ProtoMarshaler interface
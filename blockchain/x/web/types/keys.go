@@ -24,4 +24,8 @@ func KeyPrefix(p string) []byte {
 const (
 	// WebConfigKey defines the key for web configuration
 	WebConfigKey = "WebConfig-value-"
+
+	// RetainedFeesKey defines the key for the fee collector balance left
+	// after the last fee distribution
+	RetainedFeesKey = "RetainedFees-value-"
 )
@@ -48,6 +48,8 @@ func (m *MsgUpdateWebConfigResponse) String() string {
 // MsgServer interface
 type MsgServer interface {
 	UpdateWebConfig(context.Context, *MsgUpdateWebConfig) (*MsgUpdateWebConfigResponse, error)
+	SetDeveloperAddress(context.Context, *MsgSetDeveloperAddress) (*MsgSetDeveloperAddressResponse, error)
+	EnableFeeDistribution(context.Context, *MsgEnableFeeDistribution) (*MsgEnableFeeDistributionResponse, error)
 }
 
 func NewMsgUpdateWebConfig(creator string, config WebConfig) *MsgUpdateWebConfig {
@@ -2,12 +2,20 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 	
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-// MsgSetDeveloperAddress sets the developer address for fee distribution
+var (
+	_ sdk.Msg = &MsgSetDeveloperAddress{}
+	_ sdk.Msg = &MsgEnableFeeDistribution{}
+)
+
+// MsgSetDeveloperAddress sets the developer address for fee distribution.
+// Creator must be the module authority, the governance module account, so
+// it is only executed by a passed governance proposal.
 type MsgSetDeveloperAddress struct {
 	Creator        string `json:"creator"`
 	DeveloperAddress string `json:"developer_address"`
@@ -21,6 +29,22 @@ func NewMsgSetDeveloperAddress(creator, developerAddress string) *MsgSetDevelope
 	}
 }
 
+// ProtoMessage implements the proto.Message interface for MsgSetDeveloperAddress.
+func (msg *MsgSetDeveloperAddress) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgSetDeveloperAddress.
+func (msg *MsgSetDeveloperAddress) Reset() { *msg = MsgSetDeveloperAddress{} }
+
+// String implements the proto.Message interface for MsgSetDeveloperAddress.
+func (msg *MsgSetDeveloperAddress) String() string {
+	return fmt.Sprintf("MsgSetDeveloperAddress{Creator: %s, DeveloperAddress: %s}", msg.Creator, msg.DeveloperAddress)
+}
+
+// XXX_MessageName returns the fully qualified proto name of MsgSetDeveloperAddress.
+func (msg *MsgSetDeveloperAddress) XXX_MessageName() string {
+	return "skaffacity.web.MsgSetDeveloperAddress"
+}
+
 // Route returns the route of MsgSetDeveloperAddress
 func (msg *MsgSetDeveloperAddress) Route() string {
 	return RouterKey
@@ -64,7 +88,8 @@ func (msg *MsgSetDeveloperAddress) ValidateBasic() error {
 	return nil
 }
 
-// MsgEnableFeeDistribution enables or disables fee distribution
+// MsgEnableFeeDistribution enables or disables fee distribution. Like
+// MsgSetDeveloperAddress, Creator must be the module authority.
 type MsgEnableFeeDistribution struct {
 	Creator string `json:"creator"`
 	Enabled bool   `json:"enabled"`
@@ -78,6 +103,22 @@ func NewMsgEnableFeeDistribution(creator string, enabled bool) *MsgEnableFeeDist
 	}
 }
 
+// ProtoMessage implements the proto.Message interface for MsgEnableFeeDistribution.
+func (msg *MsgEnableFeeDistribution) ProtoMessage() {}
+
+// Reset implements the proto.Message interface for MsgEnableFeeDistribution.
+func (msg *MsgEnableFeeDistribution) Reset() { *msg = MsgEnableFeeDistribution{} }
+
+// String implements the proto.Message interface for MsgEnableFeeDistribution.
+func (msg *MsgEnableFeeDistribution) String() string {
+	return fmt.Sprintf("MsgEnableFeeDistribution{Creator: %s, Enabled: %t}", msg.Creator, msg.Enabled)
+}
+
+// XXX_MessageName returns the fully qualified proto name of MsgEnableFeeDistribution.
+func (msg *MsgEnableFeeDistribution) XXX_MessageName() string {
+	return "skaffacity.web.MsgEnableFeeDistribution"
+}
+
 // Route returns the route of MsgEnableFeeDistribution
 func (msg *MsgEnableFeeDistribution) Route() string {
 	return RouterKey
@@ -121,5 +162,25 @@ func (msg *MsgEnableFeeDistribution) ValidateBasic() error {
 // MsgSetDeveloperAddressResponse is the response for MsgSetDeveloperAddress
 type MsgSetDeveloperAddressResponse struct{}
 
+// ProtoMessage implements proto.Message interface
+func (m *MsgSetDeveloperAddressResponse) ProtoMessage() {}
+
+// Reset implements proto.Message interface
+func (m *MsgSetDeveloperAddressResponse) Reset() { *m = MsgSetDeveloperAddressResponse{} }
+
+// String implements proto.Message interface
+func (m *MsgSetDeveloperAddressResponse) String() string { return "MsgSetDeveloperAddressResponse{}" }
+
 // MsgEnableFeeDistributionResponse is the response for MsgEnableFeeDistribution
 type MsgEnableFeeDistributionResponse struct{}
+
+// ProtoMessage implements proto.Message interface
+func (m *MsgEnableFeeDistributionResponse) ProtoMessage() {}
+
+// Reset implements proto.Message interface
+func (m *MsgEnableFeeDistributionResponse) Reset() { *m = MsgEnableFeeDistributionResponse{} }
+
+// String implements proto.Message interface
+func (m *MsgEnableFeeDistributionResponse) String() string {
+	return "MsgEnableFeeDistributionResponse{}"
+}
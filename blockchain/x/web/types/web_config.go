@@ -1,8 +1,8 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
-	"github.com/cosmos/gogoproto/proto"
 )
 
 // WebConfig represents the configuration for the web interface
@@ -48,12 +48,14 @@ func (wc *WebConfig) String() string {
 
 // Marshal implements ProtoMarshaler interface
 func (wc *WebConfig) Marshal() ([]byte, error) {
-	return proto.Marshal(wc)
+	// encoded as JSON like the other hand-written module types;
+	// proto.Marshal would call back into this method
+	return json.Marshal(wc)
 }
 
 // Unmarshal implements ProtoMarshaler interface
 func (wc *WebConfig) Unmarshal(data []byte) error {
-	return proto.Unmarshal(data, wc)
+	return json.Unmarshal(data, wc)
 }
 
 // MarshalTo implements ProtoMarshaler interface
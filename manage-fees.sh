@@ -8,6 +8,11 @@ set -e
 BINARY_NAME="skaffacityd"
 HOME_DIR="$HOME/skaffacity"
 
+# Fee distribution changes are governance proposals signed by the governance
+# module account; they take effect when the proposal passes
+GOV_ADDRESS="skaffa1h72z9g4qf2kjrq866zgn78xl32wn0q8aqnxvuk"
+PROPOSAL_DEPOSIT="1000000000skaf"
+
 # Colors
 RED='\033[0;31m'
 GREEN='\033[0;32m'
@@ -39,9 +44,9 @@ show_help() {
     echo ""
     echo "Commands:"
     echo "  status                    - Show current fee distribution configuration"
-    echo "  enable                    - Enable fee distribution"
-    echo "  disable                   - Disable fee distribution"
-    echo "  set-address <address>     - Set developer address for fee collection"
+    echo "  enable                    - Propose enabling fee distribution"
+    echo "  disable                   - Propose disabling fee distribution"
+    echo "  set-address <address>     - Propose a developer address for fee collection"
     echo "  earnings                  - Show developer fee earnings"
     echo "  monitor                   - Monitor fee distribution in real-time"
     echo "  help                      - Show this help message"
@@ -91,6 +96,27 @@ show_status() {
     fi
 }
 
+# submit_fee_proposal <title> <description> <message json>
+submit_fee_proposal() {
+    local messages_file
+    messages_file=$(mktemp)
+    echo "[$3]" > "$messages_file"
+    
+    $BINARY_NAME tx governance submit-proposal "$1" "$2" $PROPOSAL_DEPOSIT \
+        --messages "$messages_file" \
+        --from validator \
+        --chain-id skaffacity-1 \
+        --home $HOME_DIR \
+        --gas auto \
+        --gas-adjustment 1.3 \
+        --fees 1000token \
+        --yes
+    
+    rm -f "$messages_file"
+    print_success "Proposal submitted! It takes effect once it passes its vote."
+    print_status "Follow it with: $BINARY_NAME query governance proposals --status voting_period --home $HOME_DIR"
+}
+
 set_developer_address() {
     local address=$1
     
@@ -106,58 +132,24 @@ set_developer_address() {
         return 1
     fi
     
-    print_status "Setting developer address to: $address"
-    
-    # Create transaction
-    $BINARY_NAME tx web set-developer-address $address \
-        --from validator \
-        --chain-id skaffacity-1 \
-        --home $HOME_DIR \
-        --gas auto \
-        --gas-adjustment 1.3 \
-        --fees 1000token \
-        --yes
+    print_status "Proposing developer address: $address"
     
-    print_success "Developer address updated successfully!"
-    print_status "Waiting for transaction to be processed..."
-    sleep 5
-    show_status
+    submit_fee_proposal "Set developer address" "Send the developer share of fees to $address" \
+        "{\"type_url\": \"/skaffacity.web.MsgSetDeveloperAddress\", \"value\": {\"creator\": \"$GOV_ADDRESS\", \"developer_address\": \"$address\"}}"
 }
 
 enable_fee_distribution() {
-    print_status "Enabling fee distribution..."
-    
-    $BINARY_NAME tx web enable-fee-distribution true \
-        --from validator \
-        --chain-id skaffacity-1 \
-        --home $HOME_DIR \
-        --gas auto \
-        --gas-adjustment 1.3 \
-        --fees 1000token \
-        --yes
+    print_status "Proposing to enable fee distribution..."
     
-    print_success "Fee distribution enabled!"
-    print_status "Waiting for transaction to be processed..."
-    sleep 5
-    show_status
+    submit_fee_proposal "Enable fee distribution" "Split transaction fees between validators and the developer address" \
+        "{\"type_url\": \"/skaffacity.web.MsgEnableFeeDistribution\", \"value\": {\"creator\": \"$GOV_ADDRESS\", \"enabled\": true}}"
 }
 
 disable_fee_distribution() {
-    print_status "Disabling fee distribution..."
-    
-    $BINARY_NAME tx web enable-fee-distribution false \
-        --from validator \
-        --chain-id skaffacity-1 \
-        --home $HOME_DIR \
-        --gas auto \
-        --gas-adjustment 1.3 \
-        --fees 1000token \
-        --yes
+    print_status "Proposing to disable fee distribution..."
     
-    print_success "Fee distribution disabled!"
-    print_status "Waiting for transaction to be processed..."
-    sleep 5
-    show_status
+    submit_fee_proposal "Disable fee distribution" "Stop splitting transaction fees with the developer address" \
+        "{\"type_url\": \"/skaffacity.web.MsgEnableFeeDistribution\", \"value\": {\"creator\": \"$GOV_ADDRESS\", \"enabled\": false}}"
 }
 
 show_earnings() {